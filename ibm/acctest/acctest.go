@@ -12,6 +12,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider_framework"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -243,9 +244,11 @@ var (
 // For Power Colo
 
 var (
+	Pi_auxiliary_volume_group_id      string
 	Pi_auxiliary_volume_name          string
 	Pi_cloud_instance_id              string
 	Pi_dhcp_id                        string
+	Pi_dr_target_workspace_id         string
 	Pi_host_group_id                  string
 	Pi_host_id                        string
 	Pi_image                          string
@@ -257,6 +260,7 @@ var (
 	Pi_image_id                       string
 	Pi_instance_id                    string
 	Pi_instance_name                  string
+	Pi_job_id                         string
 	Pi_key_name                       string
 	Pi_network_address_group_id       string
 	Pi_network_id                     string
@@ -317,6 +321,8 @@ var (
 	IsWinImage              string
 	IsCosBucketName         string
 	IsCosBucketCRN          string
+	IsCosBucketLocation     string
+	IsImageUploadFile       string
 	Image_cos_url           string
 	Image_cos_url_encrypted string
 	Image_operating_system  string
@@ -501,6 +507,15 @@ func init() {
 		os.Setenv("IBMCLOUD_BLUEMIX_GO_TRACE", "true")
 	}
 
+	// Replaying an HTTP cassette needs no real credentials, but the provider must still be configured with some.
+	if conns.HTTPCassetteMode() == conns.HTTPCassetteModeReplay {
+		for _, env := range []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, conns.HTTPCassetteModeReplay)
+			}
+		}
+	}
+
 	ReclamationId = os.Getenv("IBM_RECLAMATION_ID")
 	if ReclamationId == "" {
 		fmt.Println("[WARN] Set the environment variable IBM_RECLAMATION_ID for testing reclamation, reclamation_delete tests will fail if this is not set")
@@ -994,6 +1009,17 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IS_COS_BUCKET_CRN for testing ibm_is_image_export_job else it is set to default value 'bucket-27200-lwx4cfvcue'")
 	}
 
+	IsCosBucketLocation = os.Getenv("IS_COS_BUCKET_LOCATION")
+	if IsCosBucketLocation == "" {
+		IsCosBucketLocation = "us-south"
+		fmt.Println("[INFO] Set the environment variable IS_COS_BUCKET_LOCATION for testing ibm_is_image_upload else it is set to default value 'us-south'")
+	}
+
+	IsImageUploadFile = os.Getenv("IS_IMAGE_UPLOAD_FILE")
+	if IsImageUploadFile == "" {
+		fmt.Println("[INFO] Set the environment variable IS_IMAGE_UPLOAD_FILE with the path of a local qcow2 file for testing ibm_is_image_upload")
+	}
+
 	InstanceName = os.Getenv("IS_INSTANCE_NAME")
 	if InstanceName == "" {
 		InstanceName = "placement-check-ins" // for next gen infrastructure
@@ -1362,6 +1388,18 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_AUXILIARY_VOLUME_NAME for testing ibm_pi_volume_onboarding resource else it is set to default value 'terraform-test-power'")
 	}
 
+	Pi_auxiliary_volume_group_id = os.Getenv("PI_AUXILIARY_VOLUME_GROUP_ID")
+	if Pi_auxiliary_volume_group_id == "" {
+		Pi_auxiliary_volume_group_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_AUXILIARY_VOLUME_GROUP_ID for testing ibm_pi_dr_plan_failover action else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_dr_target_workspace_id = os.Getenv("PI_DR_TARGET_WORKSPACE_ID")
+	if Pi_dr_target_workspace_id == "" {
+		Pi_dr_target_workspace_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_DR_TARGET_WORKSPACE_ID for testing ibm_pi_dr_plan resource else it is set to default value 'terraform-test-power'")
+	}
+
 	Pi_volume_group_name = os.Getenv("PI_VOLUME_GROUP_NAME")
 	if Pi_volume_group_name == "" {
 		Pi_volume_group_name = "terraform-test-power"
@@ -1498,6 +1536,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_TARGET_STORAGE_TIER for testing Pi_target_storage_tier resource else it is set to default value 'terraform-test-tier'")
 	}
 
+	Pi_job_id = os.Getenv("PI_JOB_ID")
+	if Pi_job_id == "" {
+		Pi_job_id = "terraform-test-job-id"
+		fmt.Println("[INFO] Set the environment variable PI_JOB_ID for testing ibm_pi_job data source else it is set to default value 'terraform-test-job-id'")
+	}
+
 	Pi_volume_clone_task_id = os.Getenv("PI_VOLUME_CLONE_TASK_ID")
 	if Pi_volume_clone_task_id == "" {
 		Pi_volume_clone_task_id = "terraform-test-volume-clone-task-id"
@@ -2524,6 +2568,16 @@ func TestAccPreCheckImage(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckImageUpload(t *testing.T) {
+	TestAccPreCheck(t)
+	if IsImageUploadFile == "" {
+		t.Fatal("IS_IMAGE_UPLOAD_FILE must be set for acceptance tests")
+	}
+	if Image_operating_system == "" {
+		t.Fatal("IMAGE_OPERATING_SYSTEM must be set for acceptance tests")
+	}
+}
+
 func TestAccPreCheckEncryptedImage(t *testing.T) {
 	TestAccPreCheck(t)
 	if Image_cos_url_encrypted == "" {
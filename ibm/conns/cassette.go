@@ -0,0 +1,490 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// HTTPCassetteEnv names the file the provider records its API exchanges to, or replays them from.
+	HTTPCassetteEnv = "IBMCLOUD_HTTP_CASSETTE"
+	// HTTPCassetteModeEnv selects the cassette mode, "record" or "replay". When it is not set the
+	// cassette is replayed if the file exists and recorded otherwise.
+	HTTPCassetteModeEnv = "IBMCLOUD_HTTP_CASSETTE_MODE"
+
+	HTTPCassetteModeRecord = "record"
+	HTTPCassetteModeReplay = "replay"
+
+	cassetteRedacted = "REDACTED"
+)
+
+// cassetteRedactedHeaders are the request and response headers whose values are never written to a cassette.
+var cassetteRedactedHeaders = map[string]bool{
+	"Authorization":        true,
+	"Cookie":               true,
+	"Set-Cookie":           true,
+	"Refresh-Token":        true,
+	"X-Auth-Refresh-Token": true,
+	"X-Auth-Token":         true,
+	"X-Auth-Uaa-Token":     true,
+	"X-Api-Key":            true,
+	"Iam-Apikey":           true,
+	"Iam-Token":            true,
+}
+
+// cassetteRedactedFields are the JSON properties, form fields and query parameters whose values are never
+// written to a cassette. Names are compared in lower case.
+var cassetteRedactedFields = map[string]bool{
+	"apikey":                  true,
+	"api_key":                 true,
+	"password":                true,
+	"passphrase":              true,
+	"access_token":            true,
+	"refresh_token":           true,
+	"delegated_refresh_token": true,
+	"iam_token":               true,
+	"iam_refresh_token":       true,
+	"uaa_token":               true,
+	"uaa_refresh_token":       true,
+	"ims_token":               true,
+	"client_secret":           true,
+	"private_key":             true,
+	"payload":                 true,
+	"secret":                  true,
+	"token":                   true,
+	"access_key_id":           true,
+	"secret_access_key":       true,
+	"cos_hmac_keys":           true,
+	"x-amz-credential":        true,
+	"x-amz-signature":         true,
+	"x-amz-security-token":    true,
+}
+
+var cassetteJWTRegexp = regexp.MustCompile(`^(Bearer )?([A-Za-z0-9_-]+)\.([A-Za-z0-9_-]+)\.([A-Za-z0-9_-]+)$`)
+
+// cassetteJWTClaims are the claims of an IAM access token that the provider reads, for example in
+// fetchUserDetails, and that the IAM authenticators need to schedule a token refresh. A redacted
+// token only keeps these claims.
+var cassetteJWTClaims = []string{"account", "exp", "iat", "id", "iss"}
+
+// cassetteBearerRegexp matches the bearer tokens embedded in other values, such as a kubeconfig or an
+// error message.
+var cassetteBearerRegexp = regexp.MustCompile(`(?i)(Bearer\s+)[A-Za-z0-9._~+/-]+=*`)
+
+// cassetteURLUserinfoRegexp and cassetteURLSignatureRegexp match the credentials embedded in URLs, as the
+// userinfo of the URL or as the query parameters of a presigned URL.
+var (
+	cassetteURLUserinfoRegexp  = regexp.MustCompile(`([A-Za-z][A-Za-z0-9+.-]*://)[^/?#\s"'@]+@`)
+	cassetteURLSignatureRegexp = regexp.MustCompile(`(?i)(X-Amz-(?:Credential|Signature|Security-Token)=)[^&#\s"']+`)
+)
+
+type cassetteRequest struct {
+	Method  string        `json:"method"`
+	URL     string        `json:"url"`
+	Headers gohttp.Header `json:"headers,omitempty"`
+	Body    string        `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int           `json:"status_code"`
+	Headers    gohttp.Header `json:"headers,omitempty"`
+	Body       string        `json:"body,omitempty"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteFile struct {
+	Interactions []*cassetteInteraction `json:"interactions"`
+}
+
+// httpCassette records the API exchanges of the provider to a file with all credentials redacted, and
+// replays them later without contacting IBM Cloud.
+type httpCassette struct {
+	mu           sync.Mutex
+	path         string
+	mode         string
+	interactions []*cassetteInteraction
+
+	// used counts the replayed interactions per request key. Once all recorded interactions for a key
+	// have been replayed the last one is repeated, so polling and token refreshes stay deterministic.
+	used map[string]int
+
+	// err is the error of loading the cassette. It is returned by every request, so that an unreadable
+	// cassette fails the API calls of the provider instead of stopping the provider process.
+	err error
+}
+
+var (
+	activeCassette     *httpCassette
+	activeCassetteOnce sync.Once
+)
+
+// HTTPCassetteMode returns the mode of the cassette configured with IBMCLOUD_HTTP_CASSETTE, or an empty
+// string when no cassette is used.
+func HTTPCassetteMode() string {
+	if cassette := httpCassetteFromEnv(); cassette != nil {
+		return cassette.mode
+	}
+	return ""
+}
+
+// httpCassetteFromEnv returns the cassette configured with IBMCLOUD_HTTP_CASSETTE, or nil when no cassette
+// is used. The cassette is shared by every provider instance in the process.
+func httpCassetteFromEnv() *httpCassette {
+	activeCassetteOnce.Do(func() {
+		path := os.Getenv(HTTPCassetteEnv)
+		if path == "" {
+			return
+		}
+		cassette, err := newHTTPCassette(path, os.Getenv(HTTPCassetteModeEnv))
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error loading HTTP cassette %s: %s", path, err)
+			log.Print(err)
+			activeCassette = &httpCassette{path: path, mode: os.Getenv(HTTPCassetteModeEnv), err: err}
+			return
+		}
+		log.Printf("[INFO] Using HTTP cassette %s in %s mode", path, cassette.mode)
+		activeCassette = cassette
+	})
+	return activeCassette
+}
+
+func newHTTPCassette(path, mode string) (*httpCassette, error) {
+	if mode == "" {
+		mode = HTTPCassetteModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = HTTPCassetteModeReplay
+		}
+	}
+
+	cassette := &httpCassette{
+		path: path,
+		mode: mode,
+		used: map[string]int{},
+	}
+	switch mode {
+	case HTTPCassetteModeRecord:
+		return cassette, cassette.save()
+	case HTTPCassetteModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("invalid cassette: %s", err)
+		}
+		cassette.interactions = file.Interactions
+		return cassette, nil
+	}
+	return nil, fmt.Errorf("invalid %s %q, expected %q or %q", HTTPCassetteModeEnv, mode, HTTPCassetteModeRecord, HTTPCassetteModeReplay)
+}
+
+// Transport wraps next so that its exchanges are recorded to, or replayed from, the cassette.
+// It returns next unchanged when the cassette is nil.
+func (cassette *httpCassette) Transport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	if cassette == nil {
+		return next
+	}
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	if _, ok := next.(*cassetteTransport); ok {
+		return next
+	}
+	return &cassetteTransport{cassette: cassette, next: next}
+}
+
+// WrapClient wraps the transport of client in place and returns it.
+func (cassette *httpCassette) WrapClient(client *gohttp.Client) *gohttp.Client {
+	if cassette != nil && client != nil {
+		client.Transport = cassette.Transport(client.Transport)
+	}
+	return client
+}
+
+// Client returns a new HTTP client for IAM authenticators using the cassette, or nil when the cassette is
+// nil so that the authenticators fall back to their own default client.
+func (cassette *httpCassette) Client() *gohttp.Client {
+	if cassette == nil {
+		return nil
+	}
+	client := core.DefaultHTTPClient()
+	client.Timeout = 30 * time.Second
+	return cassette.WrapClient(client)
+}
+
+// WrapService routes the requests of a go-sdk-core service through the cassette.
+func (cassette *httpCassette) WrapService(service *core.BaseService) {
+	if cassette != nil && service != nil {
+		cassette.WrapClient(service.GetHTTPClient())
+	}
+}
+
+type cassetteTransport struct {
+	cassette *httpCassette
+	next     gohttp.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if t.cassette.err != nil {
+		return nil, t.cassette.err
+	}
+	var body []byte
+	if req.Body != nil && req.Body != gohttp.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	request := redactCassetteRequest(req, body)
+
+	if t.cassette.mode == HTTPCassetteModeReplay {
+		return t.cassette.replay(req, request)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	err = t.cassette.record(&cassetteInteraction{
+		Request: request,
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    redactCassetteHeaders(resp.Header),
+			Body:       redactCassetteBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+	if err != nil {
+		log.Printf("[WARN] Error writing HTTP cassette %s: %s", t.cassette.path, err)
+	}
+	return resp, nil
+}
+
+func (cassette *httpCassette) record(interaction *cassetteInteraction) error {
+	cassette.mu.Lock()
+	defer cassette.mu.Unlock()
+	cassette.interactions = append(cassette.interactions, interaction)
+	return cassette.save()
+}
+
+// save writes the whole cassette. The provider process can be stopped at any time, so the file is kept
+// up to date after every interaction.
+func (cassette *httpCassette) save() error {
+	interactions := cassette.interactions
+	if interactions == nil {
+		interactions = []*cassetteInteraction{}
+	}
+	data, err := json.MarshalIndent(cassetteFile{Interactions: interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(cassette.path, data, 0600)
+}
+
+func (cassette *httpCassette) replay(req *gohttp.Request, request cassetteRequest) (*gohttp.Response, error) {
+	cassette.mu.Lock()
+	defer cassette.mu.Unlock()
+
+	key := request.key()
+	var matches []*cassetteInteraction
+	for _, interaction := range cassette.interactions {
+		if interaction.Request.key() == key {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("[ERROR] No interaction for %s found in HTTP cassette %s", key, cassette.path)
+	}
+
+	index := cassette.used[key]
+	if index >= len(matches) {
+		index = len(matches) - 1
+	}
+	cassette.used[key]++
+	recorded := matches[index].Response
+
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = gohttp.Header{}
+	}
+	return &gohttp.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, gohttp.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// key identifies the recorded interactions a request is replayed from: its method, URL and the hash of
+// its redacted body, so that two requests to the same URL with different bodies are told apart.
+func (request cassetteRequest) key() string {
+	key := request.Method + " " + request.URL
+	if request.Body != "" {
+		sum := sha256.Sum256([]byte(request.Body))
+		key += " body:" + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+func redactCassetteRequest(req *gohttp.Request, body []byte) cassetteRequest {
+	u := *req.URL
+	u.User = nil
+	if u.RawQuery != "" {
+		query := u.Query()
+		for name := range query {
+			if cassetteRedactedFields[strings.ToLower(name)] {
+				query.Set(name, cassetteRedacted)
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+	return cassetteRequest{
+		Method:  req.Method,
+		URL:     redactCassetteString(u.String()),
+		Headers: redactCassetteHeaders(req.Header),
+		Body:    redactCassetteBody(req.Header.Get("Content-Type"), body),
+	}
+}
+
+func redactCassetteHeaders(header gohttp.Header) gohttp.Header {
+	redacted := header.Clone()
+	for name := range redacted {
+		if cassetteRedactedHeaders[gohttp.CanonicalHeaderKey(name)] {
+			redacted[name] = []string{cassetteRedacted}
+			continue
+		}
+		for i, value := range redacted[name] {
+			redacted[name][i] = redactCassetteString(value)
+		}
+	}
+	return redacted
+}
+
+func redactCassetteBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for name := range form {
+				if cassetteRedactedFields[strings.ToLower(name)] {
+					form.Set(name, cassetteRedacted)
+				}
+			}
+			return form.Encode()
+		}
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		if redacted, err := json.Marshal(redactCassetteValue(value)); err == nil {
+			return string(redacted)
+		}
+	}
+	return redactCassetteString(core.RedactSecrets(string(body)))
+}
+
+func redactCassetteValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if !cassetteRedactedFields[strings.ToLower(key)] {
+				v[key] = redactCassetteValue(item)
+				continue
+			}
+			if s, ok := item.(string); ok {
+				v[key] = redactCassetteToken(s)
+			} else {
+				v[key] = cassetteRedacted
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactCassetteValue(item)
+		}
+	case string:
+		return redactCassetteString(v)
+	}
+	return value
+}
+
+// redactCassetteString redacts the credentials found in value: the bearer tokens, and the credentials of
+// URLs such as the password of a connection string or the signature of a presigned COS URL.
+func redactCassetteString(value string) string {
+	value = cassetteBearerRegexp.ReplaceAllString(value, "${1}"+cassetteRedacted)
+	value = cassetteURLUserinfoRegexp.ReplaceAllString(value, "${1}"+cassetteRedacted+"@")
+	return cassetteURLSignatureRegexp.ReplaceAllString(value, "${1}"+cassetteRedacted)
+}
+
+// redactCassetteToken redacts a secret value. A JWT is replaced with an unsigned token that only holds
+// the cassetteJWTClaims, because the provider reads the account and user details from the IAM access
+// token when the cassette is replayed. Nothing else of the original token is kept.
+func redactCassetteToken(value string) string {
+	match := cassetteJWTRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return cassetteRedacted
+	}
+	header, err := base64.RawURLEncoding.DecodeString(match[2])
+	if err != nil {
+		return cassetteRedacted
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(match[3])
+	if err != nil {
+		return cassetteRedacted
+	}
+	var alg struct {
+		Alg string `json:"alg"`
+	}
+	var claims map[string]interface{}
+	if json.Unmarshal(header, &alg) != nil || json.Unmarshal(payload, &claims) != nil {
+		return cassetteRedacted
+	}
+	kept := map[string]interface{}{}
+	for _, name := range cassetteJWTClaims {
+		if claim, ok := claims[name]; ok {
+			kept[name] = claim
+		}
+	}
+	if account, ok := kept["account"].(map[string]interface{}); ok {
+		kept["account"] = map[string]interface{}{"bss": account["bss"]}
+	}
+	header, _ = json.Marshal(map[string]string{"alg": alg.Alg, "typ": "JWT"})
+	payload, _ = json.Marshal(kept)
+	return match[1] + base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + cassetteRedacted
+}
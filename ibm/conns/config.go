@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
@@ -192,6 +193,14 @@ type Config struct {
 	// Constant Retry Delay for API calls
 	RetryDelay time.Duration
 
+	// RetryPolicy is the retry policy of the service clients. Zero values default to RetryCount and
+	// RetryDelay. ServiceRetryPolicies overrides it for single services.
+	RetryPolicy          RetryPolicy
+	ServiceRetryPolicies map[string]RetryPolicy
+
+	// WaiterPolicy is the polling policy of the waiters that wait for a resource to reach a state
+	WaiterPolicy WaiterPolicy
+
 	// FunctionNameSpace ...
 	FunctionNameSpace string
 
@@ -239,6 +248,7 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	IAMAuthenticator() (core.Authenticator, error)
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
@@ -344,11 +354,19 @@ type ClientSession interface {
 	SdsaasV1() (*sdsaasv1.SdsaasV1, error)
 	DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error)
 	PlatformNotificationsV1() (*platformnotificationsv1.PlatformNotificationsV1, error)
+	WaiterPolicy() WaiterPolicy
 }
 
 type clientSession struct {
 	session *Session
 
+	// lazyClients holds the constructors of the service clients, keyed by
+	// service. A client is only built the first time one of its accessors is
+	// called, so a run only pays for the services it actually uses.
+	lazyClients map[string]*lazyClient
+
+	waiterPolicy WaiterPolicy
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -364,6 +382,9 @@ type clientSession struct {
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
+	iamAuthenticator    core.Authenticator
+	iamAuthenticatorErr error
+
 	csConfigErr  error
 	csServiceAPI containerv1.ContainerServiceAPI
 
@@ -739,66 +760,103 @@ type clientSession struct {
 	platformNotificationsClientErr error
 }
 
+// lazyClient builds the clients of one service on first use.
+type lazyClient struct {
+	once sync.Once
+	init func()
+}
+
+// register records the constructor of a service. It must only be called while
+// the session is being configured.
+func (sess *clientSession) register(name string, init func()) {
+	sess.lazyClients[name] = &lazyClient{init: init}
+}
+
+// load builds the clients of a service if that has not happened yet. Services
+// that were never registered, e.g. because the Bluemix session is missing, keep
+// the errors set while configuring the session.
+func (sess *clientSession) load(name string) {
+	if client, ok := sess.lazyClients[name]; ok {
+		client.once.Do(client.init)
+	}
+}
+
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.load("usageReports")
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
-func (session clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+func (session *clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+	session.load("partnerCenterSell")
 	return session.partnerCenterSellClient, session.partnerCenterSellClientErr
 }
 
 // Configuration Aggregator
-func (session clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+func (session *clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	session.load("configurationAggregator")
 	return session.configurationAggregatorClient, session.configurationAggregatorClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.load("appid")
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.load("catalogManagement")
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.load("accountv2")
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.load("accountv1")
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// IAMAuthenticator provides the IAM authenticator shared by the go-sdk-core based service clients
+func (sess *clientSession) IAMAuthenticator() (core.Authenticator, error) {
+	return sess.iamAuthenticator, sess.iamAuthenticatorErr
+}
+
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.load("containerv1")
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.load("containerv2")
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.load("containerRegistry")
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.load("schematics")
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -807,122 +865,146 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.load("function")
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.load("globalSearch")
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.load("globalTagging")
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.load("globalTaggingV1")
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.load("globalSearchV2")
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.load("hpcs")
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.load("uko")
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.load("userManagement")
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.load("iamPolicyManagement")
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.load("iamAccessGroups")
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.load("cloudShell")
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.load("icd")
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.load("cloudDatabases")
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // IBM Db2 SaaS on Cloud REST API
-func (session clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+func (session *clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+	session.load("db2saas")
 	return session.db2saasClient, session.db2saasClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.load("mccpv2")
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.load("resourceCatalog")
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.load("resourceManagementV2")
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.load("resourceControllerV1")
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.load("resourceControllerV2")
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.load("pushNotifications")
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.load("eventNotifications")
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.load("appConfiguration")
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.load("keyProtect")
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.load("keyManagement")
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -943,57 +1025,67 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 
 		kpClient, err := kp.New(*clientConfig, DefaultTransport())
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.load("vpc")
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.load("vpc")
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.load("directLink")
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.load("directLink")
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.load("cosConfig")
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.load("transitGateway")
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.load("power")
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.load("privateDNS")
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.load("functionNamespace")
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.load("cis")
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -1001,7 +1093,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.load("cis")
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -1009,7 +1102,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.load("cis")
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -1017,7 +1111,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.load("cis")
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -1025,7 +1120,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.load("cis")
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -1033,7 +1129,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.load("cis")
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -1041,7 +1138,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.load("cis")
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -1049,7 +1147,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.load("cis")
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -1057,7 +1156,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.load("cis")
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -1065,7 +1165,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.load("cis")
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -1073,7 +1174,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.load("cis")
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -1081,12 +1183,14 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // DrAutomation Service
-func (session clientSession) DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error) {
+func (session *clientSession) DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error) {
+	session.load("drAutomation")
 	return session.drAutomationServiceClient, session.drAutomationServiceClientErr
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.load("cis")
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -1094,7 +1198,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.load("cis")
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -1102,7 +1207,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.load("cis")
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -1110,7 +1216,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Rulesets
-func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+func (sess *clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+	sess.load("cis")
 	if sess.cisRulesetsErr != nil {
 		return sess.cisRulesetsClient, sess.cisRulesetsErr
 	}
@@ -1118,7 +1225,8 @@ func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.load("cis")
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -1126,7 +1234,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.load("cis")
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1134,7 +1243,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.load("cis")
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1142,7 +1252,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.load("cis")
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1150,7 +1261,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.load("cis")
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1158,7 +1270,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.load("cis")
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1166,7 +1279,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.load("cis")
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1174,7 +1288,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.load("cis")
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1182,7 +1297,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.load("cis")
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1190,7 +1306,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.load("cis")
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1198,7 +1315,8 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // CIS Lists
-func (sess clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
+func (sess *clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
+	sess.load("cis")
 	if sess.cisListsErr != nil {
 		return sess.cisListsClient, sess.cisListsErr
 	}
@@ -1206,60 +1324,72 @@ func (sess clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
 }
 
 // Account Management Session
-func (sess clientSession) AccountManagementV4() (*accountmanagementv4.AccountManagementV4, error) {
+func (sess *clientSession) AccountManagementV4() (*accountmanagementv4.AccountManagementV4, error) {
+	sess.load("iamIdentity")
 	return sess.accountManagementAPI, sess.accountManagementErr
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.load("iamIdentity")
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.load("resourceManager")
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.load("enterpriseManagement")
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.load("resourceController")
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
-func (session clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+func (session *clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+	session.load("backupRecovery")
 	return session.backupRecoveryClient, session.backupRecoveryClientErr
 }
 
-func (session clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+func (session *clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+	session.load("backupRecovery")
 	return session.backupRecoveryConnectorClient, session.backupRecoveryConnectorClientErr
 }
 
-func (session clientSession) BackupRecoveryManagerV1() (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
+func (session *clientSession) BackupRecoveryManagerV1() (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
+	session.load("backupRecovery")
 	return session.backupRecoveryManagerClient, session.backupRecoveryManagerClientErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.load("secretsManager")
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.load("satelliteLink")
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.load("satellite")
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.load("cis")
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1267,7 +1397,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.load("cis")
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1275,7 +1406,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.load("cis")
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1283,7 +1415,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.load("cis")
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1291,7 +1424,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.load("cis")
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1299,7 +1433,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.load("cis")
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1307,7 +1442,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.load("cis")
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1315,107 +1451,137 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.load("atracker")
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.load("metricsRouter")
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.load("eventStreamsSchemaRegistry")
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
-func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+func (session *clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	session.load("eventStreamsAdminRest")
 	return session.esAdminRestClient, session.esAdminRestErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.load("securityAndComplianceCenter")
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.load("contextBasedRestrictions")
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.load("cdToolchain")
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.load("cdTektonPipeline")
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.load("codeEngine")
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.load("project")
 	return session.projectClient, session.projectClientErr
 }
 
 // MQ SaaS
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.load("mqcloud")
 	if session.mqcloudClientErr != nil {
-		sessionMqcloudClient := session.mqcloudClient
-		sessionMqcloudClient.EnableRetries(0, 0)
 		return session.mqcloudClient, session.mqcloudClientErr
 	}
 	return session.mqcloudClient.Clone(), nil
 }
 
 // sdsaas
-func (session clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+func (session *clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+	session.load("sdsaas")
 	return session.sdsaasClient, session.sdsaasClientErr
 }
 
 // VMware as a Service API
-func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+func (session *clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+	session.load("vmware")
 	return session.vmwareClient, session.vmwareClientErr
 }
 
 // Cloud Logs
-func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
+func (session *clientSession) LogsV0() (*logsv0.LogsV0, error) {
+	session.load("logs")
 	return session.logsClient, session.logsClientErr
 }
 
 // IBM Cloud Logs Routing V1
-func (session clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+func (session *clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+	session.load("logsRouting")
 	return session.ibmCloudLogsRoutingClient, session.ibmCloudLogsRoutingClientErr
 }
 
 // Logs Routing API V3
-func (session clientSession) LogsRouterV3() (*logsrouterv3.LogsRouterV3, error) {
+func (session *clientSession) LogsRouterV3() (*logsrouterv3.LogsRouterV3, error) {
+	session.load("logsRouter")
 	return session.logsRouterClient, session.logsRouterClientErr
 }
 
 // GlobalCatalog Session
-func (sess clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+func (sess *clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	sess.load("globalCatalog")
 	return sess.globalCatalogClient, sess.globalCatalogClientErr
 }
 
 // Platform Notifications
-func (session clientSession) PlatformNotificationsV1() (*platformnotificationsv1.PlatformNotificationsV1, error) {
+func (session *clientSession) PlatformNotificationsV1() (*platformnotificationsv1.PlatformNotificationsV1, error) {
+	session.load("platformNotifications")
 	return session.platformNotificationsClient, session.platformNotificationsClientErr
 }
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
+	// Clients that do not support a retry policy use the provider wide attempts and backoff
+	if c.RetryPolicy.MaxAttempts > 0 {
+		c.RetryCount = c.RetryPolicy.MaxAttempts - 1
+	}
+	if c.RetryPolicy.MaxBackoff > 0 {
+		c.RetryDelay = c.RetryPolicy.MaxBackoff
+	}
+
+	if err := c.WaiterPolicy.validate(); err != nil {
+		return nil, err
+	}
+
 	sess, fileMap, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:      sess,
+		lazyClients:  map[string]*lazyClient{},
+		waiterPolicy: c.WaiterPolicy.WithDefaults(),
 	}
 
 	if sess.BluemixSession == nil {
@@ -1459,6 +1625,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcbetaErr = errEmptyBluemixCredentials
 		session.pDNSErr = errEmptyBluemixCredentials
 		session.bmxUserFetchErr = errEmptyBluemixCredentials
+		session.iamAuthenticatorErr = errEmptyBluemixCredentials
 		session.directlinkErr = errEmptyBluemixCredentials
 		session.dlProviderErr = errEmptyBluemixCredentials
 		session.cosConfigErr = errEmptyBluemixCredentials
@@ -1540,74 +1707,90 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	session.register("function", func() {
+		session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	})
 
 	BluemixRegion = sess.BluemixSession.Config.Region
 
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
+	session.register("accountv1", func() {
+		accv1API, err := accountv1.New(c.bluemixSession("accountv1", sess.BluemixSession))
+		if err != nil {
+			session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
+		}
+		session.bmxAccountv1ServiceAPI = accv1API
+	})
 
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
+	session.register("accountv2", func() {
+		accAPI, err := accountv2.New(c.bluemixSession("accountv2", sess.BluemixSession))
+		if err != nil {
+			session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
+		}
+		session.bmxAccountServiceAPI = accAPI
+	})
 
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
+	session.register("mccpv2", func() {
+		cfAPI, err := mccpv2.New(c.bluemixSession("mccpv2", sess.BluemixSession))
+		if err != nil {
+			session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
+		}
+		session.cfServiceAPI = cfAPI
+	})
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
+	session.register("containerv1", func() {
+		clusterAPI, err := containerv1.New(c.bluemixSession("containerv1", sess.BluemixSession))
+		if err != nil {
+			session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
+		}
+		session.csServiceAPI = clusterAPI
+	})
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
+	session.register("containerv2", func() {
+		v2clusterAPI, err := containerv2.New(c.bluemixSession("containerv2", sess.BluemixSession))
+		if err != nil {
+			session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
+		}
+		session.csv2ServiceAPI = v2clusterAPI
+	})
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
+	session.register("hpcs", func() {
+		hpcsAPI, err := hpcs.New(c.bluemixSession("hpcs", sess.BluemixSession))
+		if err != nil {
+			session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
+		}
+		session.hpcsEndpointAPI = hpcsAPI
+	})
 
-	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
-	var options kp.ClientConfig
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
-		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+	session.register("keyProtect", func() {
+		kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 		}
-	} else {
-		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 		}
-	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
+		var options kp.ClientConfig
+		if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
+			options = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
+		} else {
+			options = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
+		}
+		kpAPIclient, err := kp.New(options, DefaultTransport())
+		if err != nil {
+			session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+		}
+		session.kpAPI = kpAPIclient
+	})
 
 	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, IAMURL)
 
@@ -1622,37 +1805,39 @@ func (c *Config) ClientSession() (interface{}, error) {
 		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
 	}
 
-	// KEY MANAGEMENT Service
-	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
-	var kmsOptions kp.ClientConfig
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
-		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+	session.register("keyManagement", func() {
+		// KEY MANAGEMENT Service
+		kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 		}
-	} else {
-		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 		}
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
+		var kmsOptions kp.ClientConfig
+		if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
+			kmsOptions = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			}
+		} else {
+			kmsOptions = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			}
+		}
+		kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+		if err != nil {
+			session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
+		}
+		session.kmsAPI = kmsAPIclient
+	})
 
 	var authenticator core.Authenticator
 
@@ -1662,6 +1847,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				SetApiKey(c.BluemixAPIKey).
 				SetIAMProfileID(c.IAMTrustedProfileID).
 				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
+				SetClient(httpCassetteFromEnv().Client()).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
@@ -1672,6 +1858,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				SetIAMProfileName(c.IAMTrustedProfileName).
 				SetIAMAccountID(c.Account).
 				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
+				SetClient(httpCassetteFromEnv().Client()).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
@@ -1683,6 +1870,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			authenticator = &core.IamAuthenticator{
 				ApiKey: c.BluemixAPIKey,
 				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client: httpCassetteFromEnv().Client(),
 			}
 		} else {
 			// Construct the IamAuthenticator with the IAM refresh token.
@@ -1691,6 +1879,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				ClientId:     "bx",
 				ClientSecret: "bx",
 				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client:       httpCassetteFromEnv().Client(),
 			}
 		}
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
@@ -1702,2227 +1891,2382 @@ func (c *Config) ClientSession() (interface{}, error) {
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
 		}
 	}
+	session.iamAuthenticator = authenticator
 
-	// Construct the service options.
-	var backupRecoveryURL string = "https://default.backup-recovery.cloud.ibm.com/v2"
-	var backupRecoveryConnectorURL string
-	var backupRecoveryManagerURL string = "https://manager.backup-recovery.cloud.ibm.com/v2"
-
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		backupRecoveryURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", c.Region, backupRecoveryURL)
-		backupRecoveryConnectorURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT", c.Region, backupRecoveryConnectorURL)
-		backupRecoveryManagerURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT", c.Region, backupRecoveryConnectorURL)
-	}
-
-	backupRecoveryClientOptions := &backuprecoveryv1.BackupRecoveryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT"}, backupRecoveryURL),
-	}
-
-	// Construct the service client.
-	session.backupRecoveryClient, err = backuprecoveryv1.NewBackupRecoveryV1(backupRecoveryClientOptions)
-	if err != nil {
-		session.backupRecoveryClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
-	}
-	if session.backupRecoveryClient != nil && session.backupRecoveryClient.Service != nil {
-		// Enable retries for API calls
-		session.backupRecoveryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	var backupRecoveryConnectorClientAuthenticator core.Authenticator
-	backupRecoveryConnectorClientAuthenticator = &core.NoAuthAuthenticator{}
-
-	backupRecoveryConnectorClientOptions := &backuprecoveryv1.BackupRecoveryV1ConnectorOptions{
-		ConnectorURL:  EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT"}, backupRecoveryConnectorURL),
-		Authenticator: backupRecoveryConnectorClientAuthenticator,
-	}
-
-	// Construct the service connector client.
-	session.backupRecoveryConnectorClient, err = backuprecoveryv1.NewBackupRecoveryV1Connector(backupRecoveryConnectorClientOptions)
-	session.backupRecoveryConnectorClient.Service.DisableSSLVerification()
-	if err != nil {
-		session.backupRecoveryConnectorClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API connector service: %q", err)
-	}
-	if session.backupRecoveryConnectorClient != nil && session.backupRecoveryConnectorClient.Service != nil {
-		// Enable retries for API calls
-		session.backupRecoveryConnectorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.backupRecoveryConnectorClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	var backupRecoveryManagerClientAuthenticator core.Authenticator
-	backupRecoveryManagerClientAuthenticator = &core.NoAuthAuthenticator{}
-
-	backupRecoveryManagerClientOptions := &backuprecoveryv1.BackupRecoveryManagementSreApiV1Options{
-		Authenticator: backupRecoveryManagerClientAuthenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT"}, backupRecoveryManagerURL),
-	}
-	// Construct the service client.
-	session.backupRecoveryManagerClient, err = backuprecoveryv1.NewBackupRecoveryManagementSreApiV1(backupRecoveryManagerClientOptions)
-	if err != nil {
-		session.backupRecoveryManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
-	}
-	if session.backupRecoveryManagerClient != nil && session.backupRecoveryManagerClient.Service != nil {
-		// Enable retries for API calls
-		session.backupRecoveryManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.backupRecoveryManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	projectEndpoint := project.DefaultServiceURL
-	// Construct an "options" struct for creating the service client.
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
-	}
-	// Construct an "options" struct for creating the service client.
-	projectClientOptions := &project.ProjectV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.projectClient, err = project.NewProjectV1(projectClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.projectClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
-	}
+	session.register("backupRecovery", func() {
+		var err error
+		// Construct the service options.
+		var backupRecoveryURL string = "https://default.backup-recovery.cloud.ibm.com/v2"
+		var backupRecoveryConnectorURL string
+		var backupRecoveryManagerURL string = "https://manager.backup-recovery.cloud.ibm.com/v2"
+
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			backupRecoveryURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", c.Region, backupRecoveryURL)
+			backupRecoveryConnectorURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT", c.Region, backupRecoveryConnectorURL)
+			backupRecoveryManagerURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT", c.Region, backupRecoveryConnectorURL)
+		}
 
-	// Construct an "options" struct for creating the service client.
-	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
+		backupRecoveryClientOptions := &backuprecoveryv1.BackupRecoveryV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT"}, backupRecoveryURL),
+		}
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
-	}
-	logsClientOptions := &logsv0.LogsV0Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, logsEndpoint),
-	}
+		// Construct the service client.
+		session.backupRecoveryClient, err = backuprecoveryv1.NewBackupRecoveryV1(backupRecoveryClientOptions)
+		if err != nil {
+			session.backupRecoveryClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
+		}
+		if session.backupRecoveryClient != nil && session.backupRecoveryClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("backup_recovery", session.backupRecoveryClient.Service)
+			// Add custom header for analytics
+			session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// Construct the service client.
-	session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.logsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
-	}
+		var backupRecoveryConnectorClientAuthenticator core.Authenticator
+		backupRecoveryConnectorClientAuthenticator = &core.NoAuthAuthenticator{}
 
-	// LOGS ROUTER Version 0
-	var logsrouterClientURL string
-	var logsrouterURLErr error
+		backupRecoveryConnectorClientOptions := &backuprecoveryv1.BackupRecoveryV1ConnectorOptions{
+			ConnectorURL:  EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT"}, backupRecoveryConnectorURL),
+			Authenticator: backupRecoveryConnectorClientAuthenticator,
+		}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsrouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", c.Region, ibmcloudlogsroutingv0.DefaultServiceURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
-	} else {
-		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
-	}
-	if logsrouterURLErr != nil {
-		logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
-	}
-	ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
-		Authenticator: authenticator,
-		URL:           logsrouterClientURL,
-	}
+		// Construct the service connector client.
+		session.backupRecoveryConnectorClient, err = backuprecoveryv1.NewBackupRecoveryV1Connector(backupRecoveryConnectorClientOptions)
+		session.backupRecoveryConnectorClient.Service.DisableSSLVerification()
+		if err != nil {
+			session.backupRecoveryConnectorClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API connector service: %q", err)
+		}
+		if session.backupRecoveryConnectorClient != nil && session.backupRecoveryConnectorClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("backup_recovery", session.backupRecoveryConnectorClient.Service)
+			// Add custom header for analytics
+			session.backupRecoveryConnectorClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// Construct the service client.
-	session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
-	}
+		var backupRecoveryManagerClientAuthenticator core.Authenticator
+		backupRecoveryManagerClientAuthenticator = &core.NoAuthAuthenticator{}
 
-	// LOGS ROUTER V3
-	// Determine the correct region-based endpoint URL to use for the 'Logs Routing API Version 3' service.
-	var logsRouterV3ClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		logsRouterV3ClientURL, err = logsrouterv3.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			logsRouterV3ClientURL, err = logsrouterv3.GetServiceURLForRegion(c.Region)
+		backupRecoveryManagerClientOptions := &backuprecoveryv1.BackupRecoveryManagementSreApiV1Options{
+			Authenticator: backupRecoveryManagerClientAuthenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT"}, backupRecoveryManagerURL),
 		}
-	} else {
-		logsRouterV3ClientURL, err = logsrouterv3.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		// Developer: For some services that support regional endpoints it may be appropriate to use the service's
-		// default endpoint URL if a specific region was not configured by the user, but for other services that
-		// support regional endpoints, it may NOT be appropriate to use the default endpoint URL.
-		//
-		// Choose the appropriate code block below when you copy/paste this code into the official copy of config.go
-		// (https://github.com/IBM-Cloud/terraform-provider-ibm/blob/master/ibm/conns/config.go):
-		//
-		// 1. If it is appropriate to use your service's default endpoint URL if a region-based endpoint URL
-		// cannot be successfully obtained from the user's configuration, then use "Code block 1" and remove
-		// "Code block 2" below.
-		//
-		// 2. If your service should ONLY use a region-based endpoint URL and instead return an error if a
-		// region-based endpoint URL cannot be obtained from the user's configuration, then use "Code block 2"
-		// and remove "Code block 1" below.
-
-		// Code block 1:
-		// If a suitable region-based endpoint URL could not be obtained, fall back to the service's default endpoint URL.
-		logsRouterV3ClientURL = logsrouterv3.DefaultServiceURL
-
-		// Code block 2 removed. Fall back to service's default endpoint URL.
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsRouterV3ClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3", c.Region, logsRouterV3ClientURL)
-	}
+		// Construct the service client.
+		session.backupRecoveryManagerClient, err = backuprecoveryv1.NewBackupRecoveryManagementSreApiV1(backupRecoveryManagerClientOptions)
+		if err != nil {
+			session.backupRecoveryManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
+		}
+		if session.backupRecoveryManagerClient != nil && session.backupRecoveryManagerClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("backup_recovery", session.backupRecoveryManagerClient.Service)
+			// Add custom header for analytics
+			session.backupRecoveryManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct an instance of the 'Logs Routing API Version 3' service.
-	if session.logsRouterClientErr == nil {
-		// Construct the service options.
-		logsRouterClientOptions := &logsrouterv3.LogsRouterV3Options{
+	session.register("project", func() {
+		var err error
+		projectEndpoint := project.DefaultServiceURL
+		// Construct an "options" struct for creating the service client.
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
+		}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
+		}
+		// Construct an "options" struct for creating the service client.
+		projectClientOptions := &project.ProjectV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
 			Authenticator: authenticator,
-			URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3"}, logsRouterV3ClientURL),
 		}
 
 		// Construct the service client.
-		session.logsRouterClient, err = logsrouterv3.NewLogsRouterV3(logsRouterClientOptions)
+		session.projectClient, err = project.NewProjectV1(projectClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.logsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.configureService("project", session.projectClient.Service)
 			// Add custom header for analytics
-			session.logsRouterClient.SetDefaultHeaders(gohttp.Header{
+			session.projectClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.logsRouterClientErr = fmt.Errorf("Error occurred while constructing 'Logs Routing API Version 3' service client: %q", err)
+			session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 		}
-	}
-
-	// Construct an "options" struct for creating the service client.
-	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
-	}
+	})
 
-	// Construct the service client.
-	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ukoClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
-	}
+	session.register("logs", func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
 
-	// APP ID Service
-	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
-	if c.Visibility == "private" {
-		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
-	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
-	}
-	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
-	if err != nil {
-		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
-	}
-	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		appIDClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.appidAPI = appIDClient
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
+		}
+		logsClientOptions := &logsv0.LogsV0Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, logsEndpoint),
+		}
 
-	// Construct an "options" struct for creating Context Based Restrictions service client.
-	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
-			cbrURL = ContructEndpoint(fmt.Sprintf("private.%s.cbr", c.Region), cloudEndpoint)
+		// Construct the service client.
+		session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("logs", session.logsClient.Service)
+			// Add custom header for analytics
+			session.logsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		} else {
-			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
+			session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
-	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
-	}
-
-	// Construct the service client.
-	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
-	if err == nil && session.contextBasedRestrictionsClient != nil {
-		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
-	}
+	})
 
-	// PARTNER CENTER SELL (product lifecycle) service
-	partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
-	if c.Visibility == "private" {
-		session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
-	}
-	partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.partnerCenterSellClient, err = partnercentersellv1.NewPartnerCenterSellV1(partnerCenterSellClientOptions)
-	if err != nil {
-		session.partnerCenterSellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Partner Center Sell API service: %q", err)
-	}
-	if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
-		// Enable retries for API calls
-		session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("logsRouting", func() {
+		var err error
+		// LOGS ROUTER Version 0
+		var logsrouterClientURL string
+		var logsrouterURLErr error
 
-	//Usage Reports Service Client
-	usageReportsURL := usagereportsv4.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			log.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			usageReportsURL = ContructEndpoint("private.us-south.usagereports", fmt.Sprintf("%s/v1", cloudEndpoint))
-		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			logsrouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", c.Region, ibmcloudlogsroutingv0.DefaultServiceURL)
+		} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
 		} else {
-			usageReportsURL = usagereportsv4.DefaultServiceURL
+			logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
-	}
-	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
-	}
-	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
-	if err != nil {
-		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
-	}
-	if usageReportsClient != nil && usageReportsClient.Service != nil {
-		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		usageReportsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.usageReportsClient = usageReportsClient
-
-	// CATALOG MANAGEMENT Service
-	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
-	if c.Visibility == "private" {
-		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
-	if err != nil {
-		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
-	}
-	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// ATRACKER Version 2
-	var atrackerClientV2URL string
-	var atrackerURLV2Err error
-
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+		if logsrouterURLErr != nil {
+			logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
 		}
-	} else {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
-	}
-	if atrackerURLV2Err != nil {
-		atrackerClientV2URL = atrackerv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
-	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
-	// This should technically never happen as we default this for v2
-	if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
-		session.atrackerClientV2Err = atrackerURLV2Err
-	}
-	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
-	if err == nil {
-		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
-	}
-
-	platformNotificationsUrl := platformnotificationsv1.DefaultServiceURL
-	// Construct an instance of the 'Platform Notifications' service.
-	if session.platformNotificationsClientErr == nil {
-		// Construct the service options.
-		platformNotificationsClientOptions := &platformnotificationsv1.PlatformNotificationsV1Options{
+		ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
 			Authenticator: authenticator,
-			URL:           EnvFallBack([]string{"IBMCLOUD_PLATFORM_NOTIFICATIONS_API_ENDPOINT"}, platformNotificationsUrl),
+			URL:           logsrouterClientURL,
 		}
 
 		// Construct the service client.
-		session.platformNotificationsClient, err = platformnotificationsv1.NewPlatformNotificationsV1(platformNotificationsClientOptions)
+		session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.platformNotificationsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.configureService("logs_routing", session.ibmCloudLogsRoutingClient.Service)
 			// Add custom header for analytics
-			session.platformNotificationsClient.SetDefaultHeaders(gohttp.Header{
+			session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.platformNotificationsClientErr = fmt.Errorf("Error occurred while constructing 'Platform Notifications' service client: %q", err)
+			session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
 		}
-	}
-
-	// Construct an "options" struct for creating the service client for Metrics Router
-	var metricsRouterClientURL string
-	var metricsRouterURLV3Err error
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion("private." + c.Region)
-		if metricsRouterURLV3Err != nil && c.Visibility == "public-and-private" {
-			metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
+	session.register("logsRouter", func() {
+		var err error
+		// LOGS ROUTER V3
+		// Determine the correct region-based endpoint URL to use for the 'Logs Routing API Version 3' service.
+		var logsRouterV3ClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			logsRouterV3ClientURL, err = logsrouterv3.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				logsRouterV3ClientURL, err = logsrouterv3.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			logsRouterV3ClientURL, err = logsrouterv3.GetServiceURLForRegion(c.Region)
+		}
+		if err != nil {
+			// Developer: For some services that support regional endpoints it may be appropriate to use the service's
+			// default endpoint URL if a specific region was not configured by the user, but for other services that
+			// support regional endpoints, it may NOT be appropriate to use the default endpoint URL.
+			//
+			// Choose the appropriate code block below when you copy/paste this code into the official copy of config.go
+			// (https://github.com/IBM-Cloud/terraform-provider-ibm/blob/master/ibm/conns/config.go):
+			//
+			// 1. If it is appropriate to use your service's default endpoint URL if a region-based endpoint URL
+			// cannot be successfully obtained from the user's configuration, then use "Code block 1" and remove
+			// "Code block 2" below.
+			//
+			// 2. If your service should ONLY use a region-based endpoint URL and instead return an error if a
+			// region-based endpoint URL cannot be obtained from the user's configuration, then use "Code block 2"
+			// and remove "Code block 1" below.
+
+			// Code block 1:
+			// If a suitable region-based endpoint URL could not be obtained, fall back to the service's default endpoint URL.
+			logsRouterV3ClientURL = logsrouterv3.DefaultServiceURL
+
+			// Code block 2 removed. Fall back to service's default endpoint URL.
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			logsRouterV3ClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3", c.Region, logsRouterV3ClientURL)
 		}
-	} else {
-		metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
-	}
-	if metricsRouterURLV3Err != nil {
-		metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
-	}
-	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
-	}
 
-	// Construct the service client.
-	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
-	}
+		// Construct an instance of the 'Logs Routing API Version 3' service.
+		if session.logsRouterClientErr == nil {
+			// Construct the service options.
+			logsRouterClientOptions := &logsrouterv3.LogsRouterV3Options{
+				Authenticator: authenticator,
+				URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3"}, logsRouterV3ClientURL),
+			}
 
-	// SCC (Security and Compliance Center) Service
-	sccApiClientURL := scc.DefaultServiceURL
-	// Construct the service options.
-	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
-		sccApiClientURL = regionURL
-	}
-	sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
-	}
+			// Construct the service client.
+			session.logsRouterClient, err = logsrouterv3.NewLogsRouterV3(logsRouterClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.configureService("logs_router", session.logsRouterClient.Service)
+				// Add custom header for analytics
+				session.logsRouterClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.logsRouterClientErr = fmt.Errorf("Error occurred while constructing 'Logs Routing API Version 3' service client: %q", err)
+			}
+		}
+	})
 
-	// Construct the service client.
-	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterV3(sccApiClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
-	}
+	session.register("uko", func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		ukoClientOptions := &ukov4.UkoV4Options{
+			Authenticator: authenticator,
+		}
 
-	// SCHEMATICS Service
-	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
-	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
-	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
-	}
-	// Construct the service client.
-	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
-	if err != nil {
-		session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
-	}
-	// Enable retries for API calls
-	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		schematicsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.schematicsClient = schematicsClient
+		// Construct the service client.
+		session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("uko", session.ukoClient.Service)
+			// Add custom header for analytics
+			session.ukoClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		}
+	})
 
-	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcAPI = vpcclient
+	session.register("appid", func() {
+		// APP ID Service
+		appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
+		if c.Visibility == "private" {
+			session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
+		}
+		appIDClientOptions := &appid.AppIDManagementV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		}
+		appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+		if err != nil {
+			session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
+		}
+		if appIDClient != nil && appIDClient.Service != nil {
+			c.configureService("appid", appIDClient.Service)
+			appIDClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.appidAPI = appIDClient
+	})
 
-	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
-	if err != nil {
-		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
-	}
-	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcBetaAPI = vpcbetaclient
+	session.register("contextBasedRestrictions", func() {
+		var err error
+		// Construct an "options" struct for creating Context Based Restrictions service client.
+		cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
+				cbrURL = ContructEndpoint(fmt.Sprintf("private.%s.cbr", c.Region), cloudEndpoint)
+			} else {
+				cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
+		}
+		contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		}
 
-	// PUSH NOTIFICATIONS Service
-	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
-	if c.Visibility == "private" {
-		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
-	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
-	}
-	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
-	if err != nil {
-		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
-	}
-	if pnclient != nil && pnclient.Service != nil {
-		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		pnclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.pushServiceClient = pnclient
+		// Construct the service client.
+		session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
+		if err == nil && session.contextBasedRestrictionsClient != nil {
+			// Enable retries for API calls
+			c.configureService("context_based_restrictions", session.contextBasedRestrictionsClient.Service)
+			// Add custom header for analytics
+			session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		}
+	})
+
+	session.register("partnerCenterSell", func() {
+		var err error
+		// PARTNER CENTER SELL (product lifecycle) service
+		partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
+		if c.Visibility == "private" {
+			session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
+		}
+		partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.partnerCenterSellClient, err = partnercentersellv1.NewPartnerCenterSellV1(partnerCenterSellClientOptions)
+		if err != nil {
+			session.partnerCenterSellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Partner Center Sell API service: %q", err)
+		}
+		if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("partner_center_sell", session.partnerCenterSellClient.Service)
+			// Add custom header for analytics
+			session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// event notifications
-	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+	session.register("usageReports", func() {
+		//Usage Reports Service Client
+		usageReportsURL := usagereportsv4.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				log.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				usageReportsURL = ContructEndpoint("private.us-south.usagereports", fmt.Sprintf("%s/v1", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region),
+					fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				usageReportsURL = usagereportsv4.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
+		}
+		usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+		}
+		usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+		if err != nil {
+			session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+		}
+		if usageReportsClient != nil && usageReportsClient.Service != nil {
+			c.configureService("usage_reports", usageReportsClient.Service)
+			usageReportsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.usageReportsClient = usageReportsClient
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
-	}
+	session.register("catalogManagement", func() {
+		var err error
+		// CATALOG MANAGEMENT Service
+		catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
+		if c.Visibility == "private" {
+			session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
+		}
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
+		}
+		if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("catalog_management", session.catalogManagementClient.Service)
+			// Add custom header for analytics
+			session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
-	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
-	}
-	// Construct the service client.
-	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
-	if err != nil {
-		// Enable {
-		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
-	}
-	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
-		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("atracker", func() {
+		var err error
+		// ATRACKER Version 2
+		var atrackerClientV2URL string
+		var atrackerURLV2Err error
 
-	// APP CONFIGURATION Service
-	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
-	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
-	}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+		}
+		if atrackerURLV2Err != nil {
+			atrackerClientV2URL = atrackerv2.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
+		}
+		atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		}
+		// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
+		// This should technically never happen as we default this for v2
+		if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
+			session.atrackerClientV2Err = atrackerURLV2Err
+		}
+		session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("atracker", session.atrackerClientV2.Service)
+			// Add custom header for analytics
+			session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		}
+	})
 
-	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
-	if appConfigClient != nil {
-		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.appConfigurationClient = appConfigClient
-	} else {
-		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
-	}
+	session.register("platformNotifications", func() {
+		var err error
+		platformNotificationsUrl := platformnotificationsv1.DefaultServiceURL
+		// Construct an instance of the 'Platform Notifications' service.
+		if session.platformNotificationsClientErr == nil {
+			// Construct the service options.
+			platformNotificationsClientOptions := &platformnotificationsv1.PlatformNotificationsV1Options{
+				Authenticator: authenticator,
+				URL:           EnvFallBack([]string{"IBMCLOUD_PLATFORM_NOTIFICATIONS_API_ENDPOINT"}, platformNotificationsUrl),
+			}
 
-	// CONTAINER REGISTRY Service
-	// Construct an "options" struct for creating the service client.
-	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
-	if err != nil {
-		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerRegistryClientURL = strings.Replace(containerRegistryClientURL, "https://", "https://private.", 1)
+			// Construct the service client.
+			session.platformNotificationsClient, err = platformnotificationsv1.NewPlatformNotificationsV1(platformNotificationsClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.configureService("platform_notifications", session.platformNotificationsClient.Service)
+				// Add custom header for analytics
+				session.platformNotificationsClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.platformNotificationsClientErr = fmt.Errorf("Error occurred while constructing 'Platform Notifications' service client: %q", err)
+			}
+		}
+	})
 
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
-	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
-	}
-	// Construct the service client.
-	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
-	if err != nil {
-		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
-	}
-	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+	session.register("metricsRouter", func() {
+		var err error
+		// Construct an "options" struct for creating the service client for Metrics Router
+		var metricsRouterClientURL string
+		var metricsRouterURLV3Err error
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion("private." + c.Region)
+			if metricsRouterURLV3Err != nil && c.Visibility == "public-and-private" {
+				metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
+		}
+		if metricsRouterURLV3Err != nil {
+			metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
+		}
+		metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
+		}
+
+		// Construct the service client.
+		session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("metrics_router", session.metricsRouterClient.Service)
+			// Add custom header for analytics
+			session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
+		}
+	})
+
+	session.register("securityAndComplianceCenter", func() {
+		var err error
+		// SCC (Security and Compliance Center) Service
+		sccApiClientURL := scc.DefaultServiceURL
+		// Construct the service options.
+		if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
+			sccApiClientURL = regionURL
+		}
+		sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
+		}
+
+		// Construct the service client.
+		session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterV3(sccApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("security_and_compliance_center", session.securityAndComplianceCenterClient.Service)
+			// Add custom header for analytics
+			session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
+		}
+	})
+
+	session.register("schematics", func() {
+		// SCHEMATICS Service
+		// schematicsEndpoint := "https://schematics.cloud.ibm.com"
+		schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
+		}
+		schematicsClientOptions := &schematicsv1.SchematicsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		}
+		// Construct the service client.
+		schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+		if err != nil {
+			session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		if schematicsClient != nil && schematicsClient.Service != nil {
+			c.configureService("schematics", schematicsClient.Service)
+			schematicsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.schematicsClient = schematicsClient
+	})
 
-	// OBJECT STORAGE Service
-	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
+	session.register("vpc", func() {
+		// VPC Service
+		vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
+		}
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcclient, err := vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
+		}
+		if vpcclient != nil && vpcclient.Service != nil {
+			c.configureService("vpc", vpcclient.Service)
+			vpcclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcAPI = vpcclient
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
+		vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
+		if err != nil {
+			session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
+		}
+		if vpcbetaclient != nil && vpcbetaclient.Service != nil {
+			c.configureService("vpc", vpcbetaclient.Service)
+			vpcbetaclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcBetaAPI = vpcbetaclient
+	})
 
-	// GLOBAL TAGGING Service
-	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalTaggingRegion string
-		if c.Region != "us-south" && c.Region != "au-syd" && c.Region != "eu-gb" && c.Region != "eu-de" {
-			globalTaggingRegion = "us-south"
+	session.register("pushNotifications", func() {
+		// PUSH NOTIFICATIONS Service
+		pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
+		if c.Visibility == "private" {
+			session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
+		}
+		pushNotificationOptions := &pushservicev1.PushServiceV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+			Authenticator: authenticator,
+		}
+		pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+		if err != nil {
+			session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
+		}
+		if pnclient != nil && pnclient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("push_notifications", pnclient.Service)
+			pnclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.pushServiceClient = pnclient
+	})
+
+	session.register("eventNotifications", func() {
+		var err error
+		// event notifications
+		enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+		}
+
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
+		}
+		enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		}
+		// Construct the service client.
+		session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+		if err != nil {
+			// Enable {
+			session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
+		}
+		if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("event_notifications", session.eventNotificationsApiClient.Service)
+			session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.register("appConfiguration", func() {
+		// APP CONFIGURATION Service
+		appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
+		}
+		appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
+			Authenticator: authenticator,
+		}
+
+		appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
+		if appConfigClient != nil {
+			// Enable retries for API calls
+			c.configureService("app_configuration", appConfigClient.Service)
+			session.appConfigurationClient = appConfigClient
 		} else {
-			globalTaggingRegion = c.Region
+			session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 		}
-		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
-	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
-	}
-	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
-		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// GLOBAL TAGGING Service
-	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalSearchRegion string
-		if c.Region != "us-south" && c.Region != "au-syd" && c.Region != "eu-gb" && c.Region != "eu-de" {
-			globalSearchRegion = "us-south"
+	})
+
+	session.register("containerRegistry", func() {
+		// CONTAINER REGISTRY Service
+		// Construct an "options" struct for creating the service client.
+		containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
+		if err != nil {
+			containerRegistryClientURL = containerregistryv1.DefaultServiceURL
+		}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			containerRegistryClientURL = strings.Replace(containerRegistryClientURL, "https://", "https://private.", 1)
+
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
+		}
+		containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+			Account:       core.StringPtr(userConfig.UserAccount),
+		}
+		// Construct the service client.
+		session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
+		if err != nil {
+			session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
+		}
+		if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("container_registry", session.containerRegistryClient.Service)
+			// Add custom header for analytics
+			session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.register("cosConfig", func() {
+		// OBJECT STORAGE Service
+		cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
+		}
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		}
+		cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err != nil {
+			session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 		} else {
-			globalSearchRegion = c.Region
+			c.configureService("cos_config", cosconfigclient.Service)
 		}
-		globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
-	}
-	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
-	}
-	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
-	if err != nil {
-		session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
-		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cosConfigAPI = cosconfigclient
+	})
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
+	session.register("globalSearch", func() {
+		globalSearchAPI, err := globalsearchv2.New(c.bluemixSession("global_search", sess.BluemixSession))
+		if err != nil {
+			session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		session.globalSearchServiceAPI = globalSearchAPI
+	})
+	session.register("globalTagging", func() {
+		// Global Tagging Bluemix-go
+		globalTaggingAPI, err := globaltaggingv3.New(c.bluemixSession("global_tagging", sess.BluemixSession))
+		if err != nil {
+			session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		session.globalTaggingServiceAPI = globalTaggingAPI
+	})
+
+	session.register("globalTaggingV1", func() {
+		// GLOBAL TAGGING Service
+		globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			var globalTaggingRegion string
+			if c.Region != "us-south" && c.Region != "au-syd" && c.Region != "eu-gb" && c.Region != "eu-de" {
+				globalTaggingRegion = "us-south"
+			} else {
+				globalTaggingRegion = c.Region
+			}
+			globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
+		}
+		globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+			Authenticator: authenticator,
+		}
+		globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
+			session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+			c.configureService("global_tagging_v1", session.globalTaggingServiceAPIV1.Service)
+			session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+	session.register("globalSearchV2", func() {
+		// GLOBAL TAGGING Service
+		globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			var globalSearchRegion string
+			if c.Region != "us-south" && c.Region != "au-syd" && c.Region != "eu-gb" && c.Region != "eu-de" {
+				globalSearchRegion = "us-south"
+			} else {
+				globalSearchRegion = c.Region
+			}
+			globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
+		}
+		globalSearchV2Options := &searchv2.GlobalSearchV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
+			Authenticator: authenticator,
+		}
+		globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+		if err != nil {
+			session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
+			session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+			c.configureService("global_search_v2", session.globalSearchServiceAPIV2.Service)
+			session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	var cloudDatabasesEndpoint string
+	session.register("icd", func() {
+		icdAPI, err := icdv4.New(c.bluemixSession("icd", sess.BluemixSession))
+		if err != nil {
+			session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+		session.icdServiceAPI = icdAPI
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
-	} else {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
-	}
+	session.register("cloudDatabases", func() {
+		var err error
+		var cloudDatabasesEndpoint string
 
-	// Construct an "options" struct for creating the service client.
-	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
-	}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
+		} else {
+			cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
+		}
 
-	// Construct the service client.
-	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
-	}
+		// Construct an "options" struct for creating the service client.
+		cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
+			Authenticator: authenticator,
+		}
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
+		// Construct the service client.
+		session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("cloud_databases", session.cloudDatabasesClient.Service)
+			// Add custom header for analytics
+			session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		}
+	})
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	session.register("resourceCatalog", func() {
+		resourceCatalogAPI, err := catalog.New(c.bluemixSession("resource_catalog", sess.BluemixSession))
+		if err != nil {
+			session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
+		}
+		session.resourceCatalogServiceAPI = resourceCatalogAPI
+	})
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
+	session.register("resourceManagementV2", func() {
+		resourceManagementAPIv2, err := managementv2.New(c.bluemixSession("resource_management_v2", sess.BluemixSession))
+		if err != nil {
+			session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
+		}
+		session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	})
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	session.register("resourceControllerV1", func() {
+		resourceControllerAPI, err := controller.New(c.bluemixSession("resource_controller_v1", sess.BluemixSession))
+		if err != nil {
+			session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		session.resourceControllerServiceAPI = resourceControllerAPI
+	})
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
+	session.register("resourceControllerV2", func() {
+		ResourceControllerAPIv2, err := controllerv2.New(c.bluemixSession("resource_controller_v2", sess.BluemixSession))
+		if err != nil {
+			session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+		session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	})
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
-	}
-	session.functionIAMNamespaceAPI = namespaceFunction
-
-	// POWER SYSTEMS Service
-	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
-	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
-		Debug:         os.Getenv("TF_LOG") != "",
-		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
-		Zone:          c.Zone,
-	}
-	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
-	if err != nil {
-		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
-	}
-	session.ibmpiSession = ibmpisession
+	session.register("userManagement", func() {
+		userManagementAPI, err := usermanagementv2.New(c.bluemixSession("user_management", sess.BluemixSession))
+		if err != nil {
+			session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
+		}
+		session.userManagementAPI = userManagementAPI
+	})
 
-	// PRIVATE DNS Service
-	pdnsURL := dns.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
-	}
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("functionNamespace", func() {
+		namespaceFunction, err := functions.New(c.bluemixSession("function_namespace", sess.BluemixSession))
+		if err != nil {
+			session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
+		}
+		session.functionIAMNamespaceAPI = namespaceFunction
+	})
 
-	// DIRECT LINK Service
-	ver := time.Now().Format("2006-01-02")
-	dlURL := dl.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("power", func() {
+		// POWER SYSTEMS Service
+		piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+		ibmPIOptions := &ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			Debug:         os.Getenv("TF_LOG") != "",
+			Region:        c.Region,
+			URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+			UserAccount:   userConfig.UserAccount,
+			Zone:          c.Zone,
+		}
+		ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+		if err != nil {
+			session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+		}
+		session.ibmpiSession = ibmpisession
+	})
 
-	// DIRECT LINK PROVIDER Service
-	dlproviderURL := dlProviderV2.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("privateDNS", func() {
+		// PRIVATE DNS Service
+		pdnsURL := dns.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
+		}
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+			Authenticator: authenticator,
+		}
+		session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
+		if session.pDNSErr != nil {
+			session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
+		}
+		if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+			c.configureService("private_dns", session.pDNSClient.Service)
+			session.pDNSClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// TRANSIT GATEWAY Service
-	tgURL := tg.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
-		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		// })
-	}
+	session.register("directLink", func() {
+		// DIRECT LINK Service
+		ver := time.Now().Format("2006-01-02")
+		dlURL := dl.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
+		}
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
+		if session.directlinkErr != nil {
+			session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
+		}
+		if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+			c.configureService("direct_link", session.directlinkAPI.Service)
+			session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// Construct an instance of the 'Configuration Aggregator' service.
-	configBaseURL := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		configBaseURL = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		configBaseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, configBaseURL)
-	}
-	configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, configBaseURL),
-	}
+		// DIRECT LINK PROVIDER Service
+		dlproviderURL := dlProviderV2.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
+		}
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if session.dlProviderErr != nil {
+			session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
+		}
+		if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+			c.configureService("direct_link", session.dlProviderAPI.Service)
+			session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct the service client.
-	session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
-	}
+	session.register("transitGateway", func() {
+		// TRANSIT GATEWAY Service
+		tgURL := tg.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
+		}
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if session.transitgatewayErr != nil {
+			session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
+		}
+		if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+			c.configureService("transit_gateway", session.transitgatewayAPI.Service)
+			// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
+			// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			// })
+		}
+	})
 
-	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
-	if session.db2saasClientErr == nil {
-		// Construct the service options.
-		defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
-		db2saasClientOptions := &db2saasv1.Db2saasV1Options{
-			URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
+	session.register("configurationAggregator", func() {
+		var err error
+		// Construct an instance of the 'Configuration Aggregator' service.
+		configBaseURL := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			configBaseURL = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			configBaseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, configBaseURL)
+		}
+		configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
 			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, configBaseURL),
 		}
 
 		// Construct the service client.
-		session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
+		session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.configureService("configuration_aggregator", session.configurationAggregatorClient.Service)
 			// Add custom header for analytics
-			session.db2saasClient.SetDefaultHeaders(gohttp.Header{
+			session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
+			session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
 		}
-	}
+	})
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+	session.register("db2saas", func() {
+		var err error
+		// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
+		if session.db2saasClientErr == nil {
+			// Construct the service options.
+			defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
+			db2saasClientOptions := &db2saasv1.Db2saasV1Options{
+				URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
+				Authenticator: authenticator,
+			}
 
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+			// Construct the service client.
+			session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.configureService("db2saas", session.db2saasClient.Service)
+				// Add custom header for analytics
+				session.db2saasClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
+			}
+		}
+	})
 
-	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("cis", func() {
+		// CIS Service instances starts here.
+		cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+		if c.Visibility == "private" {
+			// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
+			session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+			session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 
-	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
+		}
+		cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
 
-	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Zones service
+		cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
+		if session.cisZonesErr != nil {
+			session.cisZonesErr = fmt.Errorf(
+				"Error occured while configuring CIS Zones service: %s",
+				session.cisZonesErr)
+		}
+		if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
+			c.configureService("cis", session.cisZonesV1Client.Service)
+			session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
-			session.cisGLBPoolErr)
-	}
-	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS DNS Record service
+		cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
+		if session.cisDNSErr != nil {
+			session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
+		}
+		if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
+			c.configureService("cis", session.cisDNSRecordsClient.Service)
+			session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
-			session.cisGLBErr)
-	}
-	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS DNS Record bulk service
+		cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
+		if session.cisDNSBulkErr != nil {
+			session.cisDNSBulkErr = fmt.Errorf(
+				"Error occured while configuration CIS DNS bulk service : %s",
+				session.cisDNSBulkErr)
+		}
+		if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
+			c.configureService("cis", session.cisDNSRecordBulkClient.Service)
+			session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
-			session.cisGLBHealthCheckErr)
-	}
-	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Global load balancer pool
+		cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
+		if session.cisGLBPoolErr != nil {
+			session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
+				session.cisGLBPoolErr)
+		}
+		if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
+			c.configureService("cis", session.cisGLBPoolClient.Service)
+			session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Global load balancer
+		cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
+		session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
+		if session.cisGLBErr != nil {
+			session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
+				session.cisGLBErr)
+		}
+		if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
+			c.configureService("cis", session.cisGLBClient.Service)
+			session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// IBM Network CIS Alerts
-	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
-	if session.cisAlertsErr != nil {
-		session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
-			session.cisAlertsErr)
-	}
-	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Global load balancer health check/monitor
+		cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
+		if session.cisGLBHealthCheckErr != nil {
+			session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
+				session.cisGLBHealthCheckErr)
+		}
+		if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
+			c.configureService("cis", session.cisGLBHealthCheckClient.Service)
+			session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Rulesets
-	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
-	if session.cisRulesetsErr != nil {
-		session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
-			session.cisRulesetsErr)
-	}
-	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
-		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS IP
+		cisIPOpt := &cisipv1.CisIpApiV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
+		if session.cisIPErr != nil {
+			session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
+				session.cisIPErr)
+		}
+		if session.cisIPClient != nil && session.cisIPClient.Service != nil {
+			c.configureService("cis", session.cisIPClient.Service)
+			session.cisIPClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Zone Rate Limit
+		cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
+		if session.cisRLErr != nil {
+			session.cisRLErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Zone Rate Limit service: %s",
+				session.cisRLErr)
+		}
+		if session.cisRLClient != nil && session.cisRLClient.Service != nil {
+			c.configureService("cis", session.cisRLClient.Service)
+			session.cisRLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// IBM Network CIS Alerts
+		cisAlertsOpt := &cisalertsv1.AlertsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
+		if session.cisAlertsErr != nil {
+			session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
+				session.cisAlertsErr)
+		}
+		if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
+			c.configureService("cis", session.cisAlertsClient.Service)
+			session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
-			session.cisEdgeFunctionErr)
-	}
-	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Rulesets
+		cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
+		if session.cisRulesetsErr != nil {
+			session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
+				session.cisRulesetsErr)
+		}
+		if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
+			c.configureService("cis", session.cisRulesetsClient.Service)
+			session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
+		// IBM Network CIS Page Rules
+		cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
+		if session.cisPageRuleErr != nil {
+			session.cisPageRuleErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Page Rule service: %s",
+				session.cisPageRuleErr)
+		}
+		if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
+			c.configureService("cis", session.cisPageRuleClient.Service)
+			session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
-			session.cisSSLErr)
-	}
-	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Edge Function
+		cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
+		if session.cisEdgeFunctionErr != nil {
+			session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
+				session.cisEdgeFunctionErr)
+		}
+		if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
+			c.configureService("cis", session.cisEdgeFunctionClient.Service)
+			session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
-			session.cisWAFPackageErr)
-	}
-	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS SSL certificate
+		cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+
+		session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
+		if session.cisSSLErr != nil {
+			session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
+				session.cisSSLErr)
+		}
+		if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
+			c.configureService("cis", session.cisSSLClient.Service)
+			session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+
+		// IBM Network CIS WAF Package
+		cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
+		if session.cisWAFPackageErr != nil {
+			session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
+				session.cisWAFPackageErr)
+		}
+		if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
+			c.configureService("cis", session.cisWAFPackageClient.Service)
+			session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+
+		// IBM Network CIS Domain settings
+		cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
+		if session.cisDomainSettingsErr != nil {
+			session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
+				session.cisDomainSettingsErr)
+		}
+		if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
+			c.configureService("cis", session.cisDomainSettingsClient.Service)
+			session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+
+		// IBM Network CIS Routing
+		cisRoutingOpt := &cisroutingv1.RoutingV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
+		if session.cisRoutingErr != nil {
+			session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
+				session.cisRoutingErr)
+		}
+		if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
+			c.configureService("cis", session.cisRoutingClient.Service)
+			session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+
+		// IBM Network CIS WAF Group
+		cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
+		if session.cisWAFGroupErr != nil {
+			session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
+				session.cisWAFGroupErr)
+		}
+		if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
+			c.configureService("cis", session.cisWAFGroupClient.Service)
+			session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
-			session.cisDomainSettingsErr)
-	}
-	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Cache service
+		cisCacheOpt := &ciscachev1.CachingApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
+		if session.cisCacheErr != nil {
+			session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
+				session.cisCacheErr)
+		}
+		if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
+			c.configureService("cis", session.cisCacheClient.Service)
+			session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
-			session.cisRoutingErr)
-	}
-	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Custom pages service
+		cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
 
-	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
-			session.cisWAFGroupErr)
-	}
-	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
+		if session.cisCustomPageErr != nil {
+			session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
+				session.cisCustomPageErr)
+		}
+		if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
+			c.configureService("cis", session.cisCustomPageClient.Service)
+			session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
-			session.cisCacheErr)
-	}
-	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Firewall Access rule
+		cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
+		if session.cisAccessRuleErr != nil {
+			session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
+				session.cisAccessRuleErr)
+		}
+		if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
+			c.configureService("cis", session.cisAccessRuleClient.Service)
+			session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
+		// IBM Network CIS Firewall User Agent Blocking rule
+		cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
+		if session.cisUARuleErr != nil {
+			session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
+				session.cisUARuleErr)
+		}
+		if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
+			c.configureService("cis", session.cisUARuleClient.Service)
+			session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
-			session.cisCustomPageErr)
-	}
-	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Firewall Lockdown rule
+		cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
+		if session.cisLockdownErr != nil {
+			session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
+				session.cisLockdownErr)
+		}
+		if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
+			c.configureService("cis", session.cisLockdownClient.Service)
+			session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
-			session.cisAccessRuleErr)
-	}
-	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Range Application rule
+		cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
+		if session.cisRangeAppErr != nil {
+			session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
+				session.cisRangeAppErr)
+		}
+		if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
+			c.configureService("cis", session.cisRangeAppClient.Service)
+			session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-			session.cisUARuleErr)
-	}
-	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS WAF Rule Service
+		cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
+		if session.cisWAFRuleErr != nil {
+			session.cisWAFRuleErr = fmt.Errorf(
+				"Error occured while configuring CIS WAF Rules service: %s",
+				session.cisWAFRuleErr)
+		}
+		if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
+			c.configureService("cis", session.cisWAFRuleClient.Service)
+			session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-			session.cisLockdownErr)
-	}
-	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS LogpushJobs
+		cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Dataset:       core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
+		if session.cisLogpushJobsErr != nil {
+			session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
+				session.cisLogpushJobsErr)
+		}
+		if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
+			c.configureService("cis", session.cisLogpushJobsClient.Service)
+			session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
-			session.cisRangeAppErr)
-	}
-	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM MTLS Session
+		cisMtlsOpt := &cismtlsv1.MtlsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
+		if session.cisMtlsErr != nil {
+			session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
+				session.cisMtlsErr)
+		}
+		if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
+			c.configureService("cis", session.cisMtlsClient.Service)
+			session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Bot Management
+		cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
+		if session.cisBotManagementErr != nil {
+			session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
+				session.cisBotManagementErr)
+		}
+		if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
+			c.configureService("cis", session.cisBotManagementClient.Service)
+			session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS LogpushJobs
-	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
-	if session.cisLogpushJobsErr != nil {
-		session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
-			session.cisLogpushJobsErr)
-	}
-	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Bot Analytics
+		cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
+		if session.cisBotAnalyticsErr != nil {
+			session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
+				session.cisBotAnalyticsErr)
+		}
+		if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
+			c.configureService("cis", session.cisBotAnalyticsClient.Service)
+			session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM MTLS Session
-	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
-	if session.cisMtlsErr != nil {
-		session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
-			session.cisMtlsErr)
-	}
-	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Webhooks
+		cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
+		if session.cisWebhooksErr != nil {
+			session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
+				session.cisWebhooksErr)
+		}
+		if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
+			c.configureService("cis", session.cisWebhooksClient.Service)
+			session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// IBM Network CIS Filters
+		cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
+		if session.cisFiltersErr != nil {
+			session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
+				session.cisFiltersErr)
+		}
+		if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+			c.configureService("cis", session.cisFiltersClient.Service)
+			session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Bot Management
-	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
-	if session.cisBotManagementErr != nil {
-		session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
-			session.cisBotManagementErr)
-	}
-	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Firewall rules
+		cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
+		if session.cisFirewallRulesErr != nil {
+			session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
+				session.cisFirewallRulesErr)
+		}
+		if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+			c.configureService("cis", session.cisFirewallRulesClient.Service)
+			session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Bot Analytics
-	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
-	if session.cisBotAnalyticsErr != nil {
-		session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
-			session.cisBotAnalyticsErr)
-	}
-	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Authenticated Origin Pull
+		cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
 
-	// IBM Network CIS Webhooks
-	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
-	if session.cisWebhooksErr != nil {
-		session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
-			session.cisWebhooksErr)
-	}
-	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// IBM Network CIS Filters
-	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
-	if session.cisFiltersErr != nil {
-		session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
-			session.cisFiltersErr)
-	}
-	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
+		if session.cisOriginAuthPullErr != nil {
+			session.cisOriginAuthPullErr = fmt.Errorf(
+				"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
+				session.cisOriginAuthPullErr)
+		}
+		if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
+			c.configureService("cis", session.cisOriginAuthClient.Service)
+			session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
 
-	// IBM Network CIS Firewall rules
-	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
-	if session.cisFirewallRulesErr != nil {
-		session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
-			session.cisFirewallRulesErr)
-	}
-	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// IBM Network CIS Lists
+		cisListsOpt := &cislistsapiv1.ListsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+			ItemID:        core.StringPtr(""),
+			ListID:        core.StringPtr(""),
+			OperationID:   core.StringPtr(""),
+		}
+		session.cisListsClient, session.cisListsErr = cislistsapiv1.NewListsApiV1(cisListsOpt)
+		if session.cisListsErr != nil {
+			session.cisListsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Lists : %s",
+				session.cisListsErr)
+		}
+		if session.cisListsClient != nil && session.cisListsClient.Service != nil {
+			c.configureService("cis", session.cisListsClient.Service)
+			session.cisListsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// IBM Network CIS Authenticated Origin Pull
-	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
+	session.register("iamIdentity", func() {
+		// IAM IDENTITY Service
+		// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+		iamIdenityURL := iamidentity.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
+		}
+		// ACCOUNT MANAGEMENT Service
+		accountManagementURL := accountmanagementv4.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				accountManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			}
+		}
+		accountManagementOptions := &accountmanagementv4.AccountManagementV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"}, accountManagementURL),
+		}
+		accountManagementClient, err := accountmanagementv4.NewAccountManagementV4(accountManagementOptions)
+		if err != nil {
+			session.accountManagementErr = fmt.Errorf("[ERROR] Error occurred while configuring Account Management service: %q", err)
+		}
+		if accountManagementClient != nil && accountManagementClient.Service != nil {
+			c.configureService("iam_identity", accountManagementClient.Service)
+			accountManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.accountManagementAPI = accountManagementClient
 
-	session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
-	if session.cisOriginAuthPullErr != nil {
-		session.cisOriginAuthPullErr = fmt.Errorf(
-			"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
-			session.cisOriginAuthPullErr)
-	}
-	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		iamIdentityOptions := &iamidentity.IamIdentityV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		}
+		iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
+		if err != nil {
+			session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
+		}
+		if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+			c.configureService("iam_identity", iamIdentityClient.Service)
+			iamIdentityClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamIdentityAPI = iamIdentityClient
+	})
 
-	// IBM Network CIS Lists
-	cisListsOpt := &cislistsapiv1.ListsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-		ItemID:        core.StringPtr(""),
-		ListID:        core.StringPtr(""),
-		OperationID:   core.StringPtr(""),
-	}
-	session.cisListsClient, session.cisListsErr = cislistsapiv1.NewListsApiV1(cisListsOpt)
-	if session.cisListsErr != nil {
-		session.cisListsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Lists : %s",
-			session.cisListsErr)
-	}
-	if session.cisListsClient != nil && session.cisListsClient.Service != nil {
-		session.cisListsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisListsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("iamPolicyManagement", func() {
+		// IAM POLICY MANAGEMENT Service
+		iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
+		}
+		iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		}
+		iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
+		if err != nil {
+			session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
+		}
+		if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+			c.configureService("iam_policy_management", iamPolicyManagementClient.Service)
+			iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamPolicyManagementAPI = iamPolicyManagementClient
+	})
 
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdenityURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+	session.register("iamAccessGroups", func() {
+		// IAM ACCESS GROUP
+		iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
-	// ACCOUNT MANAGEMENT Service
-	accountManagementURL := accountmanagementv4.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			accountManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
 		}
-	}
-	accountManagementOptions := &accountmanagementv4.AccountManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"}, accountManagementURL),
-	}
-	accountManagementClient, err := accountmanagementv4.NewAccountManagementV4(accountManagementOptions)
-	if err != nil {
-		session.accountManagementErr = fmt.Errorf("[ERROR] Error occurred while configuring Account Management service: %q", err)
-	}
-	if accountManagementClient != nil && accountManagementClient.Service != nil {
-		accountManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		accountManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.accountManagementAPI = accountManagementClient
+		iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		}
+		iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
+		if err != nil {
+			session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
+		}
+		if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+			c.configureService("iam_access_groups", iamAccessGroupsClient.Service)
+			iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamAccessGroupsAPI = iamAccessGroupsClient
+	})
 
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
-	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
-	}
-	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamIdentityAPI = iamIdentityClient
+	session.register("resourceManager", func() {
+		// RESOURCE MANAGEMENT Service
+		rmURL := resourcemanager.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				rmURL = resourcemanager.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
+		}
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		}
+		resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
+		}
+		if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+			c.configureService("resource_manager", resourceManagerClient.Service)
+			resourceManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceManagerAPI = resourceManagerClient
+	})
 
-	// IAM POLICY MANAGEMENT Service
-	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
+	session.register("cloudShell", func() {
+		var err error
+		// CLOUD SHELL Service
+		cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
-	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
-	}
-	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
-	if err != nil {
-		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
-	}
-	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamPolicyManagementAPI = iamPolicyManagementClient
+		ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		}
+		session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+		if err != nil {
+			session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
+		}
+		if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+			c.configureService("cloud_shell", session.ibmCloudShellClient.Service)
+			session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// IAM ACCESS GROUP
-	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
+	session.register("enterpriseManagement", func() {
+		// ENTERPRISE Service
+		enterpriseURL := enterprisemanagementv1.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
+				enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+			}
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
-	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
-	}
-	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
-	if err != nil {
-		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
-	}
-	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamAccessGroupsAPI = iamAccessGroupsClient
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
+				enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
+					fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
+		}
+		enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		}
+		enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
+		if err != nil {
+			session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
+		}
+		if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+			c.configureService("enterprise_management", enterpriseManagementClient.Service)
+			enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.enterpriseManagementClient = enterpriseManagementClient
+	})
 
-	// RESOURCE MANAGEMENT Service
-	rmURL := resourcemanager.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+	session.register("resourceController", func() {
+		// RESOURCE CONTROLLER Service
+		rcURL := resourcecontroller.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+			}
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			rmURL = resourcemanager.DefaultServiceURL
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+			} else {
+				rcURL = resourcecontroller.DefaultServiceURL
+			}
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceManagerAPI = resourceManagerClient
-
-	// CLOUD SHELL Service
-	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
-	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
-	}
-	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
-	if err != nil {
-		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
-	}
-	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// ENTERPRISE Service
-	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+		resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
-	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
-	}
-	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
-	if err != nil {
-		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
-	}
-	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.enterpriseManagementClient = enterpriseManagementClient
+		resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
+		if err != nil {
+			session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+			c.configureService("resource_controller", resourceControllerClient.Service)
+			resourceControllerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceControllerAPI = resourceControllerClient
+	})
 
-	// RESOURCE CONTROLLER Service
-	rcURL := resourcecontroller.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+	session.register("drAutomation", func() {
+		var err error
+		// Construct an instance of the 'DrAutomation Service' service.
+		if session.drAutomationServiceClientErr == nil {
+			// Construct the service options.
+			drAutomationServiceClientOptions := &drautomationservicev1.DrAutomationServiceV1Options{
+				Authenticator: authenticator,
+			}
+
+			// Construct the service client.
+			session.drAutomationServiceClient, err = drautomationservicev1.NewDrAutomationServiceV1(drAutomationServiceClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.configureService("dr_automation", session.drAutomationServiceClient.Service)
+				// Add custom header for analytics
+				session.drAutomationServiceClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.drAutomationServiceClientErr = fmt.Errorf("error occurred while constructing 'DrAutomation Service' service client: %q", err)
+			}
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+	})
+
+	session.register("secretsManager", func() {
+		var err error
+		// SECRETS MANAGER Service V2
+		// Construct an "options" struct for creating the service client.
+		var smBaseUrl string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			smBaseUrl = ContructEndpoint(fmt.Sprintf("private.secrets-manager.%s", c.Region), cloudEndpoint)
 		} else {
-			rcURL = resourcecontroller.DefaultServiceURL
+			smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
-	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
-	}
-	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
-	if err != nil {
-		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceControllerAPI = resourceControllerClient
 
-	// Construct an instance of the 'DrAutomation Service' service.
-	if session.drAutomationServiceClientErr == nil {
-		// Construct the service options.
-		drAutomationServiceClientOptions := &drautomationservicev1.DrAutomationServiceV1Options{
+		secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
 			Authenticator: authenticator,
+			URL:           smBaseUrl,
 		}
 
 		// Construct the service client.
-		session.drAutomationServiceClient, err = drautomationservicev1.NewDrAutomationServiceV1(drAutomationServiceClientOptions)
+		session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 		if err == nil {
 			// Enable retries for API calls
-			session.drAutomationServiceClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.configureService("secrets_manager", session.secretsManagerClient.Service)
 			// Add custom header for analytics
-			session.drAutomationServiceClient.SetDefaultHeaders(gohttp.Header{
+			session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.drAutomationServiceClientErr = fmt.Errorf("error occurred while constructing 'DrAutomation Service' service client: %q", err)
+			session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
 		}
-	}
-
-	// SECRETS MANAGER Service V2
-	// Construct an "options" struct for creating the service client.
-	var smBaseUrl string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		smBaseUrl = ContructEndpoint(fmt.Sprintf("private.secrets-manager.%s", c.Region), cloudEndpoint)
-	} else {
-		smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
-	}
+	})
 
-	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
-		Authenticator: authenticator,
-		URL:           smBaseUrl,
-	}
+	session.register("satellite", func() {
+		var err error
+		// SATELLITE Service
+		containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
+		}
+		kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+		if err != nil {
+			session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
+		}
 
-	// Construct the service client.
-	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
-	if err == nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
-	}
-
-	// SATELLITE Service
-	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
-	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
-	if err != nil {
-		session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
-	}
-
-	// Enable retries for API calls
-	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+			c.configureService("satellite", session.satelliteClient.Service)
+			session.satelliteClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// SATELLITE LINK Service
-	// Construct an "options" struct for creating the service client.
-	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
-	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
-	if err != nil {
-		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
-	}
-	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
-		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("satelliteLink", func() {
+		var err error
+		// SATELLITE LINK Service
+		// Construct an "options" struct for creating the service client.
+		satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
+		}
+		satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+		if err != nil {
+			session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
+		}
+		if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("satellite_link", session.satelliteLinkClient.Service)
+			// Add custom header for analytics
+			session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
-	}
-	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
-	if err != nil {
-		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
-	}
-	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("eventStreamsSchemaRegistry", func() {
+		var err error
+		esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
+			Authenticator: authenticator,
+		}
+		session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+		if err != nil {
+			session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
+		}
+		if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+			c.configureService("event_streams_schema_registry", session.esSchemaRegistryClient.Service)
+			session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
-		Authenticator: authenticator,
-	}
-	session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
-	if err != nil {
-		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
-	}
-	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
-		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.register("eventStreamsAdminRest", func() {
+		var err error
+		esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
+			Authenticator: authenticator,
+		}
+		session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
+		if err != nil {
+			session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
+		}
+		if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
+			c.configureService("event_streams_admin_rest", session.esAdminRestClient.Service)
+			session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct an "options" struct for creating the service client.
-	var cdToolchainClientURL string
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+	session.register("cdToolchain", func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		var cdToolchainClientURL string
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+		} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
-	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
-	}
+		if err != nil {
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
+		}
+		cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
+		}
 
-	// Construct the service client.
-	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
+		// Construct the service client.
+		session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("cd_toolchain", session.cdToolchainClient.Service)
+			// Add custom header for analytics
+			session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
+		}
+	})
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
-	var cdTektonPipelineClientURL string
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+	session.register("cdTektonPipeline", func() {
+		var err error
+		// Construct an "options" struct for creating the tekton pipeline service client.
+		var cdTektonPipelineClientURL string
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+		} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
-	}
-	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
-	}
-	// Construct the service client.
-	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
-	}
-
-	// MQaaS Service Configuration
-	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
-	}
-
-	mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
-	}
+		if err != nil {
+			cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
+		}
+		cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		}
+		// Construct the service client.
+		session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.configureService("cd_tekton_pipeline", session.cdTektonPipelineClient.Service)
+			// Add custom header for analytics
+			session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
+		}
+	})
 
-	// Construct the service client for MQ SaaS.
-	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.mqcloudClientErr = fmt.Errorf("Error occurred while constructing 'MQ SaaS' service client: %q", err)
-	}
+	session.register("mqcloud", func() {
+		var err error
+		// MQaaS Service Configuration
+		mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
+		}
 
-	// VMware Cloud Foundation as a Service
-	// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
-	if session.vmwareClientErr == nil {
-		// Construct the service options.
-		vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
-		vmwareClientOptions := &vmwarev1.VmwareV1Options{
+		mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
 			Authenticator: authenticator,
-			URL:           EnvFallBack([]string{"IBMCLOUD_VMWARE_URL"}, vmwareURL),
+			URL:           EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
 		}
 
-		// Construct the service client.
-		session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
+		// Construct the service client for MQ SaaS.
+		session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.configureService("mqcloud", session.mqcloudClient.Service)
 			// Add custom header for analytics
-			session.vmwareClient.SetDefaultHeaders(gohttp.Header{
+			session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
+			session.mqcloudClientErr = fmt.Errorf("Error occurred while constructing 'MQ SaaS' service client: %q", err)
 		}
-	}
+	})
 
-	// Construct the service options.
-	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
-	}
-	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
-		Version:       core.StringPtr("2026-02-20"),
-	}
+	session.register("vmware", func() {
+		var err error
+		// VMware Cloud Foundation as a Service
+		// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
+		if session.vmwareClientErr == nil {
+			// Construct the service options.
+			vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
+			vmwareClientOptions := &vmwarev1.VmwareV1Options{
+				Authenticator: authenticator,
+				URL:           EnvFallBack([]string{"IBMCLOUD_VMWARE_URL"}, vmwareURL),
+			}
 
-	// Construct the service client.
-	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
-	}
+			// Construct the service client.
+			session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.configureService("vmware", session.vmwareClient.Service)
+				// Add custom header for analytics
+				session.vmwareClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
+			}
+		}
+	})
 
-	// Construct an instance of the 'sdsaas' service.
-	if session.sdsaasClientErr == nil {
+	session.register("codeEngine", func() {
+		var err error
 		// Construct the service options.
-		sdsaasClientOptions := &sdsaasv1.SdsaasV1Options{
+		codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
+		}
+		codeEngineClientOptions := &codeengine.CodeEngineV2Options{
 			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
+			Version:       core.StringPtr("2026-02-20"),
 		}
 
 		// Construct the service client.
-		session.sdsaasClient, err = sdsaasv1.NewSdsaasV1(sdsaasClientOptions)
+		session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.sdsaasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.configureService("code_engine", session.codeEngineClient.Service)
 			// Add custom header for analytics
-			session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
+			session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
+			session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 		}
-	}
+	})
 
-	// CATALOG MANAGEMENT Service
-	globalcatalogURL := globalcatalogv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			globalcatalogURL = ContructEndpoint(fmt.Sprintf("private.%s.globalcatalog", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			globalcatalogURL = ContructEndpoint("private.us-south.globalcatalog", fmt.Sprintf("%s", cloudEndpoint))
+	session.register("sdsaas", func() {
+		var err error
+		// Construct an instance of the 'sdsaas' service.
+		if session.sdsaasClientErr == nil {
+			// Construct the service options.
+			sdsaasClientOptions := &sdsaasv1.SdsaasV1Options{
+				Authenticator: authenticator,
+			}
+
+			// Construct the service client.
+			session.sdsaasClient, err = sdsaasv1.NewSdsaasV1(sdsaasClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.configureService("sdsaas", session.sdsaasClient.Service)
+				// Add custom header for analytics
+				session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
+			}
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalcatalogURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", c.Region, globalcatalogURL)
-	}
-	gurl := EnvFallBack([]string{"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"}, globalcatalogURL)
-	parsedURL, err := url.Parse(gurl)
-	if err != nil {
-		fmt.Println("Error parsing global catalog url:", err)
-	}
-	if parsedURL.Path == "" {
-		globalURL, err := url.Parse(globalcatalogv1.DefaultServiceURL)
+	})
+
+	session.register("globalCatalog", func() {
+		// CATALOG MANAGEMENT Service
+		globalcatalogURL := globalcatalogv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				globalcatalogURL = ContructEndpoint(fmt.Sprintf("private.%s.globalcatalog", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				globalcatalogURL = ContructEndpoint("private.us-south.globalcatalog", fmt.Sprintf("%s", cloudEndpoint))
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalcatalogURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", c.Region, globalcatalogURL)
+		}
+		gurl := EnvFallBack([]string{"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"}, globalcatalogURL)
+		parsedURL, err := url.Parse(gurl)
 		if err != nil {
-			fmt.Println("Error parsing global catalog default url:", err)
+			fmt.Println("Error parsing global catalog url:", err)
 		}
-		gurl = gurl + globalURL.Path
-	}
-	globalCatalogClientOptions := &globalcatalogv1.GlobalCatalogV1Options{
-		URL:           gurl,
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.globalCatalogClient, err = globalcatalogv1.NewGlobalCatalogV1(globalCatalogClientOptions)
-	if err != nil {
-		session.globalCatalogClientErr = fmt.Errorf("[ERROR] Error occurred while configuring global catalog API service: %q", err)
-	}
-	if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
-		// Enable retries for API calls
-		session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		if parsedURL.Path == "" {
+			globalURL, err := url.Parse(globalcatalogv1.DefaultServiceURL)
+			if err != nil {
+				fmt.Println("Error parsing global catalog default url:", err)
+			}
+			gurl = gurl + globalURL.Path
+		}
+		globalCatalogClientOptions := &globalcatalogv1.GlobalCatalogV1Options{
+			URL:           gurl,
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.globalCatalogClient, err = globalcatalogv1.NewGlobalCatalogV1(globalCatalogClientOptions)
+		if err != nil {
+			session.globalCatalogClientErr = fmt.Errorf("[ERROR] Error occurred while configuring global catalog API service: %q", err)
+		}
+		if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
+			// Enable retries for API calls
+			c.configureService("global_catalog", session.globalCatalogClient.Service)
+			// Add custom header for analytics
+			session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	if os.Getenv("TF_LOG") != "" {
 		logDestination := log.Writer()
@@ -3932,6 +4276,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// setting UserAgent for vpc-go-sdk common
 	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
+
+	if err := c.validateServiceRetryPolicies(session); err != nil {
+		return nil, err
+	}
+	if err := c.configureKeyProtect(); err != nil {
+		return nil, err
+	}
 	return session, nil
 }
 
@@ -3965,6 +4316,9 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		softlayerSession.UserName = c.SoftLayerUserName
 	}
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
+	if cassette := httpCassetteFromEnv(); cassette != nil {
+		softlayerSession.HTTPClient = cassette.WrapClient(&gohttp.Client{Timeout: c.SoftLayerTimeout})
+	}
 	ibmSession.SoftLayerSession = softlayerSession
 
 	var authenticator core.Authenticator
@@ -4004,6 +4358,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				SetApiKey(c.BluemixAPIKey).
 				SetIAMProfileID(c.IAMTrustedProfileID).
 				SetURL(iamURL).
+				SetClient(httpCassetteFromEnv().Client()).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
@@ -4015,6 +4370,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				SetIAMProfileName(c.IAMTrustedProfileName).
 				SetIAMAccountID(c.Account).
 				SetURL(iamURL).
+				SetClient(httpCassetteFromEnv().Client()).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
@@ -4028,6 +4384,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				URL:          iamURL,
 				ClientId:     "bx",
 				ClientSecret: "bx",
+				Client:       httpCassetteFromEnv().Client(),
 			}
 		} else {
 			// Construct the IamAuthenticator with the IAM refresh token.
@@ -4037,6 +4394,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				ClientId:     "bx",
 				ClientSecret: "bx",
 				URL:          iamURL,
+				Client:       httpCassetteFromEnv().Client(),
 			}
 		}
 	} else if strings.HasPrefix(c.IAMToken, "Bearer") {
@@ -4069,6 +4427,9 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		UserAgent:           fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		Authenticator:       authenticator,
 	}
+	if cassette := httpCassetteFromEnv(); cassette != nil {
+		bmxConfig.HTTPClient = cassette.WrapClient(http.NewHTTPClient(bmxConfig))
+	}
 	sess, err = bxsession.New(bmxConfig)
 	if err != nil {
 		return nil, fileMap, err
@@ -4227,13 +4588,15 @@ func DefaultTransport() gohttp.RoundTripper {
 			InsecureSkipVerify: false,
 		},
 	}
-	return transport
+	return httpCassetteFromEnv().Transport(transport)
 }
 
 func isRetryable(err error) bool {
 	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
+		switch code := bmErr.StatusCode(); {
+		case code == 408, code == 429:
+			return true
+		case code >= 500 && code != 501:
 			return true
 		}
 	}
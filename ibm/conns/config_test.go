@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestClientSessionLazyClients(t *testing.T) {
+	session := &clientSession{
+		lazyClients: map[string]*lazyClient{},
+	}
+
+	calls := 0
+	session.register("vpc", func() {
+		calls++
+		session.vpcErr = errors.New("vpc")
+	})
+
+	if calls != 0 {
+		t.Fatalf("client was built while registering it")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := session.VpcV1API(); err == nil || err.Error() != "vpc" {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("client was built %d times, expected once", calls)
+	}
+}
+
+func TestClientSessionUnregisteredClient(t *testing.T) {
+	session := &clientSession{
+		lazyClients: map[string]*lazyClient{},
+		vpcErr:      errEmptyBluemixCredentials,
+	}
+
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	gohttp "net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryMinDelay is the default minimum backoff between two attempts of an API call.
+const RetryMinDelay = 1 * time.Second
+
+// RetryPolicy describes how failed API calls are retried. Zero values are inherited: a service policy
+// inherits from the provider policy, which inherits from RetryCount and RetryDelay of the Config.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of an API call, including the first one.
+	MaxAttempts int
+	// MinBackoff is the backoff after the first failed attempt. It doubles after every attempt.
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff between two attempts, including the wait requested by Retry-After.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the HTTP status codes that are retried. When empty, 429 and all 5xx
+	// status codes except 501 are retried.
+	RetryableStatusCodes []int
+}
+
+func (p RetryPolicy) isZero() bool {
+	return p.MaxAttempts == 0 && p.MinBackoff == 0 && p.MaxBackoff == 0 && len(p.RetryableStatusCodes) == 0
+}
+
+// merge returns the policy with its zero values taken from parent.
+func (p RetryPolicy) merge(parent RetryPolicy) RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = parent.MaxAttempts
+	}
+	if p.MinBackoff == 0 {
+		p.MinBackoff = parent.MinBackoff
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = parent.MaxBackoff
+	}
+	if len(p.RetryableStatusCodes) == 0 {
+		p.RetryableStatusCodes = parent.RetryableStatusCodes
+	}
+	return p
+}
+
+// retryPolicy returns the retry policy of a service. Services are named like the overrides of the
+// provider retry block, for example "vpc" or "iam_identity".
+func (c *Config) retryPolicy(service string) RetryPolicy {
+	policy := c.RetryPolicy.merge(RetryPolicy{
+		MaxAttempts: c.RetryCount + 1,
+		MinBackoff:  RetryMinDelay,
+		MaxBackoff:  c.RetryDelay,
+	})
+	if override, ok := c.ServiceRetryPolicies[service]; ok {
+		policy = override.merge(policy)
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = policy.MinBackoff
+	}
+	return policy
+}
+
+// unsupportedRetryServices are the services of the session whose SDK can't be given a retry policy,
+// with the reason.
+var unsupportedRetryServices = map[string]string{
+	"function": "the Cloud Functions client does not retry failed calls",
+	"power":    "the Power SDK does not accept an HTTP client",
+}
+
+// validateServiceRetryPolicies checks that every service override names a service of the session that
+// supports a retry policy. The overrides name the services in snake case, for example "iam_identity"
+// for the "iamIdentity" clients.
+func (c *Config) validateServiceRetryPolicies(session *clientSession) error {
+	services := make(map[string]bool, len(session.lazyClients))
+	for name := range session.lazyClients {
+		if service := retryServiceName(name); unsupportedRetryServices[service] == "" {
+			services[service] = true
+		}
+	}
+	for service := range c.ServiceRetryPolicies {
+		if reason, ok := unsupportedRetryServices[service]; ok {
+			return fmt.Errorf("[ERROR] The retry policy of the %q service can't be overridden in the retry block, %s", service, reason)
+		}
+		if !services[service] {
+			names := make([]string, 0, len(services))
+			for name := range services {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return fmt.Errorf("[ERROR] Unknown service %q in the retry block, expected one of: %s", service, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// retryServiceName returns the snake case name of a lazily built service, for example "private_dns"
+// for "privateDNS" or "global_tagging_v1" for "globalTaggingV1".
+func retryServiceName(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// configureService applies the provider wide settings to the client of a go-sdk-core based service.
+func (c *Config) configureService(name string, service *core.BaseService) {
+	service.EnableRetries(0, 0)
+	if transport, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		c.retryPolicy(name).apply(transport.Client)
+	}
+	httpCassetteFromEnv().WrapService(service)
+}
+
+// bluemixSession returns the session of a bluemix-go based service. When the retry block overrides the
+// service, the session is a copy with the attempts of the service policy. bluemix-go waits a fixed
+// delay between two attempts, which is MaxBackoff, and retries its own set of errors.
+func (c *Config) bluemixSession(name string, sess *bxsession.Session) *bxsession.Session {
+	if _, ok := c.ServiceRetryPolicies[name]; !ok || sess == nil || sess.Config == nil {
+		return sess
+	}
+	policy := c.retryPolicy(name)
+	config := sess.Config.Copy()
+	maxRetries := policy.MaxAttempts - 1
+	config.MaxRetries = &maxRetries
+	config.RetryDelay = &policy.MaxBackoff
+	return &bxsession.Session{Config: config}
+}
+
+var keyProtectRetryOnce sync.Once
+
+// configureKeyProtect applies the retry policy to the Key Protect clients. The Key Protect SDK only
+// supports process wide retry settings, so the key_protect and key_management clients share one
+// policy, which is set once by the first provider that is configured.
+func (c *Config) configureKeyProtect() error {
+	keyProtect, hasKeyProtect := c.ServiceRetryPolicies["key_protect"]
+	keyManagement, hasKeyManagement := c.ServiceRetryPolicies["key_management"]
+	if hasKeyProtect && hasKeyManagement && !reflect.DeepEqual(keyProtect, keyManagement) {
+		return fmt.Errorf("[ERROR] The key_protect and key_management services of the retry block must have the same settings, the Key Protect SDK only supports one retry policy")
+	}
+	// Without a retry block the SDK keeps its own defaults
+	if !hasKeyProtect && !hasKeyManagement && c.RetryPolicy.isZero() {
+		return nil
+	}
+	name := "key_protect"
+	if !hasKeyProtect && hasKeyManagement {
+		name = "key_management"
+	}
+	policy := c.retryPolicy(name)
+	keyProtectRetryOnce.Do(func() {
+		kp.RetryMax = policy.MaxAttempts - 1
+		kp.RetryWaitMax = policy.MaxBackoff
+	})
+	return nil
+}
+
+// apply configures a retryable HTTP client with the policy.
+func (p RetryPolicy) apply(client *retryablehttp.Client) {
+	client.RetryMax = p.MaxAttempts - 1
+	client.RetryWaitMin = p.MinBackoff
+	client.RetryWaitMax = p.MaxBackoff
+	client.CheckRetry = p.checkRetry
+	client.Backoff = retryBackoff
+}
+
+// isRetryableStatusCode reports whether a response with the status code is retried.
+func (p RetryPolicy) isRetryableStatusCode(statusCode int) bool {
+	if len(p.RetryableStatusCodes) == 0 {
+		return statusCode == gohttp.StatusTooManyRequests || (statusCode >= 500 && statusCode != gohttp.StatusNotImplemented)
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RetryPolicy) checkRetry(ctx context.Context, resp *gohttp.Response, err error) (bool, error) {
+	if ctx.Err() != nil || err != nil || resp == nil {
+		return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	}
+	return p.isRetryableStatusCode(resp.StatusCode), nil
+}
+
+// retryBackoff honors the Retry-After header of a response and otherwise backs off exponentially
+// with jitter, so that concurrent requests hitting a rate limit do not retry in lockstep.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *gohttp.Response) time.Duration {
+	if resp != nil {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			var wait time.Duration
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				wait = time.Duration(seconds) * time.Second
+			} else if date, err := gohttp.ParseTime(retryAfter); err == nil {
+				wait = time.Until(date)
+			}
+			if wait > max {
+				wait = max
+			}
+			if wait > 0 {
+				return wait
+			}
+		}
+	}
+
+	backoff := max
+	if attemptNum < 32 {
+		if exp := min * time.Duration(1<<uint(attemptNum)); exp > 0 && exp < max {
+			backoff = exp
+		}
+	}
+	// Wait between half and all of the exponential backoff
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
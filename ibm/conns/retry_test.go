@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	gohttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func TestRetryPolicyInheritance(t *testing.T) {
+	c := &Config{
+		RetryCount: 10,
+		RetryDelay: RetryAPIDelay,
+		RetryPolicy: RetryPolicy{
+			MaxBackoff: 30 * time.Second,
+		},
+		ServiceRetryPolicies: map[string]RetryPolicy{
+			"vpc": {
+				MaxAttempts:          3,
+				RetryableStatusCodes: []int{429},
+			},
+		},
+	}
+
+	policy := c.retryPolicy("iam_identity")
+	if policy.MaxAttempts != 11 || policy.MinBackoff != RetryMinDelay || policy.MaxBackoff != 30*time.Second {
+		t.Fatalf("unexpected provider policy: %+v", policy)
+	}
+	if !policy.isRetryableStatusCode(503) || policy.isRetryableStatusCode(501) || policy.isRetryableStatusCode(404) {
+		t.Fatalf("unexpected default retryable status codes")
+	}
+
+	policy = c.retryPolicy("vpc")
+	if policy.MaxAttempts != 3 || policy.MaxBackoff != 30*time.Second {
+		t.Fatalf("unexpected service policy: %+v", policy)
+	}
+	if !policy.isRetryableStatusCode(429) || policy.isRetryableStatusCode(503) {
+		t.Fatalf("unexpected service retryable status codes")
+	}
+	retry, err := policy.checkRetry(context.Background(), &gohttp.Response{StatusCode: 429}, nil)
+	if err != nil || !retry {
+		t.Fatalf("expected 429 to be retried, got %t %v", retry, err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	resp := &gohttp.Response{Header: gohttp.Header{"Retry-After": []string{"7"}}}
+	if wait := retryBackoff(time.Second, 30*time.Second, 0, resp); wait != 7*time.Second {
+		t.Fatalf("expected Retry-After to be honored, got %s", wait)
+	}
+	if wait := retryBackoff(time.Second, 5*time.Second, 0, resp); wait != 5*time.Second {
+		t.Fatalf("expected Retry-After to be capped, got %s", wait)
+	}
+
+	for attempt := 0; attempt < 40; attempt++ {
+		wait := retryBackoff(time.Second, 30*time.Second, attempt, nil)
+		expected := 30 * time.Second
+		if attempt < 5 {
+			expected = time.Second << uint(attempt)
+		}
+		if wait < expected/2 || wait > expected {
+			t.Fatalf("backoff %s of attempt %d is outside [%s, %s]", wait, attempt, expected/2, expected)
+		}
+	}
+}
+
+func TestRetryServiceName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"vpc":                        "vpc",
+		"iamIdentity":                "iam_identity",
+		"privateDNS":                 "private_dns",
+		"globalTaggingV1":            "global_tagging_v1",
+		"eventStreamsSchemaRegistry": "event_streams_schema_registry",
+		"accountv2":                  "accountv2",
+	} {
+		if actual := retryServiceName(name); actual != expected {
+			t.Errorf("retryServiceName(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+}
+
+func TestRetryKeyProtectConflict(t *testing.T) {
+	c := &Config{
+		ServiceRetryPolicies: map[string]RetryPolicy{
+			"key_protect":    {MaxAttempts: 3},
+			"key_management": {MaxAttempts: 5},
+		},
+	}
+	if err := c.configureKeyProtect(); err == nil {
+		t.Fatalf("expected an error for different key_protect and key_management policies")
+	}
+}
+
+func TestRetryBluemixSession(t *testing.T) {
+	retryCount, retryDelay := 10, RetryAPIDelay
+	sess := &bxsession.Session{Config: &bluemix.Config{MaxRetries: &retryCount, RetryDelay: &retryDelay}}
+	c := &Config{
+		RetryCount: retryCount,
+		RetryDelay: retryDelay,
+		ServiceRetryPolicies: map[string]RetryPolicy{
+			"containerv2": {MaxAttempts: 3, MaxBackoff: 20 * time.Second},
+		},
+	}
+
+	if actual := c.bluemixSession("icd", sess); actual != sess {
+		t.Fatalf("expected a service without override to share the provider session")
+	}
+	actual := c.bluemixSession("containerv2", sess)
+	if actual == sess || *actual.Config.MaxRetries != 2 || *actual.Config.RetryDelay != 20*time.Second {
+		t.Fatalf("expected the containerv2 session to retry twice every 20s, got %d every %s", *actual.Config.MaxRetries, *actual.Config.RetryDelay)
+	}
+	if *sess.Config.MaxRetries != 10 || *sess.Config.RetryDelay != RetryAPIDelay {
+		t.Fatalf("expected the provider session to be left unchanged")
+	}
+}
+
+func TestRetryUnsupportedService(t *testing.T) {
+	session := &clientSession{lazyClients: map[string]*lazyClient{}}
+	for _, name := range []string{"containerv2", "cosConfig", "power"} {
+		session.register(name, func() {})
+	}
+
+	for service, valid := range map[string]bool{
+		"containerv2": true,
+		"cos_config":  true,
+		"power":       false,
+		"unknown":     false,
+	} {
+		c := &Config{ServiceRetryPolicies: map[string]RetryPolicy{service: {MaxAttempts: 3}}}
+		if err := c.validateServiceRetryPolicies(session); (err == nil) != valid {
+			t.Errorf("validateServiceRetryPolicies(%q) returned %v", service, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"time"
+)
+
+// Default polling of the waiters that wait for a resource to reach a state.
+const (
+	WaiterDefaultDelay           = 2 * time.Second
+	WaiterDefaultMinPollInterval = 2 * time.Second
+	WaiterDefaultMaxPollInterval = 10 * time.Second
+)
+
+// WaiterPolicy describes how waiters poll a resource until it reaches a state. Zero values take the
+// defaults.
+type WaiterPolicy struct {
+	// Delay is the wait before the first poll.
+	Delay time.Duration
+	// MinPollInterval is the interval after the first poll. It doubles after every poll.
+	MinPollInterval time.Duration
+	// MaxPollInterval caps the interval between two polls.
+	MaxPollInterval time.Duration
+}
+
+// WithDefaults returns the policy with its zero values set to the defaults.
+func (p WaiterPolicy) WithDefaults() WaiterPolicy {
+	if p.Delay == 0 {
+		p.Delay = WaiterDefaultDelay
+	}
+	if p.MinPollInterval == 0 {
+		p.MinPollInterval = WaiterDefaultMinPollInterval
+	}
+	if p.MaxPollInterval == 0 {
+		p.MaxPollInterval = WaiterDefaultMaxPollInterval
+	}
+	if p.MaxPollInterval < p.MinPollInterval {
+		p.MaxPollInterval = p.MinPollInterval
+	}
+	return p
+}
+
+// validate checks that the durations of the policy are not negative.
+func (p WaiterPolicy) validate() error {
+	if p.Delay < 0 || p.MinPollInterval < 0 || p.MaxPollInterval < 0 {
+		return fmt.Errorf("[ERROR] The durations of the waiter block must not be negative")
+	}
+	return nil
+}
+
+// WaiterPolicy returns the polling policy of the waiters of the session.
+func (session *clientSession) WaiterPolicy() WaiterPolicy {
+	return session.waiterPolicy
+}
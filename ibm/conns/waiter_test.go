@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWaiterPolicyWithDefaults(t *testing.T) {
+	policy := WaiterPolicy{}.WithDefaults()
+	if policy.Delay != WaiterDefaultDelay || policy.MinPollInterval != WaiterDefaultMinPollInterval || policy.MaxPollInterval != WaiterDefaultMaxPollInterval {
+		t.Fatalf("unexpected default policy: %+v", policy)
+	}
+
+	policy = WaiterPolicy{MinPollInterval: 30 * time.Second}.WithDefaults()
+	if policy.Delay != WaiterDefaultDelay || policy.MinPollInterval != 30*time.Second || policy.MaxPollInterval != 30*time.Second {
+		t.Fatalf("expected the max poll interval to be raised to the min poll interval: %+v", policy)
+	}
+}
+
+func TestWaiterPolicyValidate(t *testing.T) {
+	if err := (WaiterPolicy{Delay: time.Second}).validate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := (WaiterPolicy{MaxPollInterval: -time.Second}).validate(); err == nil {
+		t.Fatalf("expected an error for a negative poll interval")
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IdentityRegion is the name of the identity attribute that holds the
+// region of a regional resource.
+const IdentityRegion = "region"
+
+// ResourceIdentity describes the identity of a resource, which can be used
+// in import blocks instead of the ID of the resource. The identity
+// attributes are the parts of the ID of the resource, in order.
+type ResourceIdentity struct {
+	// Attributes are the identity attributes that make up the ID.
+	Attributes []IdentityAttribute
+
+	// Separator joins the attributes into the ID. Defaults to "/".
+	Separator string
+
+	// Regional adds an optional "region" attribute that holds the region
+	// of the provider. On import, it must match the region of the provider.
+	Regional bool
+}
+
+// IdentityAttribute is an attribute of a resource identity.
+type IdentityAttribute struct {
+	Name        string
+	Description string
+
+	// Validate, if set, validates the value of the attribute on import,
+	// before the API is called.
+	Validate func(value string) error
+}
+
+// WithResourceIdentity adds the identity to a resource. The identity is set
+// from the ID after every successful create, read and update, and a
+// resource can be imported either by ID or by identity.
+func WithResourceIdentity(resource *schema.Resource, identity ResourceIdentity) *schema.Resource {
+	resource.Identity = &schema.ResourceIdentity{
+		SchemaFunc: identity.schemaMap,
+	}
+	resource.Importer = identity.importer(resource.Importer)
+
+	resource.CreateContext = identity.wrapContextFunc(resource.CreateContext)
+	resource.ReadContext = identity.wrapContextFunc(resource.ReadContext)
+	resource.UpdateContext = identity.wrapContextFunc(resource.UpdateContext)
+	resource.CreateWithoutTimeout = identity.wrapContextFunc(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = identity.wrapContextFunc(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = identity.wrapContextFunc(resource.UpdateWithoutTimeout)
+	resource.Create = identity.wrapFunc(resource.Create)
+	resource.Read = identity.wrapFunc(resource.Read)
+	resource.Update = identity.wrapFunc(resource.Update)
+
+	return resource
+}
+
+func (i ResourceIdentity) separator() string {
+	if i.Separator == "" {
+		return "/"
+	}
+	return i.Separator
+}
+
+func (i ResourceIdentity) schemaMap() map[string]*schema.Schema {
+	identitySchema := map[string]*schema.Schema{}
+	for _, attribute := range i.Attributes {
+		identitySchema[attribute.Name] = &schema.Schema{
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+			Description:       attribute.Description,
+		}
+	}
+	if i.Regional {
+		identitySchema[IdentityRegion] = &schema.Schema{
+			Type:              schema.TypeString,
+			OptionalForImport: true,
+			Description:       "The region of the resource. Defaults to the region of the provider.",
+		}
+	}
+	return identitySchema
+}
+
+// Set sets the identity of a resource from its ID.
+func (i ResourceIdentity) Set(d *schema.ResourceData, meta interface{}) error {
+	parts := strings.SplitN(d.Id(), i.separator(), len(i.Attributes))
+	if len(parts) != len(i.Attributes) {
+		return fmt.Errorf("[ERROR] The ID %s does not match the format of the resource identity", d.Id())
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the resource identity: %s", err)
+	}
+	for index, attribute := range i.Attributes {
+		if err := identity.Set(attribute.Name, parts[index]); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the resource identity %s: %s", attribute.Name, err)
+		}
+	}
+	if i.Regional {
+		region, err := providerRegion(meta)
+		if err != nil {
+			return err
+		}
+		if err := identity.Set(IdentityRegion, region); err != nil {
+			return fmt.Errorf("[ERROR] Error setting the resource identity %s: %s", IdentityRegion, err)
+		}
+	}
+	return nil
+}
+
+// id returns the ID of a resource from its identity.
+func (i ResourceIdentity) id(d *schema.ResourceData, meta interface{}) (string, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting the resource identity: %s", err)
+	}
+
+	parts := make([]string, 0, len(i.Attributes))
+	for index, attribute := range i.Attributes {
+		part, ok := identity.GetOk(attribute.Name)
+		if !ok || part.(string) == "" {
+			return "", fmt.Errorf("[ERROR] The resource identity attribute %s is required", attribute.Name)
+		}
+		// Only the last part of the ID may contain the separator.
+		if index < len(i.Attributes)-1 && strings.Contains(part.(string), i.separator()) {
+			return "", fmt.Errorf("[ERROR] The resource identity attribute %s must not contain %q", attribute.Name, i.separator())
+		}
+		if attribute.Validate != nil {
+			if err := attribute.Validate(part.(string)); err != nil {
+				return "", fmt.Errorf("[ERROR] The resource identity attribute %s is invalid: %s", attribute.Name, err)
+			}
+		}
+		parts = append(parts, part.(string))
+	}
+
+	if region, ok := identity.GetOk(IdentityRegion); ok && i.Regional {
+		providerRegion, err := providerRegion(meta)
+		if err != nil {
+			return "", err
+		}
+		if region.(string) != providerRegion {
+			return "", fmt.Errorf("[ERROR] The resource identity region %s does not match the provider region %s, use a provider that is configured for region %s", region, providerRegion, region)
+		}
+	}
+
+	return strings.Join(parts, i.separator()), nil
+}
+
+// importer returns an importer that sets the ID from the identity when the
+// resource is imported by identity, and then runs the importer of the
+// resource, if any.
+func (i ResourceIdentity) importer(importer *schema.ResourceImporter) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if d.Id() == "" {
+				id, err := i.id(d, meta)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(id)
+			}
+
+			switch {
+			case importer != nil && importer.StateContext != nil:
+				return importer.StateContext(ctx, d, meta)
+			case importer != nil && importer.State != nil:
+				return importer.State(d, meta)
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func (i ResourceIdentity) wrapContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := i.Set(d, meta); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+		return diags
+	}
+}
+
+func (i ResourceIdentity) wrapFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+		if err := i.Set(d, meta); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+		return nil
+	}
+}
+
+// ValidateIdentityCRN validates that an identity attribute is a CRN.
+func ValidateIdentityCRN(value string) error {
+	if !strings.HasPrefix(value, "crn:") {
+		return fmt.Errorf("%s is not a CRN", value)
+	}
+	return nil
+}
+
+func providerRegion(meta interface{}) (string, error) {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return "", fmt.Errorf("[ERROR] Unexpected provider data %T", meta)
+	}
+	bxSession, err := session.BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	return bxSession.Config.Region, nil
+}
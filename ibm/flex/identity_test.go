@@ -0,0 +1,225 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+var testResourceIdentity = ResourceIdentity{
+	Attributes: []IdentityAttribute{
+		{Name: "zone_id", Description: "The ID of the zone."},
+		{Name: "domain_id", Description: "The ID of the domain."},
+	},
+	Separator: ":",
+}
+
+func getTestIdentityResource() *sdkschema.Resource {
+	return WithResourceIdentity(&sdkschema.Resource{
+		ReadContext: func(ctx context.Context, d *sdkschema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		Importer: &sdkschema.ResourceImporter{},
+		Schema: map[string]*sdkschema.Schema{
+			"name": {
+				Type:     sdkschema.TypeString,
+				Optional: true,
+			},
+		},
+	}, testResourceIdentity)
+}
+
+func TestWithResourceIdentity(t *testing.T) {
+	resource := getTestIdentityResource()
+	assert.Nil(t, resource.Identity.InternalIdentityValidate())
+
+	identitySchema := resource.Identity.SchemaMap()
+	assert.Len(t, identitySchema, 2)
+	assert.True(t, identitySchema["zone_id"].RequiredForImport)
+	assert.True(t, identitySchema["domain_id"].RequiredForImport)
+
+	// Reading the resource sets the identity from the ID.
+	d := resource.Data(&terraform.InstanceState{ID: "zone-1:domain-1"})
+	assert.False(t, resource.ReadContext(context.Background(), d, nil).HasError())
+
+	identity, err := d.Identity()
+	assert.Nil(t, err)
+	assert.Equal(t, "zone-1", identity.Get("zone_id"))
+	assert.Equal(t, "domain-1", identity.Get("domain_id"))
+}
+
+func TestWithResourceIdentityImport(t *testing.T) {
+	resource := getTestIdentityResource()
+
+	d := resource.Data(nil)
+	identity, err := d.Identity()
+	assert.Nil(t, err)
+	assert.Nil(t, identity.Set("zone_id", "zone-1"))
+	assert.Nil(t, identity.Set("domain_id", "domain-1"))
+
+	imported, err := resource.Importer.StateContext(context.Background(), d, nil)
+	assert.Nil(t, err)
+	assert.Len(t, imported, 1)
+	assert.Equal(t, "zone-1:domain-1", imported[0].Id())
+
+	// Importing by ID keeps the ID.
+	d = resource.Data(nil)
+	d.SetId("zone-2:domain-2")
+	imported, err = resource.Importer.StateContext(context.Background(), d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "zone-2:domain-2", imported[0].Id())
+
+	// A missing identity attribute is reported.
+	d = resource.Data(nil)
+	identity, _ = d.Identity()
+	assert.Nil(t, identity.Set("zone_id", "zone-1"))
+	_, err = resource.Importer.StateContext(context.Background(), d, nil)
+	assert.ErrorContains(t, err, "domain_id is required")
+
+	// An attribute other than the last one must not contain the separator.
+	d = resource.Data(nil)
+	identity, _ = d.Identity()
+	assert.Nil(t, identity.Set("zone_id", "zone:1"))
+	assert.Nil(t, identity.Set("domain_id", "domain-1"))
+	_, err = resource.Importer.StateContext(context.Background(), d, nil)
+	assert.ErrorContains(t, err, "zone_id must not contain")
+}
+
+func TestResourceIdentityValidate(t *testing.T) {
+	identity := ResourceIdentity{
+		Attributes: []IdentityAttribute{
+			{Name: "id", Description: "The ID of the record."},
+			{Name: "cis_id", Description: "The CRN of the instance.", Validate: ValidateIdentityCRN},
+		},
+		Separator: ":",
+	}
+	resource := WithResourceIdentity(&sdkschema.Resource{
+		ReadContext: func(ctx context.Context, d *sdkschema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*sdkschema.Schema{},
+	}, identity)
+
+	d := resource.Data(nil)
+	attributes, _ := d.Identity()
+	assert.Nil(t, attributes.Set("id", "record-1"))
+	assert.Nil(t, attributes.Set("cis_id", "instance-1"))
+	_, err := resource.Importer.StateContext(context.Background(), d, nil)
+	assert.ErrorContains(t, err, "cis_id is invalid: instance-1 is not a CRN")
+
+	// The last attribute may contain the separator.
+	d = resource.Data(nil)
+	attributes, _ = d.Identity()
+	assert.Nil(t, attributes.Set("id", "record-1"))
+	assert.Nil(t, attributes.Set("cis_id", "crn:v1:bluemix:public:internet-svcs:global:a/1::"))
+	imported, err := resource.Importer.StateContext(context.Background(), d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "record-1:crn:v1:bluemix:public:internet-svcs:global:a/1::", imported[0].Id())
+
+	assert.Nil(t, identity.Set(imported[0], nil))
+	attributes, _ = imported[0].Identity()
+	assert.Equal(t, "crn:v1:bluemix:public:internet-svcs:global:a/1::", attributes.Get("cis_id"))
+}
+
+func TestResourceIdentitySetInvalidID(t *testing.T) {
+	resource := getTestIdentityResource()
+	d := resource.Data(&terraform.InstanceState{ID: "zone-1"})
+	assert.ErrorContains(t, testResourceIdentity.Set(d, nil), "does not match")
+}
+
+func TestSDKv2ListResource(t *testing.T) {
+	ctx := context.Background()
+	listResource := SDKv2ListResource{
+		Resource: getTestIdentityResource,
+		Identity: testResourceIdentity,
+	}
+
+	resp := list.RawV6SchemaResponse{}
+	listResource.RawV6Schemas(ctx, list.RawV6SchemaRequest{}, &resp)
+	assert.NotNil(t, resp.ProtoV6Schema)
+	assert.NotNil(t, resp.ProtoV6IdentitySchema)
+	assert.Len(t, resp.ProtoV6IdentitySchema.IdentityAttributes, 2)
+
+	req := list.ListRequest{
+		ResourceSchema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":   schema.StringAttribute{Computed: true},
+				"name": schema.StringAttribute{Optional: true},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"zone_id":   identityschema.StringAttribute{RequiredForImport: true},
+				"domain_id": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+	result := listResource.NewListResult(ctx, req, nil, "zone-1:domain-1", "example.com")
+	assert.False(t, result.Diagnostics.HasError())
+	assert.Equal(t, "example.com", result.DisplayName)
+	assert.True(t, result.Identity.Raw.Type().Is(tftypes.Object{}))
+
+	var domainID types.String
+	assert.False(t, result.Identity.GetAttribute(ctx, path.Root("domain_id"), &domainID).HasError())
+	assert.Equal(t, "domain-1", domainID.ValueString())
+
+	// The resource is read when the request includes it.
+	req.IncludeResource = true
+	result = listResource.NewListResult(ctx, req, nil, "zone-1:domain-1", "example.com")
+	assert.False(t, result.Diagnostics.HasError())
+
+	var id types.String
+	assert.False(t, result.Resource.GetAttribute(ctx, path.Root("id"), &id).HasError())
+	assert.Equal(t, "zone-1:domain-1", id.ValueString())
+}
+
+func TestListPages(t *testing.T) {
+	pages := map[string][]string{
+		"":  {"a", "b"},
+		"2": {"c", "d"},
+		"3": {"e"},
+	}
+	nexts := map[string]string{"": "2", "2": "3", "3": ""}
+	page := func(start string) ([]string, string, error) {
+		return pages[start], nexts[start], nil
+	}
+
+	var items []string
+	err := ListPages(page, func(item string) bool {
+		items = append(items, item)
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, items)
+
+	// The pages are no longer fetched once push returns false.
+	items = nil
+	err = ListPages(page, func(item string) bool {
+		items = append(items, item)
+		return len(items) < 3
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+
+	// An error stops the listing.
+	err = ListPages(func(start string) ([]string, string, error) {
+		if start == "2" {
+			return nil, "", fmt.Errorf("page 2 failed")
+		}
+		return page(start)
+	}, func(item string) bool {
+		return true
+	})
+	assert.EqualError(t, err, "page 2 failed")
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// SDKv2ListResource implements the parts of a framework list resource that
+// lists the instances of a managed resource implemented with the SDKv2. It
+// is meant to be embedded in the list resource.
+type SDKv2ListResource struct {
+	// Resource returns the managed resource. It must have an identity.
+	Resource func() *schema.Resource
+
+	// Identity is the identity of the managed resource.
+	Identity ResourceIdentity
+}
+
+// RawV6Schemas returns the schemas of the managed resource, which the
+// framework needs because the managed resource is not a framework resource.
+func (r SDKv2ListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	resource := r.Resource()
+	resp.ProtoV6Schema = protoV5ToV6Schema(resource.ProtoSchema(ctx)())
+	if identitySchema := resource.ProtoIdentitySchema(ctx); identitySchema != nil {
+		resp.ProtoV6IdentitySchema = protoV5ToV6IdentitySchema(identitySchema())
+	}
+}
+
+// NewListResult returns the list result for the instance of the managed
+// resource with the ID. When the request includes the resource, the
+// instance is read with the managed resource.
+func (r SDKv2ListResource) NewListResult(ctx context.Context, req list.ListRequest, meta interface{}, id, displayName string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	resource := r.Resource()
+	d := resource.Data(&terraform.InstanceState{ID: id})
+
+	if req.IncludeResource {
+		for _, diagnostic := range readSDKv2Resource(ctx, resource, d, meta) {
+			if diagnostic.Severity == sdkdiag.Error {
+				result.Diagnostics.AddError(diagnostic.Summary, diagnostic.Detail)
+			} else {
+				result.Diagnostics.AddWarning(diagnostic.Summary, diagnostic.Detail)
+			}
+		}
+		if result.Diagnostics.HasError() {
+			return result
+		}
+		if d.Id() == "" {
+			result.Diagnostics.AddError("Resource Not Found", fmt.Sprintf("The resource %s was deleted while it was listed.", id))
+			return result
+		}
+
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Unable to Convert Resource State", err.Error())
+			return result
+		}
+		result.Resource.Raw = *state
+	}
+
+	if err := r.Identity.Set(d, meta); err != nil {
+		result.Diagnostics.AddError("Unable to Set Resource Identity", err.Error())
+		return result
+	}
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Unable to Convert Resource Identity", err.Error())
+		return result
+	}
+	result.Identity.Raw = *identity
+
+	return result
+}
+
+// ListPages pages through a paginated List API and pushes every item until
+// push returns false. page returns the items of the page that begins at
+// start, which is empty for the first page, and the start of the next page,
+// which is empty after the last page.
+func ListPages[T any](page func(start string) ([]T, string, error), push func(T) bool) error {
+	start := ""
+	for {
+		items, next, err := page(start)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if !push(item) {
+				return nil
+			}
+		}
+		if next == "" || next == start {
+			return nil
+		}
+		start = next
+	}
+}
+
+func readSDKv2Resource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) sdkdiag.Diagnostics {
+	switch {
+	case resource.ReadContext != nil:
+		return resource.ReadContext(ctx, d, meta)
+	case resource.ReadWithoutTimeout != nil:
+		return resource.ReadWithoutTimeout(ctx, d, meta)
+	case resource.Read != nil:
+		return sdkdiag.FromErr(resource.Read(d, meta))
+	}
+	return nil
+}
+
+// protoV5ToV6Schema converts the protocol version 5 schema of an SDKv2
+// resource to protocol version 6, which is used by the framework provider.
+func protoV5ToV6Schema(s *tfprotov5.Schema) *tfprotov6.Schema {
+	if s == nil {
+		return nil
+	}
+	return &tfprotov6.Schema{
+		Version: s.Version,
+		Block:   protoV5ToV6SchemaBlock(s.Block),
+	}
+}
+
+func protoV5ToV6SchemaBlock(b *tfprotov5.SchemaBlock) *tfprotov6.SchemaBlock {
+	if b == nil {
+		return nil
+	}
+
+	block := &tfprotov6.SchemaBlock{
+		Version:            b.Version,
+		Description:        b.Description,
+		DescriptionKind:    tfprotov6.StringKind(b.DescriptionKind),
+		Deprecated:         b.Deprecated,
+		DeprecationMessage: b.DeprecationMessage,
+	}
+	for _, a := range b.Attributes {
+		block.Attributes = append(block.Attributes, &tfprotov6.SchemaAttribute{
+			Name:               a.Name,
+			Type:               a.Type,
+			Description:        a.Description,
+			Required:           a.Required,
+			Optional:           a.Optional,
+			Computed:           a.Computed,
+			Sensitive:          a.Sensitive,
+			DescriptionKind:    tfprotov6.StringKind(a.DescriptionKind),
+			Deprecated:         a.Deprecated,
+			WriteOnly:          a.WriteOnly,
+			DeprecationMessage: a.DeprecationMessage,
+		})
+	}
+	for _, nb := range b.BlockTypes {
+		block.BlockTypes = append(block.BlockTypes, &tfprotov6.SchemaNestedBlock{
+			TypeName: nb.TypeName,
+			Block:    protoV5ToV6SchemaBlock(nb.Block),
+			Nesting:  tfprotov6.SchemaNestedBlockNestingMode(nb.Nesting),
+			MinItems: nb.MinItems,
+			MaxItems: nb.MaxItems,
+		})
+	}
+	return block
+}
+
+func protoV5ToV6IdentitySchema(s *tfprotov5.ResourceIdentitySchema) *tfprotov6.ResourceIdentitySchema {
+	if s == nil {
+		return nil
+	}
+
+	identitySchema := &tfprotov6.ResourceIdentitySchema{
+		Version: s.Version,
+	}
+	for _, a := range s.IdentityAttributes {
+		identitySchema.IdentityAttributes = append(identitySchema.IdentityAttributes, &tfprotov6.ResourceIdentitySchemaAttribute{
+			Name:              a.Name,
+			Type:              a.Type,
+			RequiredForImport: a.RequiredForImport,
+			OptionalForImport: a.OptionalForImport,
+			Description:       a.Description,
+		})
+	}
+	return identitySchema
+}
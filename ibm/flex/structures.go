@@ -1317,6 +1317,32 @@ func RoutingRuleRedirectGet(in *s3.Redirect) []map[string]interface{} {
 	return redirect
 }
 
+func CorsRulesGet(in []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(in))
+	for _, corsRuleValue := range in {
+		rule := make(map[string]interface{})
+
+		if corsRuleValue.AllowedHeaders != nil {
+			rule["allowed_headers"] = aws.StringValueSlice(corsRuleValue.AllowedHeaders)
+		}
+		if corsRuleValue.AllowedMethods != nil {
+			rule["allowed_methods"] = aws.StringValueSlice(corsRuleValue.AllowedMethods)
+		}
+		if corsRuleValue.AllowedOrigins != nil {
+			rule["allowed_origins"] = aws.StringValueSlice(corsRuleValue.AllowedOrigins)
+		}
+		if corsRuleValue.ExposeHeaders != nil {
+			rule["expose_headers"] = aws.StringValueSlice(corsRuleValue.ExposeHeaders)
+		}
+		if corsRuleValue.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(aws.Int64Value(corsRuleValue.MaxAgeSeconds))
+		}
+
+		corsRules = append(corsRules, rule)
+	}
+	return corsRules
+}
+
 func FlattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
@@ -2585,13 +2611,7 @@ func EscapeUrlParm(urlParm string) string {
 	return urlParm
 }
 func GetLocation(instance models.ServiceInstanceV2) string {
-	region := instance.Crn.Region
-	cName := instance.Crn.CName
-	if cName == "bluemix" || cName == "staging" {
-		return region
-	} else {
-		return cName + "-" + region
-	}
+	return CRN{CName: instance.Crn.CName, Region: instance.Crn.Region}.Location()
 }
 
 type CRN struct {
@@ -2613,7 +2633,8 @@ func Parse(s string) (CRN, error) {
 		return CRN{}, nil
 	}
 
-	segments := strings.Split(s, crnSeparator)
+	// The resource segment is the last one and may itself contain the separator
+	segments := strings.SplitN(s, crnSeparator, 10)
 	if len(segments) != 10 || segments[0] != crn {
 		return CRN{}, ErrMalformedCRN
 	}
@@ -2646,18 +2667,65 @@ func Parse(s string) (CRN, error) {
 
 	return crn, nil
 }
+
+// ScopeSegment returns the scope segment of the CRN, for example "a/<account_id>" or "global".
+func (c CRN) ScopeSegment() string {
+	if c.ScopeType == "" {
+		return c.Scope
+	}
+	return c.ScopeType + scopeSeparator + c.Scope
+}
+
+// String returns the CRN in its canonical "crn:version:cname:ctype:..." form.
+func (c CRN) String() string {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = crn
+	}
+	return strings.Join([]string{
+		scheme,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		c.ScopeSegment(),
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
+// Matches reports whether the CRN matches the pattern. Empty segments of the pattern match any value.
+func (c CRN) Matches(pattern CRN) bool {
+	segmentMatches := func(value, pattern string) bool {
+		return pattern == "" || value == pattern
+	}
+	return segmentMatches(c.Version, pattern.Version) &&
+		segmentMatches(c.CName, pattern.CName) &&
+		segmentMatches(c.CType, pattern.CType) &&
+		segmentMatches(c.ServiceName, pattern.ServiceName) &&
+		segmentMatches(c.Region, pattern.Region) &&
+		segmentMatches(c.ScopeSegment(), pattern.ScopeSegment()) &&
+		segmentMatches(c.ServiceInstance, pattern.ServiceInstance) &&
+		segmentMatches(c.ResourceType, pattern.ResourceType) &&
+		segmentMatches(c.Resource, pattern.Resource)
+}
+
+// Location returns the location of the CRN, prefixed with the cloud name for clouds other than bluemix and staging.
+func (c CRN) Location() string {
+	if c.CName == "bluemix" || c.CName == "staging" {
+		return c.Region
+	}
+	return c.CName + "-" + c.Region
+}
+
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
 		log.Fatal(err)
 	}
-	region := crn.Region
-	cName := crn.CName
-	if cName == "bluemix" || cName == "staging" {
-		return region
-	} else {
-		return cName + "-" + region
-	}
+	return crn.Location()
 }
 
 func GetTags(d *schema.ResourceData, meta interface{}) error {
@@ -4831,3 +4899,38 @@ func Stringify(v interface{}) string {
 	}
 	return ""
 }
+
+// GetWriteOnlyString returns the configured value of the top-level write-only
+// string attribute "key" and whether it is set. Write-only values are never
+// stored in the plan or state, so they can only be read from the raw config
+// during create and update.
+func GetWriteOnlyString(d *schema.ResourceData, key string) (string, bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().HasAttribute(key) {
+		return "", false
+	}
+	value := rawConfig.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return "", false
+	}
+	return value.AsString(), true
+}
+
+// GetUpdatedSecretString returns the value of a secret string that is set
+// either with the argument "key" or with its write-only counterpart "woKey",
+// and whether the value must be sent on update. Write-only values are in the
+// config of every update, so the write-only value is only sent when its
+// "<woKey>_version" argument changes. Removing the write-only argument from
+// the config changes its version argument without providing a value, in which
+// case nothing must be sent.
+func GetUpdatedSecretString(d *schema.ResourceData, key, woKey string) (string, bool) {
+	if d.HasChange(woKey + "_version") {
+		if value, ok := GetWriteOnlyString(d, woKey); ok {
+			return value, true
+		}
+	}
+	if value := d.Get(key).(string); d.HasChange(key) && value != "" {
+		return value, true
+	}
+	return "", false
+}
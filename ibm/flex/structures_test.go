@@ -84,3 +84,41 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestParseCRN(t *testing.T) {
+	crn, err := Parse("crn:v1:bluemix:public:cloud-object-storage:global:a/1234::bucket:my:bucket")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", crn.Version)
+	assert.Equal(t, "cloud-object-storage", crn.ServiceName)
+	assert.Equal(t, "a", crn.ScopeType)
+	assert.Equal(t, "1234", crn.Scope)
+	assert.Equal(t, "", crn.ServiceInstance)
+	assert.Equal(t, "bucket", crn.ResourceType)
+	assert.Equal(t, "my:bucket", crn.Resource)
+	assert.Equal(t, "crn:v1:bluemix:public:cloud-object-storage:global:a/1234::bucket:my:bucket", crn.String())
+
+	_, err = Parse("crn:v1:bluemix:public:cloud-object-storage")
+	assert.Equal(t, ErrMalformedCRN, err)
+
+	_, err = Parse("crn:v1:bluemix:public:cloud-object-storage:global:1234:::")
+	assert.Equal(t, ErrMalformedScope, err)
+}
+
+func TestCRNMatches(t *testing.T) {
+	crn, err := Parse("crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1")
+	assert.Nil(t, err)
+
+	pattern, err := Parse("crn:v1:bluemix:public:is::a/1234:::")
+	assert.Nil(t, err)
+	assert.True(t, crn.Matches(pattern))
+
+	pattern, err = Parse("crn:v1:bluemix:public:is:eu-de::::")
+	assert.Nil(t, err)
+	assert.False(t, crn.Matches(pattern))
+}
+
+func TestCRNLocation(t *testing.T) {
+	assert.Equal(t, "us-south", CRN{CName: "bluemix", Region: "us-south"}.Location())
+	assert.Equal(t, "staging", CRN{CName: "staging", Region: "staging"}.Location())
+	assert.Equal(t, "ys1-us-south", CRN{CName: "ys1", Region: "us-south"}.Location())
+}
@@ -3,9 +3,13 @@ package flex
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -16,6 +20,37 @@ type TerraformProblem struct {
 
 	Resource  string
 	Operation string
+
+	// AttributePath is the path of the argument that caused the problem.
+	// Terraform uses it to highlight the argument in the configuration.
+	AttributePath cty.Path
+
+	// Remediation is a hint on how to resolve the problem. When it is
+	// empty, a hint is looked up from the error code of the service.
+	Remediation string
+}
+
+// remediationHints holds remediation hints keyed by the error code
+// that is returned by a service.
+var remediationHints = map[string]string{
+	"not_authorized":           "Check that the API key is valid and that it is not expired.",
+	"unauthorized":             "Check that the API key is valid and that it is not expired.",
+	"forbidden":                "Check that the IAM access policies of the API key grant a role that allows this operation on the resource.",
+	"not_found":                "The resource might have been deleted outside of Terraform. Run `terraform apply -refresh-only` to update the state.",
+	"validation_unique_failed": "A resource with the same name already exists. Choose another name or import the existing resource with `terraform import`.",
+	"quota_exceeded":           "An account quota is reached. Delete unused resources or request a quota increase.",
+	"over_quota":               "An account quota is reached. Delete unused resources or request a quota increase.",
+	"rate_limit_exceeded":      "The API rate limit is reached. Configure the `retry` block of the provider or lower the parallelism of Terraform with `-parallelism`.",
+	"too_many_requests":        "The API rate limit is reached. Configure the `retry` block of the provider or lower the parallelism of Terraform with `-parallelism`.",
+}
+
+// statusCodeRemediationHints holds the remediation hints used when
+// the error code returned by a service has no hint.
+var statusCodeRemediationHints = map[int]string{
+	http.StatusUnauthorized:    remediationHints["not_authorized"],
+	http.StatusForbidden:       remediationHints["forbidden"],
+	http.StatusNotFound:        remediationHints["not_found"],
+	http.StatusTooManyRequests: remediationHints["too_many_requests"],
 }
 
 // GetID returns a hash value computed from stable fields in the
@@ -44,6 +79,29 @@ func (e *TerraformProblem) GetConsoleOrderedMaps() *core.OrderedMaps {
 	orderedMaps.Add("severity", e.Severity)
 	orderedMaps.Add("resource", e.Resource)
 	orderedMaps.Add("operation", e.Operation)
+
+	// Conditionally add the details of the failed API call and the argument.
+
+	if len(e.AttributePath) > 0 {
+		orderedMaps.Add("attribute", formatAttributePath(e.AttributePath))
+	}
+
+	if statusCode := e.GetStatusCode(); statusCode != 0 {
+		orderedMaps.Add("status_code", statusCode)
+	}
+
+	if errorCode := e.GetErrorCode(); errorCode != "" {
+		orderedMaps.Add("error_code", errorCode)
+	}
+
+	if trace := e.GetTrace(); trace != "" {
+		orderedMaps.Add("trace", trace)
+	}
+
+	if remediation := e.GetRemediation(); remediation != "" {
+		orderedMaps.Add("remediation", remediation)
+	}
+
 	orderedMaps.Add("component", e.Component)
 
 	return orderedMaps
@@ -60,11 +118,183 @@ func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 	return orderedMaps
 }
 
-// GetDiag returns a new Diagnostics object using the console
-// message as the summary. It is used to create a Diagnostics
-// object from a TerraformProblem in the resource/data source code.
+// GetDiag returns a new Diagnostics object with the summary of the
+// problem as the summary and the rest of the console message as the
+// detail. The attribute path, if any, is set on the diagnostic. It is
+// used to create a Diagnostics object from a TerraformProblem in the
+// resource/data source code.
 func (e *TerraformProblem) GetDiag() diag.Diagnostics {
-	return diag.Errorf("%s", e.GetConsoleMessage())
+	severity := diag.Error
+	if e.Severity == core.WarningSeverity {
+		severity = diag.Warning
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      severity,
+			Summary:       e.Summary,
+			Detail:        core.ComputeConsoleMessage(&diagDetail{e}),
+			AttributePath: e.AttributePath,
+		},
+	}
+}
+
+// diagDetail renders the console message of a TerraformProblem
+// without the summary, which is already the diagnostic summary.
+type diagDetail struct {
+	*TerraformProblem
+}
+
+func (d *diagDetail) GetConsoleOrderedMaps() *core.OrderedMaps {
+	orderedMaps := core.NewOrderedMaps()
+	for _, item := range d.TerraformProblem.GetConsoleOrderedMaps().GetMaps() {
+		if item.Key != "summary" {
+			orderedMaps.Add(item.Key.(string), item.Value)
+		}
+	}
+	return orderedMaps
+}
+
+// WithAttributePath sets the path of the argument that caused the
+// problem and returns the problem, so that it can be chained.
+func (e *TerraformProblem) WithAttributePath(path cty.Path) *TerraformProblem {
+	e.AttributePath = path
+	return e
+}
+
+// WithAttribute sets the path of the argument that caused the problem
+// from a schema key, such as "boot_volume.0.name", and returns the
+// problem, so that it can be chained.
+func (e *TerraformProblem) WithAttribute(key string) *TerraformProblem {
+	var path cty.Path
+	for _, step := range strings.Split(key, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return e.WithAttributePath(path)
+}
+
+// WithRemediation sets a hint on how to resolve the problem and
+// returns the problem, so that it can be chained.
+func (e *TerraformProblem) WithRemediation(remediation string) *TerraformProblem {
+	e.Remediation = remediation
+	return e
+}
+
+// GetStatusCode returns the HTTP status code of the failed API call
+// that caused the problem, or 0 if the problem was not caused by one.
+func (e *TerraformProblem) GetStatusCode() int {
+	if httpProb := e.getHTTPProblem(); httpProb != nil {
+		return httpProb.Response.GetStatusCode()
+	}
+	return 0
+}
+
+// GetErrorCode returns the error code returned by the service in the
+// failed API call that caused the problem, if any.
+func (e *TerraformProblem) GetErrorCode() string {
+	httpProb := e.getHTTPProblem()
+	if httpProb == nil {
+		return ""
+	}
+
+	result, ok := httpProb.Response.GetResult().(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	if errs, ok := result["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+
+	for _, key := range []string{"code", "errorCode", "error_code"} {
+		if code, ok := result[key].(string); ok {
+			return code
+		}
+	}
+
+	return ""
+}
+
+// GetTrace returns the IBM Cloud trace ID of the failed API call that
+// caused the problem, if any. Support uses it to find the request.
+func (e *TerraformProblem) GetTrace() string {
+	httpProb := e.getHTTPProblem()
+	if httpProb == nil {
+		return ""
+	}
+
+	if result, ok := httpProb.Response.GetResult().(map[string]interface{}); ok {
+		for _, key := range []string{"trace", "transaction_id", "transactionId"} {
+			if trace, ok := result[key].(string); ok && trace != "" {
+				return trace
+			}
+		}
+	}
+
+	for _, header := range []string{"X-Correlation-Id", "Transaction-Id", "X-Request-Id"} {
+		if trace := httpProb.Response.GetHeaders().Get(header); trace != "" {
+			return trace
+		}
+	}
+
+	return ""
+}
+
+// GetRemediation returns the remediation hint of the problem. If no
+// hint was set, it is looked up from the error code and then from the
+// HTTP status code of the failed API call.
+func (e *TerraformProblem) GetRemediation() string {
+	if e.Remediation != "" {
+		return e.Remediation
+	}
+
+	if hint, ok := remediationHints[e.GetErrorCode()]; ok {
+		return hint
+	}
+
+	return statusCodeRemediationHints[e.GetStatusCode()]
+}
+
+// getHTTPProblem returns the HTTPProblem in the chain of errors that
+// caused the problem, or nil if there is none.
+func (e *TerraformProblem) getHTTPProblem() *core.HTTPProblem {
+	if e.IBMProblem == nil {
+		return nil
+	}
+
+	var httpProb *core.HTTPProblem
+	if errors.As(e.GetCausedBy(), &httpProb) && httpProb.Response != nil {
+		return httpProb
+	}
+	return nil
+}
+
+// formatAttributePath formats an attribute path like a schema key,
+// for example "boot_volume.0.name".
+func formatAttributePath(path cty.Path) string {
+	steps := make([]string, 0, len(path))
+	for _, step := range path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			steps = append(steps, s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				index, _ := s.Key.AsBigFloat().Int64()
+				steps = append(steps, strconv.FormatInt(index, 10))
+			} else if s.Key.Type() == cty.String {
+				steps = append(steps, s.Key.AsString())
+			}
+		}
+	}
+	return strings.Join(steps, ".")
 }
 
 // TerraformErrorf creates and returns a new instance of `TerraformProblem`
@@ -88,6 +318,16 @@ func DiscriminatedTerraformErrorf(err error, summary, resource, operation, discr
 	}
 }
 
+// TerraformWarningf creates and returns a new instance of
+// `TerraformProblem` with "warning" level severity. It is used for
+// problems that do not stop the operation, and is emitted as a warning
+// diagnostic by GetDiag.
+func TerraformWarningf(err error, summary, resource, operation string) *TerraformProblem {
+	tfWarning := TerraformErrorf(err, summary, resource, operation)
+	tfWarning.Severity = core.WarningSeverity
+	return tfWarning
+}
+
 func getComponentInfo() *core.ProblemComponent {
 	return core.NewProblemComponent("github.com/IBM-Cloud/terraform-provider-ibm", v.Version)
 }
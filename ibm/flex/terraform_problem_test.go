@@ -3,10 +3,13 @@ package flex
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -131,7 +134,88 @@ func TestTerraformProblemGetDiag(t *testing.T) {
 
 	diagnostic := diagnostics[0]
 	assert.Nil(t, diagnostic.Validate())
-	assert.Equal(t, terraformProb.GetConsoleMessage(), diagnostic.Summary)
+	assert.Equal(t, diag.Error, diagnostic.Severity)
+	assert.Equal(t, "Create failed.", diagnostic.Summary)
+	assert.Nil(t, diagnostic.AttributePath)
+
+	expected := `---
+id: terraform-98c0e1fd
+severity: error
+resource: ibm_some_resource
+operation: create
+component:
+  name: github.com/IBM-Cloud/terraform-provider-ibm
+  version: 1.63.0
+---
+`
+	assert.Equal(t, expected, diagnostic.Detail)
+}
+
+func TestTerraformProblemGetDiagWithHTTPProblem(t *testing.T) {
+	terraformProb := TerraformErrorf(getHTTPProblem(), "CreateVPC failed: Provided name is not unique.", "ibm_is_vpc", "create").
+		WithAttribute("name")
+
+	diagnostics := terraformProb.GetDiag()
+	assert.Len(t, diagnostics, 1)
+
+	diagnostic := diagnostics[0]
+	assert.Nil(t, diagnostic.Validate())
+	assert.Equal(t, diag.Error, diagnostic.Severity)
+	assert.Equal(t, "CreateVPC failed: Provided name is not unique.", diagnostic.Summary)
+	assert.Equal(t, cty.GetAttrPath("name"), diagnostic.AttributePath)
+	assert.Contains(t, diagnostic.Detail, "attribute: name\n")
+	assert.Contains(t, diagnostic.Detail, "status_code: 409\n")
+	assert.Contains(t, diagnostic.Detail, "error_code: validation_unique_failed\n")
+	assert.Contains(t, diagnostic.Detail, "trace: 6a0b1c5e-8a52-4d1a-9c5b-7b0c4e8f2d11\n")
+	assert.Contains(t, diagnostic.Detail, "remediation: A resource with the same name already exists.")
+	assert.NotContains(t, diagnostic.Detail, "summary:")
+}
+
+func TestTerraformProblemGetDiagWarning(t *testing.T) {
+	terraformProb := TerraformWarningf(nil, "Tags could not be attached.", "ibm_some_resource", "create")
+	assert.Equal(t, core.WarningSeverity, terraformProb.Severity)
+
+	diagnostics := terraformProb.GetDiag()
+	assert.False(t, diagnostics.HasError())
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, diag.Warning, diagnostics[0].Severity)
+	assert.Contains(t, diagnostics[0].Detail, "severity: warning\n")
+}
+
+func TestTerraformProblemHTTPDetails(t *testing.T) {
+	terraformProb := TerraformErrorf(getHTTPProblem(), "Create failed.", "ibm_is_vpc", "create")
+	assert.Equal(t, 409, terraformProb.GetStatusCode())
+	assert.Equal(t, "validation_unique_failed", terraformProb.GetErrorCode())
+	assert.Equal(t, "6a0b1c5e-8a52-4d1a-9c5b-7b0c4e8f2d11", terraformProb.GetTrace())
+	assert.Equal(t, remediationHints["validation_unique_failed"], terraformProb.GetRemediation())
+
+	// An explicit remediation hint takes precedence over the table.
+	terraformProb.WithRemediation("Rename the VPC.")
+	assert.Equal(t, "Rename the VPC.", terraformProb.GetRemediation())
+
+	// Without a body, the trace is read from the headers and the hint is
+	// looked up from the status code.
+	httpProb := getHTTPProblem()
+	httpProb.Response = &core.DetailedResponse{
+		StatusCode: http.StatusForbidden,
+		Headers:    http.Header{"X-Request-Id": []string{"request-1"}},
+	}
+	terraformProb = TerraformErrorf(httpProb, "Create failed.", "ibm_is_vpc", "create")
+	assert.Equal(t, "", terraformProb.GetErrorCode())
+	assert.Equal(t, "request-1", terraformProb.GetTrace())
+	assert.Equal(t, statusCodeRemediationHints[http.StatusForbidden], terraformProb.GetRemediation())
+
+	// Problems that are not caused by an API call have no details.
+	terraformProb = getPopulatedTerraformProblem()
+	assert.Equal(t, 0, terraformProb.GetStatusCode())
+	assert.Equal(t, "", terraformProb.GetTrace())
+	assert.Equal(t, "", terraformProb.GetRemediation())
+}
+
+func TestTerraformProblemWithAttribute(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem().WithAttribute("boot_volume.0.name")
+	assert.Equal(t, cty.GetAttrPath("boot_volume").IndexInt(0).GetAttr("name"), terraformProb.AttributePath)
+	assert.Equal(t, "boot_volume.0.name", formatAttributePath(terraformProb.AttributePath))
 }
 
 func TestTerraformErrorf(t *testing.T) {
@@ -221,6 +305,26 @@ func TestGetComponentInfo(t *testing.T) {
 	assert.Equal(t, v.Version, component.Version)
 }
 
+func getHTTPProblem() *core.HTTPProblem {
+	return &core.HTTPProblem{
+		IBMProblem:  core.IBMErrorf(nil, core.NewProblemComponent("vpc", "1.0.0"), "Provided name is not unique.", ""),
+		OperationID: "CreateVPC",
+		Response: &core.DetailedResponse{
+			StatusCode: http.StatusConflict,
+			Headers:    http.Header{"X-Request-Id": []string{"request-1"}},
+			Result: map[string]interface{}{
+				"errors": []interface{}{
+					map[string]interface{}{
+						"code":    "validation_unique_failed",
+						"message": "Provided name is not unique.",
+					},
+				},
+				"trace": "6a0b1c5e-8a52-4d1a-9c5b-7b0c4e8f2d11",
+			},
+		},
+	}
+}
+
 func getPopulatedTerraformProblem() *TerraformProblem {
 	return &TerraformProblem{
 		IBMProblem: &core.IBMProblem{
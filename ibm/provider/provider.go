@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -167,6 +168,58 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The retry count to set for API calls.",
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The retry policy for API calls. The policy is applied to every service client, and can be overridden for single services.",
+				Elem: &schema.Resource{
+					Schema: retryPolicySchema(map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Overrides the retry policy for a single service.",
+							Elem: &schema.Resource{
+								Schema: retryPolicySchema(map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the service, for example `vpc` or `iam_identity`.",
+									},
+								}),
+							},
+						},
+					}),
+				},
+			},
+			"waiter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The polling of the waiters that wait for a resource to reach a state, for example for a VPC instance to be running.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The wait (in seconds) before the first poll. The default value is 2.",
+						},
+						"min_poll_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The interval (in seconds) after the first poll. It doubles after every poll. The default value is 2.",
+						},
+						"max_poll_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum interval (in seconds) between two polls. The default value is 10.",
+						},
+					},
+				},
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -672,6 +725,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl":                     vpc.DataSourceIBMIsNetworkACL(),
 			"ibm_is_network_acl_rule":                vpc.DataSourceIBMISNetworkACLRule(),
 			"ibm_is_network_acl_rules":               vpc.DataSourceIBMISNetworkACLRules(),
+			"ibm_is_network_path_analysis":           vpc.DataSourceIBMIsNetworkPathAnalysis(),
 			"ibm_lbaas":                              classicinfrastructure.DataSourceIBMLbaas(),
 			"ibm_network_vlan":                       classicinfrastructure.DataSourceIBMNetworkVlan(),
 			"ibm_org":                                cloudfoundry.DataSourceIBMOrg(),
@@ -764,6 +818,8 @@ func Provider() *schema.Provider {
 			"ibm_pi_instance_vpmem_volumes":                 power.DataSourceIBMPIInstanceVpmemVolumes(),
 			"ibm_pi_instance":                               power.DataSourceIBMPIInstance(),
 			"ibm_pi_instances":                              power.DataSourceIBMPIInstances(),
+			"ibm_pi_job":                                    power.DataSourceIBMPIJob(),
+			"ibm_pi_jobs":                                   power.DataSourceIBMPIJobs(),
 			"ibm_pi_key":                                    power.DataSourceIBMPIKey(),
 			"ibm_pi_keys":                                   power.DataSourceIBMPIKeys(),
 			"ibm_pi_network_address_group":                  power.DataSourceIBMPINetworkAddressGroup(),
@@ -1299,6 +1355,8 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket_replication_rule":               cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                         cos.ResourceIBMCOSBucketObject(),
 			"ibm_cos_bucket_object_lock_configuration":      cos.ResourceIBMCOSBucketObjectlock(),
+			"ibm_cos_bucket_objects_sync":                   cos.ResourceIBMCOSBucketObjectsSync(),
+			"ibm_cos_bucket_cors_configuration":             cos.ResourceIBMCOSBucketCorsConfiguration(),
 			"ibm_cos_bucket_website_configuration":          cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_cos_bucket_lifecycle_configuration":        cos.ResourceIBMCOSBucketLifecycleConfiguration(),
 			"ibm_cos_backup_vault":                          cos.ResourceIBMCOSBackupVault(),
@@ -1421,6 +1479,7 @@ func Provider() *schema.Provider {
 			"ibm_is_private_path_service_gateway_operations":                          vpc.ResourceIBMIsPrivatePathServiceGatewayOperations(),
 			"ibm_is_security_group":                        vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                   vpc.ResourceIBMISSecurityGroupRule(),
+			"ibm_is_security_group_rules":                  vpc.ResourceIBMISSecurityGroupRules(),
 			"ibm_is_security_group_target":                 vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_share":                                 vpc.ResourceIbmIsShare(),
 			"ibm_is_share_replica_operations":              vpc.ResourceIbmIsShareReplicaOperations(),
@@ -1457,6 +1516,7 @@ func Provider() *schema.Provider {
 			"ibm_is_image_deprecate":                       vpc.ResourceIBMISImageDeprecate(),
 			"ibm_is_image_export_job":                      vpc.ResourceIBMIsImageExportJob(),
 			"ibm_is_image_obsolete":                        vpc.ResourceIBMISImageObsolete(),
+			"ibm_is_image_upload":                          vpc.ResourceIBMIsImageUpload(),
 			"ibm_lb":                                       classicinfrastructure.ResourceIBMLb(),
 			"ibm_lbaas":                                    classicinfrastructure.ResourceIBMLbaas(),
 			"ibm_lbaas_health_monitor":                     classicinfrastructure.ResourceIBMLbaasHealthMonitor(),
@@ -1535,12 +1595,14 @@ func Provider() *schema.Provider {
 			"ibm_pi_cloud_connection":                power.ResourceIBMPICloudConnection(),
 			"ibm_pi_console_language":                power.ResourceIBMPIInstanceConsoleLanguage(),
 			"ibm_pi_dhcp":                            power.ResourceIBMPIDhcp(),
+			"ibm_pi_dr_plan":                         power.ResourceIBMPIDRPlan(),
 			"ibm_pi_host_group":                      power.ResourceIBMPIHostGroup(),
 			"ibm_pi_host":                            power.ResourceIBMPIHost(),
 			"ibm_pi_ike_policy":                      power.ResourceIBMPIIKEPolicy(),
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
+			"ibm_pi_instance_set":                    power.ResourceIBMPIInstanceSet(),
 			"ibm_pi_instance_snapshot":               power.ResourceIBMPIInstanceSnapshot(),
 			"ibm_pi_instance_vpmem_volumes":          power.ResourceIBMPIInstanceVpmemVolumes(),
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
@@ -1901,6 +1963,8 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
 		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
 		Importer:             resource.Importer,
+		Identity:             resource.Identity,
+		ResourceBehavior:     resource.ResourceBehavior,
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
 		Description:          resource.Description,
@@ -1986,14 +2050,7 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	}
 
 	log.Printf("[DEBUG] %s", tfError.GetDebugMessage())
-	return append(
-		diags,
-		diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  tfError.Error(),
-			Detail:   tfError.GetConsoleMessage(),
-		},
-	)
+	return append(diags, tfError.GetDiag()...)
 }
 
 func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
@@ -2778,6 +2835,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		os.Setenv("FUNCTION_NAMESPACE", wskNameSpace)
 	}
 
+	retryPolicy, serviceRetryPolicies := expandRetryPolicies(d.Get("retry").([]interface{}))
+	waiterPolicy := expandWaiterPolicy(d.Get("waiter").([]interface{}))
+
 	config := conns.Config{
 		BluemixAPIKey:         bluemixAPIKey,
 		Region:                region,
@@ -2800,7 +2860,82 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		IAMTrustedProfileID:   iamTrustedProfileId,
 		IAMTrustedProfileName: iamTrustedProfileName,
 		Account:               account,
+		RetryPolicy:           retryPolicy,
+		ServiceRetryPolicies:  serviceRetryPolicies,
+		WaiterPolicy:          waiterPolicy,
 	}
 
 	return config.ClientSession()
 }
+
+// retryPolicySchema adds the attributes of a retry policy to the given schema.
+func retryPolicySchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["max_attempts"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum number of attempts of an API call, including the first one.",
+	}
+	s["min_backoff"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The backoff (in seconds) after the first failed attempt. It doubles after every attempt, with jitter.",
+	}
+	s["max_backoff"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum backoff (in seconds) between two attempts, which also caps the wait requested by a Retry-After header.",
+	}
+	s["retryable_status_codes"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(400, 599),
+		},
+		Description: "The HTTP status codes that are retried. By default 429 and all 5xx status codes except 501 are retried.",
+	}
+	return s
+}
+
+func expandRetryPolicy(m map[string]interface{}) conns.RetryPolicy {
+	policy := conns.RetryPolicy{
+		MaxAttempts: m["max_attempts"].(int),
+		MinBackoff:  time.Duration(m["min_backoff"].(int)) * time.Second,
+		MaxBackoff:  time.Duration(m["max_backoff"].(int)) * time.Second,
+	}
+	for _, code := range m["retryable_status_codes"].([]interface{}) {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+	}
+	return policy
+}
+
+func expandRetryPolicies(l []interface{}) (conns.RetryPolicy, map[string]conns.RetryPolicy) {
+	if len(l) == 0 || l[0] == nil {
+		return conns.RetryPolicy{}, nil
+	}
+	m := l[0].(map[string]interface{})
+	services := map[string]conns.RetryPolicy{}
+	for _, service := range m["service"].([]interface{}) {
+		if service == nil {
+			continue
+		}
+		serviceMap := service.(map[string]interface{})
+		services[serviceMap["name"].(string)] = expandRetryPolicy(serviceMap)
+	}
+	return expandRetryPolicy(m), services
+}
+
+func expandWaiterPolicy(l []interface{}) conns.WaiterPolicy {
+	if len(l) == 0 || l[0] == nil {
+		return conns.WaiterPolicy{}
+	}
+	m := l[0].(map[string]interface{})
+	return conns.WaiterPolicy{
+		Delay:           time.Duration(m["delay"].(int)) * time.Second,
+		MinPollInterval: time.Duration(m["min_poll_interval"].(int)) * time.Second,
+		MaxPollInterval: time.Duration(m["max_poll_interval"].(int)) * time.Second,
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &buildCRNFunction{}
+
+func NewBuildCRNFunction() function.Function {
+	return &buildCRNFunction{}
+}
+
+type buildCRNFunction struct{}
+
+func (f *buildCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_crn"
+}
+
+func (f *buildCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an IBM Cloud CRN from its segments",
+		Description: "Builds an IBM Cloud Resource Name (CRN) from an object with the same attributes as the result of `parse_crn`. " +
+			"Null attributes are treated as empty segments. Only the `resource` segment may contain `:`.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "segments",
+				Description:    "The segments of the CRN.",
+				AttributeTypes: crnAttributeTypes,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var segments crnModel
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &segments))
+	if resp.Error != nil {
+		return
+	}
+
+	crn, err := segments.toCRN()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to build CRN: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, crn.String()))
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &cosPresignURLFunction{}
+
+func NewCOSPresignURLFunction() function.Function {
+	return &cosPresignURLFunction{}
+}
+
+type cosPresignURLFunction struct{}
+
+func (f *cosPresignURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cos_presign_url"
+}
+
+func (f *cosPresignURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a presigned URL of a COS object",
+		Description: "Returns a SigV4 presigned URL of an object of a COS bucket, signed locally with HMAC keys. " +
+			"The URL is valid from `sign_time` for `expires_in` seconds, at most 7 days. " +
+			"The result only depends on the arguments, so use a time that is stable across runs, such as the `rfc3339` attribute of a `time_static` resource, for `sign_time`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "bucket_crn",
+				Description: "The CRN of the COS bucket.",
+			},
+			function.StringParameter{
+				Name:        "bucket_location",
+				Description: "The location of the COS bucket.",
+			},
+			function.StringParameter{
+				Name:           "endpoint_type",
+				AllowNullValue: true,
+				Description:    "The type of the endpoint of the URL: public, private or direct. The public endpoint is used when null.",
+			},
+			function.StringParameter{
+				Name:        "key",
+				Description: "The key of the object.",
+			},
+			function.StringParameter{
+				Name:        "method",
+				Description: "The HTTP method the URL is presigned for: GET, PUT, HEAD or DELETE.",
+			},
+			function.StringParameter{
+				Name:        "sign_time",
+				Description: "The time from which the URL is valid, in RFC 3339 format.",
+			},
+			function.Int64Parameter{
+				Name:        "expires_in",
+				Description: "The number of seconds the URL is valid from sign_time, between 1 and 604800 (7 days).",
+			},
+			function.MapParameter{
+				Name:        "credentials",
+				ElementType: types.StringType,
+				Description: "The HMAC keys, either as access_key_id and secret_access_key, or the credentials of an ibm_resource_key created with the parameter HMAC set to true.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *cosPresignURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucketCRN, bucketLocation, key, method, signTimeValue string
+	var endpointType types.String
+	var expiresIn int64
+	var creds map[string]string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bucketCRN, &bucketLocation, &endpointType, &key, &method, &signTimeValue, &expiresIn, &creds))
+	if resp.Error != nil {
+		return
+	}
+
+	switch endpointType.ValueString() {
+	case "", "public", "private", "direct":
+	default:
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("endpoint_type must be one of \"public\", \"private\" or \"direct\", got: %q", endpointType.ValueString()))
+		return
+	}
+
+	// The function does not read the clock, so that the same arguments always return the same URL
+	// during the plan and the apply
+	signTime, err := time.Parse(time.RFC3339, signTimeValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(5, fmt.Sprintf("%q is not a valid RFC 3339 time: %s", signTimeValue, err))
+		return
+	}
+	if expiresIn < 1 || expiresIn > int64(cos.PresignMaxExpiry.Seconds()) {
+		resp.Error = function.NewArgumentFuncError(6, fmt.Sprintf("expires_in must be between 1 and %d seconds, got: %d", int64(cos.PresignMaxExpiry.Seconds()), expiresIn))
+		return
+	}
+	accessKeyID, secretAccessKey, err := cos.HMACKeysFromCredentials(creds)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(7, err.Error())
+		return
+	}
+
+	presignedURL, err := cos.PresignURL(cos.PresignURLOptions{
+		BucketCRN:       bucketCRN,
+		BucketLocation:  bucketLocation,
+		EndpointType:    endpointType.ValueString(),
+		Key:             key,
+		Method:          method,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SignTime:        signTime,
+		Expiry:          time.Duration(expiresIn) * time.Second,
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, presignedURL))
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework_test
+
+import (
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmCOSPresignURLFunctionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "url" {
+					value = provider::ibm::cos_presign_url(
+						"crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts",
+						"us-south",
+						null,
+						"builds/app.tgz",
+						"GET",
+						"2026-01-01T00:00:00Z",
+						3600,
+						{ "cos_hmac_keys.access_key_id" = "access-key-id", "cos_hmac_keys.secret_access_key" = "secret-access-key" },
+					)
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("url", regexp.MustCompile(`^https://s3\.us-south\.cloud-object-storage\.appdomain\.cloud/artifacts/builds/app\.tgz\?.*X-Amz-Date=20260101T000000Z&X-Amz-Expires=3600&.*X-Amz-Signature=[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				Config: `
+				output "url" {
+					value = provider::ibm::cos_presign_url(
+						"crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts",
+						"us-south",
+						null,
+						"builds/app.tgz",
+						"GET",
+						"2026-01-01T00:00:00Z",
+						691200,
+						{ access_key_id = "access-key-id", secret_access_key = "secret-access-key" },
+					)
+				}`,
+				ExpectError: regexp.MustCompile("expires_in must be between 1 and 604800 seconds"),
+			},
+			{
+				Config: `
+				output "url" {
+					value = provider::ibm::cos_presign_url(
+						"crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts",
+						"us-south",
+						null,
+						"builds/app.tgz",
+						"GET",
+						"tomorrow",
+						3600,
+						{ access_key_id = "access-key-id", secret_access_key = "secret-access-key" },
+					)
+				}`,
+				ExpectError: regexp.MustCompile("is not a valid RFC 3339 time"),
+			},
+			{
+				Config: `
+				output "url" {
+					value = provider::ibm::cos_presign_url(
+						"crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts",
+						"us-south",
+						null,
+						"builds/app.tgz",
+						"GET",
+						"2026-01-01T00:00:00Z",
+						3600,
+						{ apikey = "apikey" },
+					)
+				}`,
+				ExpectError: regexp.MustCompile("do not contain HMAC keys"),
+			},
+			{
+				Config: `
+				output "url" {
+					value = provider::ibm::cos_presign_url(
+						"crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts",
+						"us-south",
+						"private",
+						"builds/app.tgz",
+						"get",
+						"2026-01-01T00:00:00Z",
+						3600,
+						{ access_key_id = "access-key-id", secret_access_key = "secret-access-key" },
+					)
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("url", regexp.MustCompile(`^https://s3\.private\.us-south\.cloud-object-storage\.appdomain\.cloud/artifacts/builds/app\.tgz\?`)),
+				),
+			},
+			{
+				Config: `
+				output "url" {
+					value = provider::ibm::cos_presign_url(
+						"crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts",
+						"us-south",
+						"vpc",
+						"builds/app.tgz",
+						"GET",
+						"2026-01-01T00:00:00Z",
+						3600,
+						{ access_key_id = "access-key-id", secret_access_key = "secret-access-key" },
+					)
+				}`,
+				ExpectError: regexp.MustCompile("endpoint_type must be one of"),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// crnAttributeTypes are the attributes of the CRN object returned by parse_crn and accepted by build_crn.
+var crnAttributeTypes = map[string]attr.Type{
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"location":         types.StringType,
+	"scope":            types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+// crnModel describes the CRN object used by the CRN provider functions.
+type crnModel struct {
+	Version         types.String `tfsdk:"version"`
+	CName           types.String `tfsdk:"cname"`
+	CType           types.String `tfsdk:"ctype"`
+	ServiceName     types.String `tfsdk:"service_name"`
+	Location        types.String `tfsdk:"location"`
+	Scope           types.String `tfsdk:"scope"`
+	ServiceInstance types.String `tfsdk:"service_instance"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	Resource        types.String `tfsdk:"resource"`
+}
+
+// crnFunctionDescription is shared by the descriptions of the CRN provider functions.
+const crnFunctionDescription = "The CRN is returned as an object with the attributes `version`, `cname`, `ctype`, `service_name`, " +
+	"`location`, `scope`, `service_instance`, `resource_type` and `resource`. Empty segments of the CRN are returned as empty strings."
+
+func newCRNModel(crn flex.CRN) crnModel {
+	return crnModel{
+		Version:         types.StringValue(crn.Version),
+		CName:           types.StringValue(crn.CName),
+		CType:           types.StringValue(crn.CType),
+		ServiceName:     types.StringValue(crn.ServiceName),
+		Location:        types.StringValue(crn.Region),
+		Scope:           types.StringValue(crn.ScopeSegment()),
+		ServiceInstance: types.StringValue(crn.ServiceInstance),
+		ResourceType:    types.StringValue(crn.ResourceType),
+		Resource:        types.StringValue(crn.Resource),
+	}
+}
+
+// toCRN converts the model to a CRN. Null attributes are treated as empty segments.
+func (m crnModel) toCRN() (flex.CRN, error) {
+	segments := []struct {
+		name  string
+		value types.String
+	}{
+		{"version", m.Version},
+		{"cname", m.CName},
+		{"ctype", m.CType},
+		{"service_name", m.ServiceName},
+		{"location", m.Location},
+		{"scope", m.Scope},
+		{"service_instance", m.ServiceInstance},
+		{"resource_type", m.ResourceType},
+	}
+	for _, segment := range segments {
+		if strings.Contains(segment.value.ValueString(), ":") {
+			return flex.CRN{}, fmt.Errorf("the %s segment %q must not contain \":\"", segment.name, segment.value.ValueString())
+		}
+	}
+
+	// Parsing the assembled string validates the scope the same way as any other CRN
+	return flex.Parse(flex.CRN{
+		Version:         m.Version.ValueString(),
+		CName:           m.CName.ValueString(),
+		CType:           m.CType.ValueString(),
+		ServiceName:     m.ServiceName.ValueString(),
+		Region:          m.Location.ValueString(),
+		Scope:           m.Scope.ValueString(),
+		ServiceInstance: m.ServiceInstance.ValueString(),
+		ResourceType:    m.ResourceType.ValueString(),
+		Resource:        m.Resource.ValueString(),
+	}.String())
+}
+
+// parseCRNArgument parses a CRN passed as a function argument.
+func parseCRNArgument(value string) (flex.CRN, error) {
+	if value == "" {
+		return flex.CRN{}, fmt.Errorf("the CRN must not be empty")
+	}
+	crn, err := flex.Parse(value)
+	if err != nil {
+		return flex.CRN{}, fmt.Errorf("%q is not a valid CRN: %s", value, err)
+	}
+	return crn, nil
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &crnMatchesFunction{}
+
+func NewCRNMatchesFunction() function.Function {
+	return &crnMatchesFunction{}
+}
+
+type crnMatchesFunction struct{}
+
+func (f *crnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_matches"
+}
+
+func (f *crnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an IBM Cloud CRN matches a pattern",
+		Description: "Returns true when every non-empty segment of the pattern CRN is equal to the same segment of the CRN. " +
+			"Empty segments of the pattern match any value, so `crn:v1:bluemix:public:is:::::` matches every VPC infrastructure CRN in the public cloud.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to check.",
+			},
+			function.StringParameter{
+				Name:        "pattern",
+				Description: "The CRN pattern to match against.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *crnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, patternValue string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &patternValue))
+	if resp.Error != nil {
+		return
+	}
+
+	crn, err := parseCRNArgument(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	pattern, err := parseCRNArgument(patternValue)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, crn.Matches(pattern)))
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework_test
+
+import (
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmParseCRNFunctionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "crn" {
+					value = provider::ibm::parse_crn("crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678::")
+				}
+
+				output "resource" {
+					value = provider::ibm::parse_crn("crn:v1:bluemix:public:secrets-manager:us-south:a/1234:5678:secret:key:value").resource
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("resource", "key:value"),
+				),
+			},
+			{
+				Config: `
+				output "crn" {
+					value = provider::ibm::parse_crn("crn:v1:bluemix:public")
+				}`,
+				ExpectError: regexp.MustCompile("is not a valid CRN"),
+			},
+		},
+	})
+}
+
+func TestAccIbmBuildCRNFunctionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "crn" {
+					value = provider::ibm::build_crn(merge(
+						provider::ibm::parse_crn("crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1"),
+						{ resource = "r006-2" }
+					))
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("crn", "crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmCRNMatchesFunctionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "matches" {
+					value = provider::ibm::crn_matches("crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1", "crn:v1:bluemix:public:is::a/1234:::")
+				}
+
+				output "does_not_match" {
+					value = provider::ibm::crn_matches("crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1", "crn:v1:bluemix:public:is:eu-de::::")
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("matches", "true"),
+					resource.TestCheckOutput("does_not_match", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &parseCRNFunction{}
+
+func NewParseCRNFunction() function.Function {
+	return &parseCRNFunction{}
+}
+
+type parseCRNFunction struct{}
+
+func (f *parseCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_crn"
+}
+
+func (f *parseCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an IBM Cloud CRN into its segments",
+		Description: "Parses an IBM Cloud Resource Name (CRN) into its segments. " + crnFunctionDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: crnAttributeTypes,
+		},
+	}
+}
+
+func (f *parseCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	crn, err := parseCRNArgument(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, newCRNModel(crn)))
+}
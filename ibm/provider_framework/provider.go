@@ -10,8 +10,17 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/codeengine"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,12 +29,16 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &frameworkProvider{}
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 // frameworkProvider is the provider implementation for the IBM Cloud Terraform Provider
 // using the terraform-plugin-framework. This provider runs alongside the existing SDKv2
-// provider via terraform-plugin-mux to enable framework-only features like Actions.
+// provider via terraform-plugin-mux to enable framework-only features like Actions and
+// Ephemeral Resources.
 type frameworkProvider struct {
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance testing.
@@ -34,33 +47,60 @@ type frameworkProvider struct {
 
 // frameworkProviderModel describes the provider data model.
 type frameworkProviderModel struct {
-	BluemixAPIKey          types.String `tfsdk:"bluemix_api_key"`
-	BluemixTimeout         types.Int64  `tfsdk:"bluemix_timeout"`
-	IBMCloudAPIKey         types.String `tfsdk:"ibmcloud_api_key"`
-	IBMCloudTimeout        types.Int64  `tfsdk:"ibmcloud_timeout"`
-	Region                 types.String `tfsdk:"region"`
-	Zone                   types.String `tfsdk:"zone"`
-	ResourceGroup          types.String `tfsdk:"resource_group"`
-	SoftlayerAPIKey        types.String `tfsdk:"softlayer_api_key"`
-	SoftlayerUsername      types.String `tfsdk:"softlayer_username"`
-	SoftlayerEndpointURL   types.String `tfsdk:"softlayer_endpoint_url"`
-	SoftlayerTimeout       types.Int64  `tfsdk:"softlayer_timeout"`
-	IAASClassicAPIKey      types.String `tfsdk:"iaas_classic_api_key"`
-	IAASClassicUsername    types.String `tfsdk:"iaas_classic_username"`
-	IAASClassicEndpointURL types.String `tfsdk:"iaas_classic_endpoint_url"`
-	IAASClassicTimeout     types.Int64  `tfsdk:"iaas_classic_timeout"`
-	MaxRetries             types.Int64  `tfsdk:"max_retries"`
-	FunctionNamespace      types.String `tfsdk:"function_namespace"`
-	RIAASEndpoint          types.String `tfsdk:"riaas_endpoint"`
-	Generation             types.Int64  `tfsdk:"generation"`
-	IAMProfileID           types.String `tfsdk:"iam_profile_id"`
-	IAMProfileName         types.String `tfsdk:"iam_profile_name"`
-	IAMToken               types.String `tfsdk:"iam_token"`
-	IAMRefreshToken        types.String `tfsdk:"iam_refresh_token"`
-	Visibility             types.String `tfsdk:"visibility"`
-	PrivateEndpointType    types.String `tfsdk:"private_endpoint_type"`
-	EndpointsFilePath      types.String `tfsdk:"endpoints_file_path"`
-	IBMCloudAccountID      types.String `tfsdk:"ibmcloud_account_id"`
+	BluemixAPIKey          types.String  `tfsdk:"bluemix_api_key"`
+	BluemixTimeout         types.Int64   `tfsdk:"bluemix_timeout"`
+	IBMCloudAPIKey         types.String  `tfsdk:"ibmcloud_api_key"`
+	IBMCloudTimeout        types.Int64   `tfsdk:"ibmcloud_timeout"`
+	Region                 types.String  `tfsdk:"region"`
+	Zone                   types.String  `tfsdk:"zone"`
+	ResourceGroup          types.String  `tfsdk:"resource_group"`
+	SoftlayerAPIKey        types.String  `tfsdk:"softlayer_api_key"`
+	SoftlayerUsername      types.String  `tfsdk:"softlayer_username"`
+	SoftlayerEndpointURL   types.String  `tfsdk:"softlayer_endpoint_url"`
+	SoftlayerTimeout       types.Int64   `tfsdk:"softlayer_timeout"`
+	IAASClassicAPIKey      types.String  `tfsdk:"iaas_classic_api_key"`
+	IAASClassicUsername    types.String  `tfsdk:"iaas_classic_username"`
+	IAASClassicEndpointURL types.String  `tfsdk:"iaas_classic_endpoint_url"`
+	IAASClassicTimeout     types.Int64   `tfsdk:"iaas_classic_timeout"`
+	MaxRetries             types.Int64   `tfsdk:"max_retries"`
+	FunctionNamespace      types.String  `tfsdk:"function_namespace"`
+	RIAASEndpoint          types.String  `tfsdk:"riaas_endpoint"`
+	Generation             types.Int64   `tfsdk:"generation"`
+	IAMProfileID           types.String  `tfsdk:"iam_profile_id"`
+	IAMProfileName         types.String  `tfsdk:"iam_profile_name"`
+	IAMToken               types.String  `tfsdk:"iam_token"`
+	IAMRefreshToken        types.String  `tfsdk:"iam_refresh_token"`
+	Visibility             types.String  `tfsdk:"visibility"`
+	PrivateEndpointType    types.String  `tfsdk:"private_endpoint_type"`
+	EndpointsFilePath      types.String  `tfsdk:"endpoints_file_path"`
+	IBMCloudAccountID      types.String  `tfsdk:"ibmcloud_account_id"`
+	Retry                  []retryModel  `tfsdk:"retry"`
+	Waiter                 []waiterModel `tfsdk:"waiter"`
+}
+
+// retryModel describes the retry block of the provider.
+type retryModel struct {
+	MaxAttempts          types.Int64         `tfsdk:"max_attempts"`
+	MinBackoff           types.Int64         `tfsdk:"min_backoff"`
+	MaxBackoff           types.Int64         `tfsdk:"max_backoff"`
+	RetryableStatusCodes []types.Int64       `tfsdk:"retryable_status_codes"`
+	Service              []serviceRetryModel `tfsdk:"service"`
+}
+
+// serviceRetryModel describes a service override of the retry block.
+type serviceRetryModel struct {
+	Name                 types.String  `tfsdk:"name"`
+	MaxAttempts          types.Int64   `tfsdk:"max_attempts"`
+	MinBackoff           types.Int64   `tfsdk:"min_backoff"`
+	MaxBackoff           types.Int64   `tfsdk:"max_backoff"`
+	RetryableStatusCodes []types.Int64 `tfsdk:"retryable_status_codes"`
+}
+
+// waiterModel describes the waiter block of the provider.
+type waiterModel struct {
+	Delay           types.Int64 `tfsdk:"delay"`
+	MinPollInterval types.Int64 `tfsdk:"min_poll_interval"`
+	MaxPollInterval types.Int64 `tfsdk:"max_poll_interval"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -202,7 +242,82 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Description: "The IBM Cloud account ID",
 			},
 		},
+		// The values of the retry and waiter blocks are validated by the SDKv2 provider
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				Description: "The retry policy for API calls. The policy is applied to every service client, and can be overridden for single services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: retryPolicyAttributes(map[string]schema.Attribute{}),
+					Blocks: map[string]schema.Block{
+						"service": schema.ListNestedBlock{
+							Description: "Overrides the retry policy for a single service.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: retryPolicyAttributes(map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "The name of the service, for example `vpc` or `iam_identity`.",
+									},
+								}),
+							},
+						},
+					},
+				},
+			},
+			"waiter": schema.ListNestedBlock{
+				Description: "The polling of the waiters that wait for a resource to reach a state, for example for a VPC instance to be running.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"delay": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The wait (in seconds) before the first poll. The default value is 2.",
+						},
+						"min_poll_interval": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The interval (in seconds) after the first poll. It doubles after every poll. The default value is 2.",
+						},
+						"max_poll_interval": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum interval (in seconds) between two polls. The default value is 10.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// retryPolicyAttributes adds the attributes of a retry policy to the given attributes.
+func retryPolicyAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["max_attempts"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "The maximum number of attempts of an API call, including the first one.",
+	}
+	attributes["min_backoff"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "The backoff (in seconds) after the first failed attempt. It doubles after every attempt, with jitter.",
+	}
+	attributes["max_backoff"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "The maximum backoff (in seconds) between two attempts, which also caps the wait requested by a Retry-After header.",
+	}
+	attributes["retryable_status_codes"] = schema.ListAttribute{
+		Optional:    true,
+		ElementType: types.Int64Type,
+		Description: "The HTTP status codes that are retried. By default 429 and all 5xx status codes except 501 are retried.",
+	}
+	return attributes
+}
+
+func newRetryPolicy(maxAttempts, minBackoff, maxBackoff types.Int64, statusCodes []types.Int64) conns.RetryPolicy {
+	policy := conns.RetryPolicy{
+		MaxAttempts: int(maxAttempts.ValueInt64()),
+		MinBackoff:  time.Duration(minBackoff.ValueInt64()) * time.Second,
+		MaxBackoff:  time.Duration(maxBackoff.ValueInt64()) * time.Second,
 	}
+	for _, code := range statusCodes {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(code.ValueInt64()))
+	}
+	return policy
 }
 
 // Configure prepares the provider for data sources and resources.
@@ -478,6 +593,22 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 	if !config.IBMCloudAccountID.IsNull() {
 		connConfig.Account = config.IBMCloudAccountID.ValueString()
 	}
+	if len(config.Retry) > 0 {
+		retry := config.Retry[0]
+		connConfig.RetryPolicy = newRetryPolicy(retry.MaxAttempts, retry.MinBackoff, retry.MaxBackoff, retry.RetryableStatusCodes)
+		connConfig.ServiceRetryPolicies = map[string]conns.RetryPolicy{}
+		for _, service := range retry.Service {
+			connConfig.ServiceRetryPolicies[service.Name.ValueString()] = newRetryPolicy(service.MaxAttempts, service.MinBackoff, service.MaxBackoff, service.RetryableStatusCodes)
+		}
+	}
+	if len(config.Waiter) > 0 {
+		waiter := config.Waiter[0]
+		connConfig.WaiterPolicy = conns.WaiterPolicy{
+			Delay:           time.Duration(waiter.Delay.ValueInt64()) * time.Second,
+			MinPollInterval: time.Duration(waiter.MinPollInterval.ValueInt64()) * time.Second,
+			MaxPollInterval: time.Duration(waiter.MaxPollInterval.ValueInt64()) * time.Second,
+		}
+	}
 
 	// Initialize client session
 	session, err := connConfig.ClientSession()
@@ -491,10 +622,12 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Set the client session for resources, data sources, and actions
+	// Set the client session for resources, data sources, ephemeral resources, actions, and list resources
 	resp.DataSourceData = session
 	resp.ResourceData = session
+	resp.EphemeralResourceData = session
 	resp.ActionData = session
+	resp.ListResourceData = session
 }
 
 // Resources defines the resources implemented in the provider.
@@ -513,5 +646,48 @@ func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource
 func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		codeengine.NewCodeEngineBuildRunAction,
+		vpc.NewIBMIsInstancePowerAction,
+		vpc.NewIBMIsBareMetalServerPowerAction,
+		power.NewIBMPIDRPlanFailoverAction,
+		power.NewIBMPIDRPlanFailbackAction,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iamidentity.NewIAMAccessTokenEphemeralResource,
+		cos.NewCOSPresignedURLEphemeralResource,
+		kubernetes.NewClusterKubeconfigEphemeralResource,
+		secretsmanager.NewIbmSmArbitrarySecretEphemeralResource,
+		secretsmanager.NewIbmSmKvSecretEphemeralResource,
+		secretsmanager.NewIbmSmUsernamePasswordSecretEphemeralResource,
+		secretsmanager.NewIbmSmIamCredentialsSecretEphemeralResource,
+		secretsmanager.NewIbmSmImportedCertificateEphemeralResource,
+		secretsmanager.NewIbmSmPrivateCertificateEphemeralResource,
+		secretsmanager.NewIbmSmPublicCertificateEphemeralResource,
+		secretsmanager.NewIbmSmServiceCredentialsSecretEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		vpc.NewIBMIsVPCListResource,
+		vpc.NewIBMIsSubnetListResource,
+		vpc.NewIBMIsInstanceListResource,
+		vpc.NewIBMIsSecurityGroupListResource,
+		vpc.NewIBMIsVolumeListResource,
+		vpc.NewIBMIsFloatingIPListResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseCRNFunction,
+		NewBuildCRNFunction,
+		NewCRNMatchesFunction,
+		NewCOSPresignURLFunction,
 	}
 }
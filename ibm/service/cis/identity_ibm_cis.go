@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// cisResourceIdentity returns the identity of a resource of a CIS instance,
+// whose ID is `<id>:<cis_id>`.
+func cisResourceIdentity(description string) flex.ResourceIdentity {
+	return flex.ResourceIdentity{
+		Attributes: []flex.IdentityAttribute{
+			{Name: "id", Description: description},
+			cisIdentityAttribute,
+		},
+		Separator: ":",
+	}
+}
+
+// cisDomainResourceIdentity returns the identity of a resource of a domain
+// of a CIS instance, whose ID is `<id>:<domain_id>:<cis_id>`.
+func cisDomainResourceIdentity(description string) flex.ResourceIdentity {
+	return flex.ResourceIdentity{
+		Attributes: []flex.IdentityAttribute{
+			{Name: "id", Description: description},
+			{Name: "domain_id", Description: "The ID of the domain."},
+			cisIdentityAttribute,
+		},
+		Separator: ":",
+	}
+}
+
+// cisIdentityAttribute is the CRN of the CIS instance, which is the last
+// part of the ID because it contains the separator.
+var cisIdentityAttribute = flex.IdentityAttribute{
+	Name:        "cis_id",
+	Description: "The CRN of the CIS instance.",
+	Validate:    flex.ValidateIdentityCRN,
+}
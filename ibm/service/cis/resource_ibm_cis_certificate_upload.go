@@ -37,8 +37,11 @@ const (
 	cisCertificateUploadDeleted         = "deleted"
 )
 
+// ibmCISCertificateUploadIdentity is the identity of ibm_cis_certificate_upload, which can be used to import it.
+var ibmCISCertificateUploadIdentity = cisDomainResourceIdentity("The ID of the certificate.")
+
 func ResourceIBMCISCertificateUpload() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   resourceCISCertificateUploadCreate,
 		Read:     resourceCISCertificateUploadRead,
 		Update:   resourceCISCertificateUploadUpdate,
@@ -129,7 +132,7 @@ func ResourceIBMCISCertificateUpload() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, ibmCISCertificateUploadIdentity)
 }
 
 func ResourceIBMCISCertificateUploadValidator() *validate.ResourceValidator {
@@ -49,8 +49,11 @@ const (
 	cisDNSRecordTypePTR   = "PTR"
 )
 
+// ibmCISDNSRecordIdentity is the identity of ibm_cis_dns_record, which can be used to import it.
+var ibmCISDNSRecordIdentity = cisDomainResourceIdentity("The ID of the DNS record.")
+
 func ResourceIBMCISDnsRecord() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   ResourceIBMCISDnsRecordCreate,
 		Read:     ResourceIBMCISDnsRecordRead,
 		Update:   ResourceIBMCISDnsRecordUpdate,
@@ -139,7 +142,7 @@ func ResourceIBMCISDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, ibmCISDNSRecordIdentity)
 }
 func ResourceIBMCISDnsRecordValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
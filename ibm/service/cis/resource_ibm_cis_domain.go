@@ -25,8 +25,11 @@ const (
 	ibmCISDomain                 = "ibm_cis_domain"
 )
 
+// ibmCISDomainIdentity is the identity of ibm_cis_domain, which can be used to import it.
+var ibmCISDomainIdentity = cisResourceIdentity("The ID of the domain.")
+
 func ResourceIBMCISDomain() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -85,7 +88,7 @@ func ResourceIBMCISDomain() *schema.Resource {
 		Update:   resourceCISdomainUpdate,
 		Delete:   resourceCISdomainDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, ibmCISDomainIdentity)
 }
 
 func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
@@ -21,8 +21,11 @@ const (
 	cisEdgeFunctionsActionScript     = "script"
 )
 
+// ibmCISEdgeFunctionsActionIdentity is the identity of ibm_cis_edge_functions_action, which can be used to import it.
+var ibmCISEdgeFunctionsActionIdentity = cisDomainResourceIdentity("The name of the edge functions script.")
+
 func ResourceIBMCISEdgeFunctionsAction() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   ResourceIBMCISEdgeFunctionsActionCreate,
 		Read:     ResourceIBMCISEdgeFunctionsActionRead,
 		Update:   ResourceIBMCISEdgeFunctionsActionUpdate,
@@ -55,7 +58,7 @@ func ResourceIBMCISEdgeFunctionsAction() *schema.Resource {
 				Description: "Edge function action script",
 			},
 		},
-	}
+	}, ibmCISEdgeFunctionsActionIdentity)
 }
 
 func ResourceIBMCISEdgeFunctionsActionValidator() *validate.ResourceValidator {
@@ -21,8 +21,11 @@ const (
 	cisFilterID          = "filter_id"
 )
 
+// ibmCISFilterIdentity is the identity of ibm_cis_filter, which can be used to import it.
+var ibmCISFilterIdentity = cisDomainResourceIdentity("The ID of the filter.")
+
 func ResourceIBMCISFilter() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   ResourceIBMCISFilterCreate,
 		Read:     ResourceIBMCISFilterRead,
 		Update:   ResourceIBMCISFilterUpdate,
@@ -63,7 +66,7 @@ func ResourceIBMCISFilter() *schema.Resource {
 				Description: "Filter Description",
 			},
 		},
-	}
+	}, ibmCISFilterIdentity)
 }
 func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).BluemixSession()
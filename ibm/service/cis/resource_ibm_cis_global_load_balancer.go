@@ -34,8 +34,11 @@ const (
 	cisGLBModifiedOn         = "modified_on"
 )
 
+// ibmCISGlobalLoadBalancerIdentity is the identity of ibm_cis_global_load_balancer, which can be used to import it.
+var ibmCISGlobalLoadBalancerIdentity = cisDomainResourceIdentity("The ID of the global load balancer.")
+
 func ResourceIBMCISGlb() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -173,7 +176,7 @@ func ResourceIBMCISGlb() *schema.Resource {
 		Exists:   resourceCISGlbExists,
 		Delete:   resourceCISGlbDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, ibmCISGlobalLoadBalancerIdentity)
 }
 func ResourceIBMCISGlbValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
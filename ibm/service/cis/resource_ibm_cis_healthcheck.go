@@ -35,8 +35,11 @@ const (
 	cisGLBHealthCheckHeadersValues   = "values"
 )
 
+// ibmCISHealthCheckIdentity is the identity of ibm_cis_healthcheck, which can be used to import it.
+var ibmCISHealthCheckIdentity = cisResourceIdentity("The ID of the health check.")
+
 func ResourceIBMCISHealthCheck() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 
 		Create:   resourceCISHealthCheckCreate,
 		Read:     resourceCISHealthCheckRead,
@@ -165,7 +168,7 @@ func ResourceIBMCISHealthCheck() *schema.Resource {
 				Set: hashByMapKey(cisGLBHealthCheckHeadersHeader),
 			},
 		},
-	}
+	}, ibmCISHealthCheckIdentity)
 }
 
 func ResourceIBMCISHealthCheckValidator() *validate.ResourceValidator {
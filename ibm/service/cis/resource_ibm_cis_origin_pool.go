@@ -37,8 +37,11 @@ const (
 	cisGLBPoolOriginsFailureReason = "failure_reason"
 )
 
+// ibmCISOriginPoolIdentity is the identity of ibm_cis_origin_pool, which can be used to import it.
+var ibmCISOriginPoolIdentity = cisResourceIdentity("The ID of the origin pool.")
+
 func ResourceIBMCISPool() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -157,7 +160,7 @@ func ResourceIBMCISPool() *schema.Resource {
 		Delete:   resourceCISPoolDelete,
 		Exists:   resourceCISPoolExists,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, ibmCISOriginPoolIdentity)
 }
 func ResourceIBMCISPoolValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
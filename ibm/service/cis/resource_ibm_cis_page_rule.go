@@ -42,8 +42,11 @@ const (
 	cisPageRuleActionsMinifyJS           = "js"
 )
 
+// ibmCISPageRuleIdentity is the identity of ibm_cis_page_rule, which can be used to import it.
+var ibmCISPageRuleIdentity = cisDomainResourceIdentity("The ID of the page rule.")
+
 func ResourceIBMCISPageRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   resourceCISPageRuleCreate,
 		Read:     resourceCISPageRuleRead,
 		Update:   resourceCISPageRuleUpdate,
@@ -163,7 +166,7 @@ func ResourceIBMCISPageRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmCISPageRuleIdentity)
 }
 
 func ResourceIBMCISPageRuleValidator() *validate.ResourceValidator {
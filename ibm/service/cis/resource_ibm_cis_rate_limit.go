@@ -23,8 +23,11 @@ const (
 	cisRLURL         = "url"
 )
 
+// ibmCISRateLimitIdentity is the identity of ibm_cis_rate_limit, which can be used to import it.
+var ibmCISRateLimitIdentity = cisDomainResourceIdentity("The ID of the rate limit.")
+
 func ResourceIBMCISRateLimit() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   ResourceIBMCISRateLimitCreate,
 		Read:     ResourceIBMCISRateLimitRead,
 		Update:   ResourceIBMCISRateLimitUpdate,
@@ -252,7 +255,7 @@ func ResourceIBMCISRateLimit() *schema.Resource {
 				Description: "Rate Limit rule Id",
 			},
 		},
-	}
+	}, ibmCISRateLimitIdentity)
 }
 func ResourceIBMCISRateLimitValidator() *validate.ResourceValidator {
 
@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	v4 "github.com/IBM/ibm-cos-sdk-go/aws/signer/v4"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/rest"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &cosPresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &cosPresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &cosPresignedURLEphemeralResource{}
+)
+
+const (
+	// PresignMaxExpiry is the longest validity of a SigV4 presigned URL
+	PresignMaxExpiry = 7 * 24 * time.Hour
+	// presignSigningRegion is the region of the credential scope, COS accepts the same one in every location
+	presignSigningRegion = "us-standard"
+)
+
+// PresignMethods are the HTTP methods an object URL can be presigned for
+var PresignMethods = []string{"GET", "PUT", "HEAD", "DELETE"}
+
+// PresignURLOptions describes the object URL to presign
+type PresignURLOptions struct {
+	BucketCRN       string
+	BucketLocation  string
+	EndpointType    string
+	EndpointsFile   string
+	Key             string
+	Method          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SignTime        time.Time
+	Expiry          time.Duration
+}
+
+// PresignURL returns the SigV4 presigned URL of an object. The URL is signed locally with the HMAC keys,
+// it is valid from options.SignTime for options.Expiry.
+func PresignURL(options PresignURLOptions) (string, error) {
+	if !strings.Contains(options.BucketCRN, ":bucket:") {
+		return "", fmt.Errorf("%q is not a COS bucket CRN", options.BucketCRN)
+	}
+	bucketName := strings.Split(options.BucketCRN, ":bucket:")[1]
+	if options.Key == "" {
+		return "", fmt.Errorf("the key of the object must not be empty")
+	}
+	method := strings.ToUpper(options.Method)
+	if !isPresignMethod(method) {
+		return "", fmt.Errorf("the method must be one of %s, got %q", strings.Join(PresignMethods, ", "), options.Method)
+	}
+	if options.Expiry <= 0 || options.Expiry > PresignMaxExpiry {
+		return "", fmt.Errorf("the expiry must be between 1 second and 7 days, got %s", options.Expiry)
+	}
+	if options.AccessKeyID == "" || options.SecretAccessKey == "" {
+		return "", fmt.Errorf("the access key ID and the secret access key must not be empty")
+	}
+
+	endpointType := options.EndpointType
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	visibility := endpointType
+	if endpointType == "direct" {
+		visibility = "private"
+	}
+	apiEndpoint := getCosEndpointType(options.BucketLocation, endpointType)
+	apiEndpoint = conns.FileFallBack(options.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", options.BucketLocation, apiEndpoint)
+	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	if apiEndpoint == "" {
+		return "", fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", options.BucketLocation, endpointType)
+	}
+	if !strings.Contains(apiEndpoint, "://") {
+		apiEndpoint = "https://" + apiEndpoint
+	}
+	objectURL, err := url.Parse(apiEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid COS endpoint %s, %v", apiEndpoint, err)
+	}
+	// Path style, as for the other requests of the provider
+	objectURL.Path = fmt.Sprintf("/%s/%s", bucketName, options.Key)
+	objectURL.RawPath = fmt.Sprintf("/%s/%s", bucketName, rest.EscapePath(options.Key, false))
+
+	req, err := http.NewRequest(method, objectURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build the request of the object %s, %v", options.Key, err)
+	}
+	signer := v4.NewSigner(credentials.NewStaticCredentials(options.AccessKeyID, options.SecretAccessKey, ""), func(s *v4.Signer) {
+		s.DisableURIPathEscaping = true
+	})
+	if _, err := signer.Presign(req, nil, "s3", presignSigningRegion, options.Expiry, options.SignTime); err != nil {
+		return "", fmt.Errorf("failed to presign the URL of the object %s, %v", options.Key, err)
+	}
+	return req.URL.String(), nil
+}
+
+// HMACKeysFromCredentials returns the HMAC keys of a credentials map. The map either holds the
+// access_key_id and secret_access_key keys, or is the credentials attribute of an ibm_resource_key
+// created with HMAC set to true.
+func HMACKeysFromCredentials(creds map[string]string) (string, string, error) {
+	for _, prefix := range []string{"", "cos_hmac_keys."} {
+		accessKeyID, secretAccessKey := creds[prefix+"access_key_id"], creds[prefix+"secret_access_key"]
+		if accessKeyID != "" && secretAccessKey != "" {
+			return accessKeyID, secretAccessKey, nil
+		}
+	}
+	return "", "", fmt.Errorf("the credentials do not contain HMAC keys, set access_key_id and secret_access_key, or use the credentials of a resource key created with the parameter HMAC set to true")
+}
+
+func isPresignMethod(method string) bool {
+	for _, m := range PresignMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func NewCOSPresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &cosPresignedURLEphemeralResource{}
+}
+
+type cosPresignedURLEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type cosPresignedURLModel struct {
+	BucketCRN      types.String `tfsdk:"bucket_crn"`
+	BucketLocation types.String `tfsdk:"bucket_location"`
+	EndpointType   types.String `tfsdk:"endpoint_type"`
+	Key            types.String `tfsdk:"key"`
+	Method         types.String `tfsdk:"method"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	Credentials    types.Map    `tfsdk:"credentials"`
+	URL            types.String `tfsdk:"url"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+func (r *cosPresignedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_cos_presigned_url"
+}
+
+func (r *cosPresignedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a presigned URL of a COS object, signed locally with HMAC keys. The URL gives temporary access to the object without IBM Cloud credentials, and is never persisted to the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_crn": schema.StringAttribute{
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": schema.StringAttribute{
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "COS endpoint type of the URL: public, private, direct. Default value is public.",
+			},
+			"key": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the object.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "The HTTP method the URL is presigned for: GET, PUT, HEAD, DELETE. Default value is GET.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds the URL is valid for, up to 604800 (7 days). Default value is 3600.",
+			},
+			"credentials": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The HMAC keys, either as access_key_id and secret_access_key, or the credentials of an ibm_resource_key created with the parameter HMAC set to true.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL of the object.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the URL expires, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *cosPresignedURLEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data cosPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.EndpointType.IsNull() && !data.EndpointType.IsUnknown() {
+		switch data.EndpointType.ValueString() {
+		case "public", "private", "direct":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint_type"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("\"endpoint_type\" must be one of \"public\", \"private\" or \"direct\", got: %q.", data.EndpointType.ValueString()),
+			)
+		}
+	}
+	if !data.Method.IsNull() && !data.Method.IsUnknown() && !isPresignMethod(strings.ToUpper(data.Method.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("method"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("\"method\" must be one of %s, got: %q.", strings.Join(PresignMethods, ", "), data.Method.ValueString()),
+		)
+	}
+	if !data.ExpiresIn.IsNull() && !data.ExpiresIn.IsUnknown() {
+		if expiresIn := data.ExpiresIn.ValueInt64(); expiresIn < 1 || expiresIn > int64(PresignMaxExpiry.Seconds()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_in"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("\"expires_in\" must be between 1 and %d, got: %d.", int64(PresignMaxExpiry.Seconds()), expiresIn),
+			)
+		}
+	}
+}
+
+func (r *cosPresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+func (r *cosPresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data cosPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := make(map[string]string)
+	resp.Diagnostics.Append(data.Credentials.ElementsAs(ctx, &creds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	accessKeyID, secretAccessKey, err := HMACKeysFromCredentials(creds)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("credentials"), "Invalid Credentials", err.Error())
+		return
+	}
+
+	method := "GET"
+	if !data.Method.IsNull() {
+		method = data.Method.ValueString()
+	}
+	expiry := time.Hour
+	if !data.ExpiresIn.IsNull() {
+		expiry = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+	// The endpoints file of the provider is honored, the session is not used to sign
+	var endpointsFile string
+	if r.session != nil {
+		if bxSession, err := r.session.BluemixSession(); err == nil && bxSession != nil {
+			endpointsFile = bxSession.Config.EndpointsFile
+		}
+	}
+
+	signTime := time.Now().UTC()
+	presignedURL, err := PresignURL(PresignURLOptions{
+		BucketCRN:       data.BucketCRN.ValueString(),
+		BucketLocation:  data.BucketLocation.ValueString(),
+		EndpointType:    data.EndpointType.ValueString(),
+		EndpointsFile:   endpointsFile,
+		Key:             data.Key.ValueString(),
+		Method:          method,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SignTime:        signTime,
+		Expiry:          expiry,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error presigning the COS object URL", err.Error())
+		return
+	}
+
+	data.URL = types.StringValue(presignedURL)
+	data.ExpiresAt = types.StringValue(signTime.Add(expiry).Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
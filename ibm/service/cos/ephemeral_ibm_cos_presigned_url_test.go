@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSPresignedURLEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCOSPresignedURLEphemeralResourceConfig("GET", 900),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.presigned_url_check", "id"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMCOSPresignedURLEphemeralResourceConfig("PATCH", 900),
+				ExpectError: regexp.MustCompile("\"method\" must be one of"),
+			},
+			{
+				Config:      testAccCheckIBMCOSPresignedURLEphemeralResourceConfig("GET", 604801),
+				ExpectError: regexp.MustCompile("\"expires_in\" must be between"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCOSPresignedURLEphemeralResourceConfig(method string, expiresIn int) string {
+	return fmt.Sprintf(`
+	ephemeral "ibm_cos_presigned_url" "url" {
+		bucket_crn      = "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:artifacts"
+		bucket_location = "us-south"
+		key             = "builds/app 1.0.tgz"
+		method          = "%s"
+		expires_in      = %d
+		credentials = {
+			access_key_id     = "access-key-id"
+			secret_access_key = "secret-access-key"
+		}
+	}
+
+	resource "terraform_data" "presigned_url_check" {
+		lifecycle {
+			precondition {
+				condition     = startswith(ephemeral.ibm_cos_presigned_url.url.url, "https://s3.us-south.cloud-object-storage.appdomain.cloud/artifacts/builds/app%%201.0.tgz?") && strcontains(ephemeral.ibm_cos_presigned_url.url.url, "X-Amz-Signature=")
+				error_message = "Expected ibm_cos_presigned_url to return a presigned URL of the object."
+			}
+		}
+	}
+	`, method, expiresIn)
+}
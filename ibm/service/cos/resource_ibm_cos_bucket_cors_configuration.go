@@ -0,0 +1,270 @@
+package cos
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketCorsConfigurationCreate,
+		Read:     resourceIBMCOSBucketCorsConfigurationRead,
+		Update:   resourceIBMCOSBucketCorsConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "Rules that define the cross-origin requests the bucket accepts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers that are allowed in a preflight request through the Access-Control-Request-Headers header.",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+							},
+							Description: "HTTP methods that the origins are allowed to execute.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins that are allowed to access the bucket.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers in the response that the applications are allowed to access.",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The time in seconds that the browser caches the response for a preflight request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRulesSet(corsRuleList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsRuleList {
+		ruleMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		corsRule := s3.CORSRule{}
+		if allowedHeaders, ok := ruleMap["allowed_headers"].([]interface{}); ok && len(allowedHeaders) > 0 {
+			corsRule.AllowedHeaders = aws.StringSlice(flex.ExpandStringList(allowedHeaders))
+		}
+		if allowedMethods, ok := ruleMap["allowed_methods"].([]interface{}); ok && len(allowedMethods) > 0 {
+			corsRule.AllowedMethods = aws.StringSlice(flex.ExpandStringList(allowedMethods))
+		}
+		if allowedOrigins, ok := ruleMap["allowed_origins"].([]interface{}); ok && len(allowedOrigins) > 0 {
+			corsRule.AllowedOrigins = aws.StringSlice(flex.ExpandStringList(allowedOrigins))
+		}
+		if exposeHeaders, ok := ruleMap["expose_headers"].([]interface{}); ok && len(exposeHeaders) > 0 {
+			corsRule.ExposeHeaders = aws.StringSlice(flex.ExpandStringList(exposeHeaders))
+		}
+		if maxAgeSeconds, ok := ruleMap["max_age_seconds"].(int); ok && maxAgeSeconds > 0 {
+			corsRule.MaxAgeSeconds = aws.Int64(int64(maxAgeSeconds))
+		}
+		rules = append(rules, &corsRule)
+	}
+	return rules
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	putBucketCorsInput := s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRulesSet(d.Get("cors_rule").([]interface{})),
+		},
+	}
+	_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to put CORS configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if d.HasChange("cors_rule") {
+		putBucketCorsInput := s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRulesSet(d.Get("cors_rule").([]interface{})),
+			},
+		}
+		_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+		if err != nil {
+			return fmt.Errorf("failed to update CORS configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseCorsId(d.Id(), "bucketCRN")
+	bucketName := parseCorsId(d.Id(), "bucketName")
+	bucketLocation := parseCorsId(d.Id(), "bucketLocation")
+	instanceCRN := parseCorsId(d.Id(), "instanceCRN")
+	endpointType := parseCorsId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketCors(getBucketCorsInput)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NoSuchCORSConfiguration" || aerr.Code() == "NoSuchBucket") {
+			log.Printf("[WARN] CORS configuration of the COS bucket %s not found, removing from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get CORS configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if output != nil {
+		d.Set("cors_rule", flex.CorsRulesGet(output.CORSRules))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseCorsId(d.Id(), "bucketName")
+	bucketLocation := parseCorsId(d.Id(), "bucketLocation")
+	instanceCRN := parseCorsId(d.Id(), "instanceCRN")
+	endpointType := parseCorsId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	deleteBucketCorsInput := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketCors(deleteBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to delete the CORS configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+func parseCorsId(id string, info string) string {
+	bucketCRN := strings.Split(id, ":meta:")[0]
+	meta := strings.Split(id, ":meta:")[1]
+	if info == "bucketName" {
+		return strings.Split(bucketCRN, ":bucket:")[1]
+	}
+	if info == "instanceCRN" {
+		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	}
+	if info == "bucketCRN" {
+		return bucketCRN
+	}
+	if info == "bucketLocation" {
+		return strings.Split(meta, ":")[0]
+	}
+	if info == "endpointType" {
+		eType := strings.Split(meta, ":")[1]
+		// This changes is only for Schematics
+		schET := os.Getenv("IBMCLOUD_ENV_SCH_COS_ENDPOINT_OVERRIDE")
+		if eType != "" && eType == "private" && schET != "" {
+			return schET
+		}
+		return eType
+	}
+	return parseBucketId(bucketCRN, info)
+}
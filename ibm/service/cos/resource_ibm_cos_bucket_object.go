@@ -544,6 +544,12 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 	return s3.New(s3Sess, s3Conf), nil
 }
 
+// GetS3Client returns a COS client for the bucket location and endpoint type, authenticated for the
+// COS instance. It lets the resources of other services stage objects in a bucket.
+func GetS3Client(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+	return getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+}
+
 // This is to prevent potential issues w/ binary files
 // and generally unprintable characters
 // See https://github.com/hashicorp/terraform/pull/3858#issuecomment-156856738
@@ -0,0 +1,615 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// cosSyncDeleteBatchSize is the maximum number of keys of a DeleteObjects request
+	cosSyncDeleteBatchSize = 1000
+	// cosSyncMaxUploadParts is the maximum number of parts of a multipart upload
+	cosSyncMaxUploadParts = s3manager.MaxUploadParts
+)
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory to mirror to the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "The prefix of the keys of the objects in the bucket",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files to sync, relative to source_dir. All files are synced by default",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files not to sync, relative to source_dir",
+			},
+			"delete_extraneous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that have no matching file in source_dir, including objects not uploaded by this resource",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of files uploaded in parallel",
+			},
+			"part_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of parts of a large file uploaded in parallel, for each file",
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "The size of the parts of a multipart upload, in MiB. Files larger than a part are uploaded with a multipart upload",
+			},
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ETags of the synced objects, by key",
+			},
+		},
+	}
+}
+
+// cosSyncFile is a local file to sync, with the ETag COS computes for it once it is uploaded.
+type cosSyncFile struct {
+	Path        string
+	Size        int64
+	ETag        string
+	ContentType string
+}
+
+// cosSyncFilter matches the paths of files, relative to the source directory, against the
+// include and exclude glob patterns.
+type cosSyncFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+func newCOSSyncFilter(include, exclude []interface{}) cosSyncFilter {
+	filter := cosSyncFilter{}
+	for _, pattern := range include {
+		filter.include = append(filter.include, cosSyncGlobRegexp(pattern.(string)))
+	}
+	for _, pattern := range exclude {
+		filter.exclude = append(filter.exclude, cosSyncGlobRegexp(pattern.(string)))
+	}
+	return filter
+}
+
+func (f cosSyncFilter) match(relPath string) bool {
+	for _, r := range f.exclude {
+		if r.MatchString(relPath) {
+			return false
+		}
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, r := range f.include {
+		if r.MatchString(relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// cosSyncGlobRegexp converts a glob pattern to a regular expression. '*' and '?' do not match
+// '/', '**' matches any number of directories, and a pattern without '/' matches the file name
+// in any directory.
+func cosSyncGlobRegexp(pattern string) *regexp.Regexp {
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !strings.Contains(pattern, "/") {
+		expr.WriteString("(.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					expr.WriteString("(.*/)?")
+				} else {
+					expr.WriteString(".*")
+				}
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.MustCompile(expr.String())
+}
+
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "prefix", "include", "exclude", "multipart_part_size"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("objects")
+		}
+	}
+
+	files, err := cosSyncLocalFiles(
+		diff.Get("source_dir").(string),
+		diff.Get("prefix").(string),
+		newCOSSyncFilter(diff.Get("include").([]interface{}), diff.Get("exclude").([]interface{})),
+		int64(diff.Get("multipart_part_size").(int))*1024*1024,
+	)
+	if err != nil {
+		return err
+	}
+
+	objects := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		objects[key] = file.ETag
+	}
+	if reflect.DeepEqual(objects, diff.Get("objects").(map[string]interface{})) {
+		return nil
+	}
+	return diff.SetNew("objects", objects)
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	prefix := d.Get("prefix").(string)
+
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, prefix, bucketLocation))
+
+	objects, err := syncCOSBucketObjects(ctx, d, m, map[string]interface{}{})
+	d.Set("objects", objects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	prefix := d.Get("prefix").(string)
+
+	s3Client, err := cosSyncS3Client(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := cosSyncRemoteObjects(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchBucket" {
+			log.Printf("[WARN] COS bucket (%s) not found, removing objects sync from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s): %s", bucketName, err))
+	}
+
+	// Objects that were not synced by this resource are only tracked when they are going to be deleted
+	managed := d.Get("objects").(map[string]interface{})
+	deleteExtraneous := d.Get("delete_extraneous").(bool)
+	filter := newCOSSyncFilter(d.Get("include").([]interface{}), d.Get("exclude").([]interface{}))
+	objects := make(map[string]interface{})
+	for key, etag := range remote {
+		if _, ok := managed[key]; ok || (deleteExtraneous && filter.match(strings.TrimPrefix(key, prefix))) {
+			objects[key] = etag
+		}
+	}
+	d.Set("objects", objects)
+
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("source_dir", "include", "exclude", "delete_extraneous", "multipart_part_size", "objects") {
+		old, _ := d.GetChange("objects")
+		objects, err := syncCOSBucketObjects(ctx, d, m, old.(map[string]interface{}))
+		d.Set("objects", objects)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+
+	s3Client, err := cosSyncS3Client(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0, len(d.Get("objects").(map[string]interface{})))
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if _, err := deleteCOSSyncObjects(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func cosSyncS3Client(d *schema.ResourceData, m interface{}) (*s3.S3, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	schET := os.Getenv("IBMCLOUD_ENV_SCH_COS_ENDPOINT_OVERRIDE")
+	if endpointType != "" && endpointType == "private" && schET != "" {
+		endpointType = schET
+	}
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	return getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+}
+
+// syncCOSBucketObjects uploads the files of the source directory whose ETag differs from the ETag of
+// the object, and deletes the objects that have no matching file. It returns the objects that are
+// in sync, starting from the given ones, also when some of the files could not be synced.
+func syncCOSBucketObjects(ctx context.Context, d *schema.ResourceData, m interface{}, current map[string]interface{}) (map[string]interface{}, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	prefix := d.Get("prefix").(string)
+	concurrency := d.Get("concurrency").(int)
+	partSize := int64(d.Get("multipart_part_size").(int)) * 1024 * 1024
+
+	objects := make(map[string]interface{}, len(current))
+	for key, etag := range current {
+		objects[key] = etag
+	}
+
+	files, err := cosSyncLocalFiles(d.Get("source_dir").(string), prefix, newCOSSyncFilter(d.Get("include").([]interface{}), d.Get("exclude").([]interface{})), partSize)
+	if err != nil {
+		return objects, err
+	}
+
+	s3Client, err := cosSyncS3Client(d, m)
+	if err != nil {
+		return objects, err
+	}
+
+	var uploads, deletions []string
+	for key, file := range files {
+		if etag, ok := objects[key]; !ok || etag.(string) != file.ETag {
+			uploads = append(uploads, key)
+		}
+	}
+	for key := range objects {
+		if _, ok := files[key]; !ok {
+			deletions = append(deletions, key)
+		}
+	}
+	sort.Strings(uploads)
+	sort.Strings(deletions)
+	log.Printf("[INFO] Syncing COS bucket (%s) prefix (%s): %d objects to upload, %d objects to delete", bucketName, prefix, len(uploads), len(deletions))
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = partSize
+		u.Concurrency = d.Get("part_concurrency").(int)
+	})
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, concurrency)
+	for _, key := range uploads {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key string, file cosSyncFile) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := uploadCOSSyncFile(ctx, uploader, bucketName, key, file)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			objects[key] = file.ETag
+		}(key, files[key])
+	}
+	wg.Wait()
+
+	deleted, err := deleteCOSSyncObjects(ctx, s3Client, bucketName, deletions)
+	for _, key := range deleted {
+		delete(objects, key)
+	}
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return objects, errors.Join(errs...)
+}
+
+func uploadCOSSyncFile(ctx context.Context, uploader *s3manager.Uploader, bucketName, key string, file cosSyncFile) error {
+	f, err := os.Open(file.Path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", file.Path, err)
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", file.Path, err)
+		}
+	}()
+
+	log.Printf("[DEBUG] Uploading COS object file (%s) to COS bucket (%s) object (%s)", file.Path, bucketName, key)
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		Body:        f,
+		ContentType: aws.String(file.ContentType),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+// deleteCOSSyncObjects deletes the objects with the given keys, and returns the keys of the
+// objects that are deleted.
+func deleteCOSSyncObjects(ctx context.Context, s3Client *s3.S3, bucketName string, keys []string) ([]string, error) {
+	var deleted []string
+	var errs []error
+	for start := 0; start < len(keys); start += cosSyncDeleteBatchSize {
+		end := start + cosSyncDeleteBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		objectIdentifiers := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objectIdentifiers = append(objectIdentifiers, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objectIdentifiers,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("[ERROR] Error deleting objects from COS bucket (%s): %s", bucketName, err))
+			continue
+		}
+
+		failed := make(map[string]bool, len(out.Errors))
+		for _, e := range out.Errors {
+			failed[aws.StringValue(e.Key)] = true
+			errs = append(errs, fmt.Errorf("[ERROR] Error deleting object (%s) from COS bucket (%s): %s", aws.StringValue(e.Key), bucketName, aws.StringValue(e.Message)))
+		}
+		for _, key := range keys[start:end] {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+	return deleted, errors.Join(errs...)
+}
+
+// cosSyncRemoteObjects returns the ETags of the objects under the prefix, by key.
+func cosSyncRemoteObjects(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+			// Folder placeholders have no matching file
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+			objects[key] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	return objects, err
+}
+
+// cosSyncLocalFiles returns the files of the source directory that match the filter, by object key.
+func cosSyncLocalFiles(sourceDir, prefix string, filter cosSyncFilter, partSize int64) (map[string]cosSyncFile, error) {
+	files := make(map[string]cosSyncFile)
+	err := filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !filter.match(rel) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		etag, contentType, err := cosSyncFileDetails(path, info.Size(), partSize)
+		if err != nil {
+			return err
+		}
+		files[prefix+rel] = cosSyncFile{
+			Path:        path,
+			Size:        info.Size(),
+			ETag:        etag,
+			ContentType: contentType,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+// cosSyncFileDetails returns the ETag COS computes for the file once it is uploaded with the
+// part size, and its content type. The ETag of a single part upload is the MD5 of the file,
+// and the ETag of a multipart upload is the MD5 of the MD5s of its parts, followed by the
+// number of parts.
+func cosSyncFileDetails(path string, size, partSize int64) (string, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	// Read the start of the file to detect its content type when the extension is not known
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", "", err
+	}
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = http.DetectContentType(head[:n])
+	}
+	// The text content types are read as UTF-8, whatever mime types table the system has
+	if isContentTypeAllowed(&contentType) && !strings.Contains(contentType, "charset") {
+		contentType += "; charset=utf-8"
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+
+	// The uploader raises the part size when the file would need more parts than allowed
+	if size/partSize >= cosSyncMaxUploadParts {
+		partSize = size/cosSyncMaxUploadParts + 1
+	}
+	if size <= partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, f); err != nil {
+			return "", "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), contentType, nil
+	}
+
+	var partHashes []byte
+	parts := 0
+	for {
+		hash := md5.New()
+		written, err := io.CopyN(hash, f, partSize)
+		if err != nil && err != io.EOF {
+			return "", "", err
+		}
+		if written == 0 {
+			break
+		}
+		partHashes = append(partHashes, hash.Sum(nil)...)
+		parts++
+		if written < partSize {
+			break
+		}
+	}
+	etag := md5.Sum(partHashes)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(etag[:]), parts), contentType, nil
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCOSSyncFileDetails(t *testing.T) {
+	partETag := func(content []byte) []byte {
+		sum := md5.Sum(content)
+		return sum[:]
+	}
+	multipartETag := func(parts ...[]byte) string {
+		var partHashes []byte
+		for _, part := range parts {
+			partHashes = append(partHashes, partETag(part)...)
+		}
+		sum := md5.Sum(partHashes)
+		return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(parts))
+	}
+	bumped := bytes.Repeat([]byte("x"), cosSyncMaxUploadParts+1)
+
+	testcases := []struct {
+		description         string
+		name                string
+		content             []byte
+		partSize            int64
+		expectedETag        string
+		expectedContentType string
+	}{
+		{
+			description:         "When the file is smaller than a part, Expect the MD5 of the file",
+			name:                "index.html",
+			content:             []byte("<html></html>"),
+			partSize:            16,
+			expectedETag:        hex.EncodeToString(partETag([]byte("<html></html>"))),
+			expectedContentType: "text/html; charset=utf-8",
+		},
+		{
+			description:         "When the file is exactly a part, Expect the MD5 of the file",
+			name:                "part.txt",
+			content:             []byte("0123456789abcdef"),
+			partSize:            16,
+			expectedETag:        hex.EncodeToString(partETag([]byte("0123456789abcdef"))),
+			expectedContentType: "text/plain; charset=utf-8",
+		},
+		{
+			description:         "When the file is larger than a part, Expect the MD5 of the MD5s of the parts and the number of parts",
+			name:                "data.bin",
+			content:             []byte("0123456789abcdef0123456789abcdef0123"),
+			partSize:            16,
+			expectedETag:        multipartETag([]byte("0123456789abcdef"), []byte("0123456789abcdef"), []byte("0123")),
+			expectedContentType: "application/octet-stream",
+		},
+		{
+			description:         "When the file needs more parts than allowed, Expect the part size to be raised like the uploader does",
+			name:                "bumped.bin",
+			content:             bumped,
+			partSize:            1,
+			expectedETag:        multipartETag(splitCOSSyncTestParts(bumped, 2)...),
+			expectedContentType: "application/octet-stream",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			require.NoError(t, os.WriteFile(path, tc.content, 0o600))
+
+			etag, contentType, err := cosSyncFileDetails(path, int64(len(tc.content)), tc.partSize)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedETag, etag)
+			require.Equal(t, tc.expectedContentType, contentType)
+		})
+	}
+}
+
+func splitCOSSyncTestParts(content []byte, partSize int) [][]byte {
+	var parts [][]byte
+	for start := 0; start < len(content); start += partSize {
+		parts = append(parts, content[start:min(start+partSize, len(content))])
+	}
+	return parts
+}
+
+func TestCOSSyncGlobRegexp(t *testing.T) {
+	testcases := []struct {
+		description string
+		pattern     string
+		matches     []string
+		mismatches  []string
+	}{
+		{
+			description: "When the pattern has no slash, Expect it to match the file name in any directory",
+			pattern:     "*.html",
+			matches:     []string{"index.html", "docs/index.html", "a/b/c.html"},
+			mismatches:  []string{"index.htm", "docs/index.html/x"},
+		},
+		{
+			description: "When the pattern has a slash, Expect it to match from the source directory",
+			pattern:     "docs/*.html",
+			matches:     []string{"docs/index.html"},
+			mismatches:  []string{"index.html", "site/docs/index.html", "docs/api/index.html"},
+		},
+		{
+			description: "When the pattern has **/, Expect it to match any number of directories",
+			pattern:     "assets/**/*.css",
+			matches:     []string{"assets/site.css", "assets/css/site.css", "assets/a/b/site.css"},
+			mismatches:  []string{"site.css", "other/assets/site.css"},
+		},
+		{
+			description: "When the pattern ends with **, Expect it to match everything below the directory",
+			pattern:     "build/**",
+			matches:     []string{"build/a", "build/a/b.js"},
+			mismatches:  []string{"build", "src/build/a"},
+		},
+		{
+			description: "When the pattern has ?, Expect it to match one character other than a slash",
+			pattern:     "file?.txt",
+			matches:     []string{"file1.txt", "dir/fileA.txt"},
+			mismatches:  []string{"file.txt", "file12.txt"},
+		},
+		{
+			description: "When the pattern has regular expression characters, Expect them to match literally",
+			pattern:     "a+b(1).txt",
+			matches:     []string{"a+b(1).txt"},
+			mismatches:  []string{"aab1.txt"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			r := cosSyncGlobRegexp(tc.pattern)
+			for _, path := range tc.matches {
+				require.True(t, r.MatchString(path), "expected %q to match %q", tc.pattern, path)
+			}
+			for _, path := range tc.mismatches {
+				require.False(t, r.MatchString(path), "expected %q not to match %q", tc.pattern, path)
+			}
+		})
+	}
+}
@@ -266,11 +266,32 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					validation.StringLenBetween(15, 72),
 					DatabaseUserPasswordValidator("database"),
 				),
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"adminpassword_wo"},
 				// DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 				//  return true
 				// },
 			},
+			"adminpassword_wo": {
+				Description: "The admin user password for the instance as a write-only argument. The value is never stored in the Terraform plan or state.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(15, 72),
+					DatabaseUserPasswordValidator("database"),
+				),
+				ConflictsWith: []string{"adminpassword"},
+				RequiredWith:  []string{"adminpassword_wo_version"},
+			},
+			"adminpassword_wo_version": {
+				Description:  "The version of the write-only admin user password. Change this value to update the admin password to the current value of adminpassword_wo.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"adminpassword_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"configuration": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -381,6 +402,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringLenBetween(4, 32),
 						},
+						// users is a set, and the SDK does not allow write-only attributes in set
+						// blocks, so the user passwords have no write-only variant
 						"password": {
 							Description:  "User password",
 							Type:         schema.TypeString,
@@ -1447,9 +1470,12 @@ func classicDatabaseInstanceCreate(context context.Context, d *schema.ResourceDa
 	instanceID := *instance.ID
 	icdId := flex.EscapeUrlParm(instanceID)
 
+	adminPassword, hasAdminPassword := flex.GetWriteOnlyString(d, "adminpassword_wo")
 	if pw, ok := d.GetOk("adminpassword"); ok {
-		adminPassword := pw.(string)
+		adminPassword, hasAdminPassword = pw.(string), true
+	}
 
+	if hasAdminPassword {
 		getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
 			ID: core.StringPtr(instanceID),
 		}
@@ -2074,9 +2100,8 @@ func classicDatabaseInstanceUpdate(context context.Context, d *schema.ResourceDa
 		}
 	}
 
-	if d.HasChange("adminpassword") {
+	if password, ok := flex.GetUpdatedSecretString(d, "adminpassword", "adminpassword_wo"); ok {
 		adminUser := d.Get("adminuser").(string)
-		password := d.Get("adminpassword").(string)
 
 		user := &clouddatabasesv5.UserUpdatePasswordSetting{
 			Password: &password,
@@ -67,6 +67,41 @@ func TestAccIBMDatabaseInstancePostgresBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresAdminPasswordWriteOnly(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	rnd := fmt.Sprintf("tf-Pgress-wo-%d", acctest.RandIntRange(10, 100))
+	testName := rnd
+	name := "ibm_database." + testName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresAdminPasswordWriteOnly(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckNoResourceAttr(name, "adminpassword"),
+					resource.TestCheckNoResourceAttr(name, "adminpassword_wo"),
+					resource.TestCheckResourceAttr(name, "adminpassword_wo_version", "1"),
+				),
+			},
+			{
+				// Removing the write-only pair must not reset the admin password
+				Config: testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckNoResourceAttr(name, "adminpassword"),
+					resource.TestCheckNoResourceAttr(name, "adminpassword_wo_version"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMDatabaseInstancePostgresGen2(t *testing.T) {
 	t.Parallel()
 	databaseResourceGroup := "default"
@@ -745,6 +780,26 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup string
 	`, databaseResourceGroup, name, acc.Region())
 }
 
+func testAccCheckIBMDatabaseInstancePostgresAdminPasswordWriteOnly(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id        = data.ibm_resource_group.test_acc.id
+		name                     = "%[2]s"
+		service                  = "databases-for-postgresql"
+		plan                     = "standard"
+		location                 = "%[3]s"
+		service_endpoints        = "public-and-private"
+		adminpassword_wo         = "secure-Password12345"
+		adminpassword_wo_version = 1
+	}
+	`, databaseResourceGroup, name, acc.Region())
+}
+
 func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_database" "%[2]s-pitr" {
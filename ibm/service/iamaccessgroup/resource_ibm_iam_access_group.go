@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,8 +17,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMAccessGroupIdentity is the identity of ibm_iam_access_group, which can be used to import it.
+var ibmIAMAccessGroupIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The ID of the access group."},
+	},
+}
+
 func ResourceIBMIAMAccessGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMIAMAccessGroupCreate,
 		ReadContext:   resourceIBMIAMAccessGroupRead,
 		UpdateContext: resourceIBMIAMAccessGroupUpdate,
@@ -54,7 +62,7 @@ func ResourceIBMIAMAccessGroup() *schema.Resource {
 				Description: "CRN of the access group",
 			},
 		},
-	}
+	}, ibmIAMAccessGroupIdentity)
 }
 
 func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -13,8 +13,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMAccessGroupDynamicRuleIdentity is the identity of ibm_iam_access_group_dynamic_rule, which can be used to import it.
+var ibmIAMAccessGroupDynamicRuleIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "access_group_id", Description: "The ID of the access group."},
+		{Name: "rule_id", Description: "The ID of the dynamic rule."},
+	},
+}
+
 func ResourceIBMIAMDynamicRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   resourceIBMIAMDynamicRuleCreate,
 		Read:     resourceIBMIAMDynamicRuleRead,
 		Update:   resourceIBMIAMDynamicRuleUpdate,
@@ -75,7 +83,7 @@ func ResourceIBMIAMDynamicRule() *schema.Resource {
 				Description: "id of the rule",
 			},
 		},
-	}
+	}, ibmIAMAccessGroupDynamicRuleIdentity)
 }
 func ResourceIBMIAMDynamicRuleValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &iamAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &iamAccessTokenEphemeralResource{}
+)
+
+func NewIAMAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &iamAccessTokenEphemeralResource{}
+}
+
+type iamAccessTokenEphemeralResource struct {
+	authenticator core.Authenticator
+	userDetails   *conns.UserConfig
+}
+
+type iamAccessTokenModel struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	AccountID   types.String `tfsdk:"account_id"`
+}
+
+func (r *iamAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_iam_access_token"
+}
+
+func (r *iamAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves an IAM access token for the credentials the provider is configured with. The token is never persisted to the plan or state, which makes it suitable for configuring other providers such as kubernetes, helm or http.",
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM access token, without the token type prefix.",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the access token. Always 'Bearer'.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the access token expires, in RFC 3339 format.",
+			},
+			"account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the account the access token was issued for.",
+			},
+		},
+	}
+}
+
+func (r *iamAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	authenticator, err := session.IAMAuthenticator()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create IAM Authenticator",
+			"An unexpected error occurred when creating the IAM authenticator. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"IAM Authenticator Error: "+err.Error(),
+		)
+		return
+	}
+
+	// User details are only used as a fallback for the account ID, so a failure here is not fatal
+	userDetails, _ := session.BluemixUserDetails()
+
+	r.authenticator = authenticator
+	r.userDetails = userDetails
+}
+
+func (r *iamAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data iamAccessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessToken, err := r.requestAccessToken()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Retrieve IAM Access Token",
+			"An error occurred when requesting an IAM access token: "+err.Error(),
+		)
+		return
+	}
+
+	token, _, err := jwt.NewParser().ParseUnverified(accessToken, jwt.MapClaims{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Parse IAM Access Token",
+			"The IAM access token could not be decoded: "+err.Error(),
+		)
+		return
+	}
+	claims := token.Claims.(jwt.MapClaims)
+
+	data.AccessToken = types.StringValue(accessToken)
+	data.TokenType = types.StringValue("Bearer")
+	data.ExpiresAt = types.StringNull()
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		data.ExpiresAt = types.StringValue(exp.UTC().Format(time.RFC3339))
+	}
+	data.AccountID = types.StringNull()
+	if account, ok := claims["account"].(map[string]interface{}); ok {
+		if bss, ok := account["bss"].(string); ok {
+			data.AccountID = types.StringValue(bss)
+		}
+	}
+	if data.AccountID.IsNull() && r.userDetails != nil && r.userDetails.UserAccount != "" {
+		data.AccountID = types.StringValue(r.userDetails.UserAccount)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// requestAccessToken returns a fresh access token for API key and trusted profile credentials,
+// and the configured token when the provider was configured with an iam_token.
+func (r *iamAccessTokenEphemeralResource) requestAccessToken() (string, error) {
+	switch authenticator := r.authenticator.(type) {
+	case *core.IamAuthenticator:
+		tokenResponse, err := authenticator.RequestToken()
+		if err != nil {
+			return "", err
+		}
+		return tokenResponse.AccessToken, nil
+	case *core.IamAssumeAuthenticator:
+		tokenResponse, err := authenticator.RequestToken()
+		if err != nil {
+			return "", err
+		}
+		return tokenResponse.AccessToken, nil
+	case nil:
+		return "", fmt.Errorf("the provider is not configured with IAM credentials")
+	default:
+		request, _ := http.NewRequest(http.MethodGet, "https://iam.cloud.ibm.com", nil)
+		if err := authenticator.Authenticate(request); err != nil {
+			return "", err
+		}
+		return strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer "), nil
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmIamAccessTokenEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamAccessTokenEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.token_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIamAccessTokenEphemeralResourceConfigBasic() string {
+	return `
+	ephemeral "ibm_iam_access_token" "token" {}
+
+	resource "terraform_data" "token_check" {
+		lifecycle {
+			precondition {
+				condition     = length(ephemeral.ibm_iam_access_token.token.access_token) > 0 && ephemeral.ibm_iam_access_token.token.account_id != ""
+				error_message = "Expected ibm_iam_access_token to return an access token and account ID."
+			}
+		}
+	}
+	`
+}
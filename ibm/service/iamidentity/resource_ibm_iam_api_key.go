@@ -16,8 +16,15 @@ import (
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
+// ibmIAMAPIKeyIdentity is the identity of ibm_iam_api_key, which can be used to import it.
+var ibmIAMAPIKeyIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The unique identifier of the API key."},
+	},
+}
+
 func ResourceIBMIAMApiKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmIamApiKeyCreate,
 		ReadContext:   resourceIbmIamApiKeyRead,
 		UpdateContext: resourceIbmIamApiKeyUpdate,
@@ -46,11 +53,27 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Description: "The account ID of the API key.",
 			},
 			"apikey": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"apikey_wo"},
+				Description:   "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
+			},
+			"apikey_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"apikey_wo_version"},
+				Description:  "The API key value to passthrough as a write-only argument. The value is sent to IAM but never stored in the Terraform plan or state. When it is set, the generated `apikey` attribute is not stored either.",
+			},
+			"apikey_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"apikey_wo"},
+				Description:  "The version of the write-only API key value. Change this value to replace the API key with a new one created from apikey_wo.",
 			},
 			"store_value": {
 				Type:        schema.TypeBool,
@@ -110,7 +133,7 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Description: "If set contains a date time string of the last modification date in ISO format.",
 			},
 		},
-	}
+	}, ibmIAMAPIKeyIdentity)
 }
 
 func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -138,6 +161,10 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("apikey"); ok {
 		createApiKeyOptions.SetApikey(d.Get("apikey").(string))
 	}
+	apikeyWo, hasApikeyWo := flex.GetWriteOnlyString(d, "apikey_wo")
+	if hasApikeyWo {
+		createApiKeyOptions.SetApikey(apikeyWo)
+	}
 	if _, ok := d.GetOk("store_value"); ok {
 		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
 	}
@@ -155,7 +182,9 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
+	if !hasApikeyWo {
+		d.Set("apikey", *apiKey.Apikey)
+	}
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
@@ -18,8 +18,15 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 )
 
+// ibmIAMServiceAPIKeyIdentity is the identity of ibm_iam_service_api_key, which can be used to import it.
+var ibmIAMServiceAPIKeyIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The unique identifier of the API key."},
+	},
+}
+
 func ResourceIBMIAMServiceAPIKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   resourceIBMIAMServiceAPIkeyCreate,
 		Read:     resourceIBMIAMServiceAPIKeyRead,
 		Update:   resourceIBMIAMServiceAPIKeyUpdate,
@@ -123,7 +130,7 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Description: "The date and time Service API Key was modified",
 			},
 		},
-	}
+	}, ibmIAMServiceAPIKeyIdentity)
 }
 func ResourceIBMIAMServiceAPIKeyValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -9,13 +9,21 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMServiceIDIdentity is the identity of ibm_iam_service_id, which can be used to import it.
+var ibmIAMServiceIDIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The unique identifier of the service ID."},
+	},
+}
+
 func ResourceIBMIAMServiceID() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMIAMServiceIDCreate,
 		ReadContext:   resourceIBMIAMServiceIDRead,
 		UpdateContext: resourceIBMIAMServiceIDUpdate,
@@ -64,7 +72,7 @@ func ResourceIBMIAMServiceID() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, ibmIAMServiceIDIdentity)
 }
 
 func resourceIBMIAMServiceIDCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -22,8 +22,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMTrustedProfileIdentity is the identity of ibm_iam_trusted_profile, which can be used to import it.
+var ibmIAMTrustedProfileIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The ID of the trusted profile."},
+	},
+}
+
 func ResourceIBMIAMTrustedProfile() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMIamTrustedProfileCreate,
 		ReadContext:   resourceIBMIamTrustedProfileRead,
 		UpdateContext: resourceIBMIamTrustedProfileUpdate,
@@ -137,7 +144,7 @@ func ResourceIBMIAMTrustedProfile() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIAMTrustedProfileIdentity)
 }
 
 func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -27,8 +27,16 @@ const (
 	iamClaimRuleOperator = "operator"
 )
 
+// ibmIAMTrustedProfileClaimRuleIdentity is the identity of ibm_iam_trusted_profile_claim_rule, which can be used to import it.
+var ibmIAMTrustedProfileClaimRuleIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "profile_id", Description: "The ID of the trusted profile."},
+		{Name: "rule_id", Description: "The ID of the claim rule."},
+	},
+}
+
 func ResourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMIamTrustedProfileClaimRuleCreate,
 		ReadContext:   resourceIBMIamTrustedProfileClaimRuleRead,
 		UpdateContext: resourceIBMIamTrustedProfileClaimRuleUpdate,
@@ -116,7 +124,7 @@ func ResourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
 				Description: "the unique identifier of the claim rule.",
 			},
 		},
-	}
+	}, ibmIAMTrustedProfileClaimRuleIdentity)
 }
 func ResourceIBMIAMTrustedProfileClaimRuleValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
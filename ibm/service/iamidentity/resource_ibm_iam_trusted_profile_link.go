@@ -22,8 +22,16 @@ import (
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
+// ibmIAMTrustedProfileLinkIdentity is the identity of ibm_iam_trusted_profile_link, which can be used to import it.
+var ibmIAMTrustedProfileLinkIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "profile_id", Description: "The ID of the trusted profile."},
+		{Name: "link_id", Description: "The ID of the link."},
+	},
+}
+
 func ResourceIBMIAMTrustedProfileLink() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMIamTrustedProfileLinkCreate,
 		ReadContext:   resourceIBMIamTrustedProfileLinkRead,
 		DeleteContext: resourceIBMIamTrustedProfileLinkDelete,
@@ -108,7 +116,7 @@ func ResourceIBMIAMTrustedProfileLink() *schema.Resource {
 				Description: "the unique identifier of the link.",
 			},
 		},
-	}
+	}, ibmIAMTrustedProfileLinkIdentity)
 }
 
 func ResourceIBMIAMTrustedProfileLinkValidator() *validate.ResourceValidator {
@@ -17,8 +17,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMAccessGroupPolicyIdentity is the identity of ibm_iam_access_group_policy, which can be used to import it.
+var ibmIAMAccessGroupPolicyIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "access_group_id", Description: "The ID of the access group."},
+		{Name: "policy_id", Description: "The ID of the policy."},
+	},
+}
+
 func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create: resourceIBMIAMAccessGroupPolicyCreate,
 		Read:   resourceIBMIAMAccessGroupPolicyRead,
 		Update: resourceIBMIAMAccessGroupPolicyUpdate,
@@ -266,7 +274,7 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Description: "Pattern rule follows for time-based condition",
 			},
 		},
-	}
+	}, ibmIAMAccessGroupPolicyIdentity)
 }
 
 func ResourceIBMIAMAccessGroupPolicyValidator() *validate.ResourceValidator {
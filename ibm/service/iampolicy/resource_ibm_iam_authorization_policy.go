@@ -18,8 +18,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMAuthorizationPolicyIdentity is the identity of ibm_iam_authorization_policy, which can be used to import it.
+var ibmIAMAuthorizationPolicyIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The ID of the authorization policy."},
+	},
+}
+
 func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   resourceIBMIAMAuthorizationPolicyCreate,
 		Read:     resourceIBMIAMAuthorizationPolicyRead,
 		Update:   resourceIBMIAMAuthorizationPolicyUpdate,
@@ -195,7 +202,7 @@ func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
 				Description: "Set transactionID for debug",
 			},
 		},
-	}
+	}, ibmIAMAuthorizationPolicyIdentity)
 }
 
 func ResourceIBMIAMAuthorizationPolicyValidator() *validate.ResourceValidator {
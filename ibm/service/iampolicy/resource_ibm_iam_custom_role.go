@@ -22,8 +22,15 @@ const (
 	iamCRServiceName = "service"
 )
 
+// ibmIAMCustomRoleIdentity is the identity of ibm_iam_custom_role, which can be used to import it.
+var ibmIAMCustomRoleIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "id", Description: "The ID of the custom role."},
+	},
+}
+
 func ResourceIBMIAMCustomRole() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create:   resourceIBMIAMCustomRoleCreate,
 		Read:     resourceIBMIAMCustomRoleRead,
 		Update:   resourceIBMIAMCustomRoleUpdate,
@@ -86,7 +93,7 @@ func ResourceIBMIAMCustomRole() *schema.Resource {
 				Description: "The URL of the IBM Cloud dashboard that can be used to explore and view details about the resource",
 			},
 		},
-	}
+	}, ibmIAMCustomRoleIdentity)
 }
 
 func ResourceIBMIAMCustomRoleValidator() *validate.ResourceValidator {
@@ -16,8 +16,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmIAMUserPolicyIdentity is the identity of ibm_iam_user_policy, which can be used to import it.
+var ibmIAMUserPolicyIdentity = flex.ResourceIdentity{
+	Attributes: []flex.IdentityAttribute{
+		{Name: "ibm_id", Description: "The IBMid or email address of the user."},
+		{Name: "policy_id", Description: "The ID of the policy."},
+	},
+}
+
 func ResourceIBMIAMUserPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		Create: resourceIBMIAMUserPolicyCreate,
 		Read:   resourceIBMIAMUserPolicyRead,
 		Update: resourceIBMIAMUserPolicyUpdate,
@@ -257,7 +265,7 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Description: "Pattern rule follows for time-based condition",
 			},
 		},
-	}
+	}, ibmIAMUserPolicyIdentity)
 }
 
 func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
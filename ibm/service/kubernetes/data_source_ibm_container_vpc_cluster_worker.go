@@ -40,11 +40,36 @@ func DataSourceIBMContainerVPCClusterWorker() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"target_kube_version": {
+				Description: "kube version that the worker is updated to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"update_pending": {
+				Description: "Whether the worker is not at its target kube version yet",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 			"state": {
 				Description: "State of the worker",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"lifecycle_state": {
+				Description: "Lifecycle state of the worker, such as deployed or deleting",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"pending_operation": {
+				Description: "Operation that is pending on the worker",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"operating_system": {
+				Description: "Operating system of the worker",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"pool_id": {
 				Description: "worker pool id",
 				Type:        schema.TypeString,
@@ -131,7 +156,12 @@ func dataSourceIBMContainerVPCClusterWorkerRead(d *schema.ResourceData, meta int
 	d.SetId(workerFields.ID)
 	d.Set("flavor", workerFields.Flavor)
 	d.Set("kube_version", workerFields.KubeVersion.Actual)
+	d.Set("target_kube_version", workerFields.KubeVersion.Target)
+	d.Set("update_pending", workerFields.KubeVersion.Actual != workerFields.KubeVersion.Target)
 	d.Set("state", workerFields.Health.State)
+	d.Set("lifecycle_state", workerFields.LifeCycle.ActualState)
+	d.Set("pending_operation", workerFields.LifeCycle.PendingOperation)
+	d.Set("operating_system", workerFields.LifeCycle.ActualOperatingSystem)
 	d.Set("pool_id", workerFields.PoolID)
 	d.Set("pool_name", workerFields.PoolName)
 	d.Set("host_pool_id", workerFields.HostPoolID)
@@ -23,6 +23,9 @@ func TestAccIBMContainerVPCClusterWorkerDataSource_basic(t *testing.T) {
 				Config: testAccCheckIBMContainerVPCClusterWorkerDataSourceConfig(name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_container_vpc_cluster_worker.testacc_ds_worker", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_vpc_cluster_worker.testacc_ds_worker", "target_kube_version"),
+					resource.TestCheckResourceAttr("data.ibm_container_vpc_cluster_worker.testacc_ds_worker", "update_pending", "false"),
+					resource.TestCheckResourceAttr("data.ibm_container_vpc_cluster_worker.testacc_ds_worker", "lifecycle_state", "deployed"),
 				),
 			},
 		},
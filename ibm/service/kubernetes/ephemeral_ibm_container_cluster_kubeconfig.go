@@ -0,0 +1,523 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	gohttp "net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &clusterKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &clusterKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew          = &clusterKubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &clusterKubeconfigEphemeralResource{}
+)
+
+const (
+	clusterKubeconfigPrivateKey = "cluster"
+	// clusterKubeconfigRenewMargin is how long before the token expires Terraform is asked to renew it
+	clusterKubeconfigRenewMargin = 5 * time.Minute
+)
+
+func NewClusterKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &clusterKubeconfigEphemeralResource{}
+}
+
+type clusterKubeconfigEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type clusterKubeconfigModel struct {
+	ClusterNameID        types.String `tfsdk:"cluster_name_id"`
+	ResourceGroupID      types.String `tfsdk:"resource_group_id"`
+	Admin                types.Bool   `tfsdk:"admin"`
+	EndpointType         types.String `tfsdk:"endpoint_type"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+}
+
+// clusterKubeconfigRequest is kept in the private data of the ephemeral resource, so that Renew
+// can request new credentials for the same cluster.
+type clusterKubeconfigRequest struct {
+	Cluster         string `json:"cluster"`
+	ResourceGroupID string `json:"resource_group_id,omitempty"`
+	Admin           bool   `json:"admin,omitempty"`
+	EndpointType    string `json:"endpoint_type,omitempty"`
+}
+
+// clusterCredentials holds the connection details of a cluster. Either Token, or ClientCertificate
+// and ClientKey are set.
+type clusterCredentials struct {
+	Host                 string
+	ClusterCACertificate string
+	Token                string
+	ClientCertificate    string
+	ClientKey            string
+	ExpiresAt            time.Time
+}
+
+func (r *clusterKubeconfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_container_cluster_kubeconfig"
+}
+
+func (r *clusterKubeconfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the connection details and the kubeconfig of a Kubernetes or Red Hat OpenShift cluster. Nothing is written to disk, and the credentials are never persisted to the plan or state, which makes it suitable for configuring the kubernetes and helm providers.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name or ID of the cluster.",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the resource group of the cluster.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, the admin client certificate and key of the cluster are returned instead of a token.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the service endpoint of the cluster to connect to. Supported values are 'private', 'vpe' and 'link'. By default, the public service endpoint is used if it is enabled.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the API server of the cluster.",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded certificate of the certificate authority of the cluster. Empty if the API server uses a publicly trusted certificate.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "A short-lived token for the IAM identity the provider is configured with. Empty if admin is set to true.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client certificate. Only set if admin is set to true.",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client key. Only set if admin is set to true.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the token expires, in RFC 3339 format. Empty if it is not known.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubeconfig of the cluster, in YAML format, with the credentials embedded.",
+			},
+		},
+	}
+}
+
+func (r *clusterKubeconfigEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var endpointType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_type"), &endpointType)...)
+	if resp.Diagnostics.HasError() || endpointType.IsNull() || endpointType.IsUnknown() {
+		return
+	}
+
+	switch endpointType.ValueString() {
+	case "private", "vpe", "link":
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint_type"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("\"endpoint_type\" must be one of \"private\", \"vpe\" or \"link\", got: %q.", endpointType.ValueString()),
+		)
+	}
+}
+
+func (r *clusterKubeconfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+func (r *clusterKubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data clusterKubeconfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := clusterKubeconfigRequest{
+		Cluster:         data.ClusterNameID.ValueString(),
+		ResourceGroupID: data.ResourceGroupID.ValueString(),
+		Admin:           data.Admin.ValueBool(),
+		EndpointType:    data.EndpointType.ValueString(),
+	}
+	creds, err := r.clusterCredentials(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Retrieve Cluster Kubeconfig",
+			fmt.Sprintf("An error occurred when retrieving the kubeconfig of cluster %s: %s", request.Cluster, err),
+		)
+		return
+	}
+
+	kubeconfig, err := renderClusterKubeconfig(request.Cluster, creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Render Cluster Kubeconfig",
+			fmt.Sprintf("The kubeconfig of cluster %s could not be rendered: %s", request.Cluster, err),
+		)
+		return
+	}
+
+	data.Host = types.StringValue(creds.Host)
+	data.ClusterCACertificate = types.StringValue(creds.ClusterCACertificate)
+	data.Token = types.StringValue(creds.Token)
+	data.ClientCertificate = types.StringValue(creds.ClientCertificate)
+	data.ClientKey = types.StringValue(creds.ClientKey)
+	data.ExpiresAt = types.StringNull()
+	if !creds.ExpiresAt.IsZero() {
+		data.ExpiresAt = types.StringValue(creds.ExpiresAt.UTC().Format(time.RFC3339))
+		resp.RenewAt = creds.ExpiresAt.Add(-clusterKubeconfigRenewMargin)
+	}
+	data.Kubeconfig = types.StringValue(string(kubeconfig))
+
+	privateData, err := json.Marshal(request)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Save Private Data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, clusterKubeconfigPrivateKey, privateData)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Renew requests new credentials for the cluster before the token expires, which checks that the IAM
+// session can still access the cluster, and asks Terraform to renew again before the new token
+// expires. Terraform does not pass new values to the providers that were configured with the
+// ephemeral resource, so those providers keep the token of Open until it expires. An expired IAM
+// session or a revoked access to the cluster is reported here, and the next plan or apply opens the
+// ephemeral resource again with a new token.
+func (r *clusterKubeconfigEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	privateData, diags := req.Private.GetKey(ctx, clusterKubeconfigPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var request clusterKubeconfigRequest
+	if err := json.Unmarshal(privateData, &request); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Private Data", err.Error())
+		return
+	}
+
+	creds, err := r.clusterCredentials(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Renew Cluster Kubeconfig",
+			fmt.Sprintf("An error occurred when retrieving new credentials for cluster %s: %s", request.Cluster, err),
+		)
+		return
+	}
+	if !creds.ExpiresAt.IsZero() {
+		resp.RenewAt = creds.ExpiresAt.Add(-clusterKubeconfigRenewMargin)
+	}
+}
+
+// clusterCredentials downloads the kubeconfig of the cluster into memory and returns its
+// connection details. Unlike ibm_container_cluster_config, nothing is written to disk, so no lock
+// on the config directory of the cluster is needed.
+func (r *clusterKubeconfigEphemeralResource) clusterCredentials(ctx context.Context, request clusterKubeconfigRequest) (clusterCredentials, error) {
+	var creds clusterCredentials
+
+	csClient, err := r.session.VpcContainerAPI()
+	if err != nil {
+		return creds, err
+	}
+	bmxSess, err := r.session.BluemixSession()
+	if err != nil {
+		return creds, err
+	}
+	restClient, err := newContainerRESTClient(bmxSess)
+	if err != nil {
+		return creds, err
+	}
+
+	targetEnv := v2.ClusterTargetHeader{
+		ResourceGroup: request.ResourceGroupID,
+	}
+	cluster, err := csClient.Clusters().GetCluster(request.Cluster, targetEnv)
+	if err != nil {
+		return creds, fmt.Errorf("error getting cluster: %s", err)
+	}
+
+	// Satellite clusters are only reachable through the link endpoint, with the admin certificates
+	admin := request.Admin || cluster.Provider == "satellite"
+	postBody := map[string]interface{}{
+		"cluster": cluster.ID,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if cluster.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+	} else if request.EndpointType != "" {
+		postBody["endpointType"] = request.EndpointType
+	}
+
+	var archive bytes.Buffer
+	if _, err := restClient.Post("/v2/applyRBACAndGetKubeconfig", postBody, &archive, targetEnv.ToMap()); err != nil {
+		return creds, fmt.Errorf("error downloading the kubeconfig: %s", err)
+	}
+	if err := waitForClusterRBACSync(ctx, restClient, cluster.ID, targetEnv); err != nil {
+		return creds, err
+	}
+
+	files, err := unzipClusterConfig(archive.Bytes())
+	if err != nil {
+		return creds, err
+	}
+
+	var kubeconfig []byte
+	for name, content := range files {
+		switch {
+		case name == "admin-key.pem":
+			creds.ClientKey = string(content)
+		case name == "admin.pem":
+			creds.ClientCertificate = string(content)
+		case strings.HasPrefix(name, "ca") && strings.HasSuffix(name, ".pem"):
+			creds.ClusterCACertificate = string(content)
+		case strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml"):
+			kubeconfig = content
+		}
+	}
+	if kubeconfig == nil {
+		return creds, fmt.Errorf("unable to locate the kubeconfig in the downloaded archive")
+	}
+
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return creds, fmt.Errorf("error reading the kubeconfig: %s", err)
+	}
+	if kubeContext, ok := config.Contexts[config.CurrentContext]; ok {
+		if c, ok := config.Clusters[kubeContext.Cluster]; ok {
+			creds.Host = c.Server
+		}
+		if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok && !admin {
+			if authInfo.AuthProvider != nil {
+				creds.Token = authInfo.AuthProvider.Config["id-token"]
+			} else {
+				creds.Token = authInfo.Token
+			}
+		}
+	}
+
+	if cluster.Type == "openshift" && !admin {
+		// OpenShift clusters do not accept IAM tokens, the IAM identity must log in to the
+		// OAuth server of the cluster to get an OpenShift token.
+		ocClient, ok := csClient.Clusters().(interface {
+			FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool, endpointType string) ([]byte, string, error)
+		})
+		if !ok {
+			return creds, fmt.Errorf("logging in to OpenShift clusters is not supported by the container service client")
+		}
+		ocKubeconfig, host, err := ocClient.FetchOCTokenForKubeConfig(kubeconfig, cluster, cluster.IsStagingSatelliteCluster(), request.EndpointType)
+		if err != nil {
+			return creds, fmt.Errorf("error logging in to the OpenShift cluster: %s", err)
+		}
+		ocConfig, err := clientcmd.Load(ocKubeconfig)
+		if err != nil {
+			return creds, fmt.Errorf("error reading the OpenShift kubeconfig: %s", err)
+		}
+		if kubeContext, ok := ocConfig.Contexts[ocConfig.CurrentContext]; ok {
+			if authInfo, ok := ocConfig.AuthInfos[kubeContext.AuthInfo]; ok {
+				creds.Token = authInfo.Token
+			}
+		}
+		creds.Host = host
+		// The OpenShift API server uses a publicly trusted certificate
+		creds.ClusterCACertificate = ""
+	}
+
+	if creds.Host == "" {
+		return creds, fmt.Errorf("unable to find the API server of the cluster in the kubeconfig")
+	}
+	if !admin && creds.Token == "" {
+		return creds, fmt.Errorf("unable to find a token for the cluster in the kubeconfig")
+	}
+	if admin && (creds.ClientCertificate == "" || creds.ClientKey == "") {
+		return creds, fmt.Errorf("unable to find the admin certificate and key of the cluster in the downloaded archive")
+	}
+
+	// Only IAM tokens are JWTs, the expiry of OpenShift tokens is not known
+	if token, _, err := jwt.NewParser().ParseUnverified(creds.Token, jwt.MapClaims{}); err == nil {
+		if exp, err := token.Claims.GetExpirationTime(); err == nil && exp != nil {
+			creds.ExpiresAt = exp.Time
+		}
+	}
+
+	return creds, nil
+}
+
+// newContainerRESTClient returns a client for the container service API that is set up like the one
+// of containerv2.New, for the requests the containerv2 package only offers with files on disk.
+func newContainerRESTClient(sess *bxsession.Session) (*client.Client, error) {
+	config := sess.Config.Copy()
+	if err := config.ValidateConfigForService(bluemix.VpcContainerService); err != nil {
+		return nil, err
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{config.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config.IAMAccessToken == "" {
+		if err := authentication.PopulateTokens(tokenRefresher, config); err != nil {
+			return nil, err
+		}
+	}
+	if config.Endpoint == nil {
+		ep, err := config.EndpointLocator.ContainerEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = &ep
+	}
+	return client.New(config, bluemix.VpcContainerService, tokenRefresher), nil
+}
+
+// waitForClusterRBACSync waits until the RBAC of the IAM identity is synchronized to the cluster,
+// so that the returned token is authorized when it is first used.
+func waitForClusterRBACSync(ctx context.Context, restClient *client.Client, cluster string, target v2.ClusterTargetHeader) error {
+	query := url.Values{}
+	query.Set("cluster", cluster)
+	rbacStatusPath := "/v2/getRBACStatus?" + query.Encode()
+
+	backoff := time.Second
+	for {
+		rbacStatus := struct {
+			Synchronized bool `json:"synchronized"`
+			Error        bool `json:"error"`
+		}{}
+		if _, err := restClient.Get(rbacStatusPath, &rbacStatus, target.ToMap()); err != nil {
+			return fmt.Errorf("error getting the RBAC status of the cluster: %s", err)
+		}
+		// An error is not fatal, the IAM identity can still be authorized through other roles
+		if rbacStatus.Synchronized || rbacStatus.Error || backoff > 32*time.Second {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for the RBAC of the cluster to be synchronized: %s", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// unzipClusterConfig returns the content of the files in the kubeconfig archive, by file name.
+func unzipClusterConfig(archive []byte) (map[string][]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading the kubeconfig archive: %s", err)
+	}
+	files := make(map[string][]byte, len(reader.File))
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s from the kubeconfig archive: %s", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s from the kubeconfig archive: %s", f.Name, err)
+		}
+		files[filepath.Base(f.Name)] = content
+	}
+	return files, nil
+}
+
+// renderClusterKubeconfig returns a kubeconfig with a single context, that embeds the credentials.
+func renderClusterKubeconfig(name string, creds clusterCredentials) ([]byte, error) {
+	config := clientcmdapi.NewConfig()
+
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = creds.Host
+	if creds.ClusterCACertificate != "" {
+		cluster.CertificateAuthorityData = []byte(creds.ClusterCACertificate)
+	}
+	config.Clusters[name] = cluster
+
+	authInfo := clientcmdapi.NewAuthInfo()
+	if creds.Token != "" {
+		authInfo.Token = creds.Token
+	} else {
+		authInfo.ClientCertificateData = []byte(creds.ClientCertificate)
+		authInfo.ClientKeyData = []byte(creds.ClientKey)
+	}
+	config.AuthInfos[name] = authInfo
+
+	kubeContext := clientcmdapi.NewContext()
+	kubeContext.Cluster = name
+	kubeContext.AuthInfo = name
+	kubeContext.Namespace = "default"
+	config.Contexts[name] = kubeContext
+	config.CurrentContext = name
+
+	return clientcmd.Write(*config)
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterKubeconfigEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterKubeconfigEphemeralResourceConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.kubeconfig_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerClusterKubeconfigEphemeralResourceAdmin(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterKubeconfigEphemeralResourceConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.kubeconfig_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterKubeconfigEphemeralResourceConfig(admin bool) string {
+	credentialsCondition := "length(ephemeral.ibm_container_cluster_kubeconfig.kubeconfig.token) > 0"
+	if admin {
+		credentialsCondition = "length(ephemeral.ibm_container_cluster_kubeconfig.kubeconfig.client_certificate) > 0 && length(ephemeral.ibm_container_cluster_kubeconfig.kubeconfig.client_key) > 0"
+	}
+	return fmt.Sprintf(`
+	ephemeral "ibm_container_cluster_kubeconfig" "kubeconfig" {
+		cluster_name_id = "%[1]s"
+		admin           = %[2]t
+	}
+
+	resource "terraform_data" "kubeconfig_check" {
+		lifecycle {
+			precondition {
+				condition     = startswith(ephemeral.ibm_container_cluster_kubeconfig.kubeconfig.host, "https://") && length(ephemeral.ibm_container_cluster_kubeconfig.kubeconfig.kubeconfig) > 0 && %[3]s
+				error_message = "Expected ibm_container_cluster_kubeconfig to return the host, the credentials and the kubeconfig of the cluster."
+			}
+		}
+	}
+	`, acc.ClusterName, admin, credentialsCondition)
+}
@@ -182,6 +182,14 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
 			},
 
+			"update_worker_pools": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Names or IDs of the worker pools whose workers are updated by update_all_workers, patch_version and retry_patch_version. All the worker pools are updated if not set",
+			},
+
 			"wait_for_worker_update": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -857,8 +865,12 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			workersCount := len(workers)
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
+			updateWorkerPools := d.Get("update_worker_pools").(*schema.Set)
 
 			for _, worker := range workers {
+				if updateWorkerPools.Len() > 0 && !updateWorkerPools.Contains(worker.PoolName) && !updateWorkerPools.Contains(worker.PoolID) {
+					continue
+				}
 				workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, worker.PoolID, targetEnv)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
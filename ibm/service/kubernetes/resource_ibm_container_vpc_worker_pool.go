@@ -4,6 +4,8 @@
 package kubernetes
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -18,6 +21,13 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 const (
@@ -35,8 +45,10 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
+		CustomizeDiff: resourceIBMContainerVpcWorkerPoolUpdateStrategyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -233,6 +245,68 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Set:              flex.ResourceIBMVPCHash,
 				DiffSuppressFunc: flex.ApplyOnce,
 			},
+
+			"patch_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes patch version of the workers. Changing it replaces the workers that are not at their target version or at the operating system of the worker pool, following update_strategy. The target version of the workers must be the patch version",
+			},
+
+			"retry_patch_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Argument which helps to retry the update of the workers. Increment the value to retry the update if the previous apply fails",
+			},
+
+			"update_strategy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "How the workers of the worker pool are replaced when they are updated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of workers that are replaced at the same time",
+						},
+						"max_surge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of workers per zone that are added to the worker pool during the update. max_surge workers per zone are replaced at the same time as max_unavailable workers",
+						},
+						"drain_timeout": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Cordon the workers and evict their pods before they are replaced, and wait up to the timeout for the pods to be evicted",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(string)
+								var err error
+								_, err = time.ParseDuration(value)
+								if err != nil {
+									errors = append(errors, fmt.Errorf("[ERROR] Error parsing drain_timeout: %s", err))
+								}
+								return
+							},
+						},
+						"kube_config_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of downloaded cluster config, used to drain the workers",
+						},
+						"pause_on_failure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Stop replacing workers when a worker fails to update. If false, the other workers are still replaced and the failures are reported at the end",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -543,6 +617,27 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return err
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d)
+		if err != nil {
+			return err
+		}
+		strategy, err := expandVpcWorkerPoolUpdateStrategy(d.Get("update_strategy").([]interface{}))
+		if err != nil {
+			d.Set("patch_version", nil)
+			return err
+		}
+		err = updateVpcWorkerPoolWorkers(meta, parts[0], parts[1], d.Get("patch_version").(string), strategy, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		if err != nil {
+			d.Set("patch_version", nil)
+			return fmt.Errorf("[ERROR] Error updating the workers of worker pool (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -856,3 +951,312 @@ func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPo
 		return workerFields, workerDeleteState, nil
 	}
 }
+
+// vpcWorkerPoolUpdateStrategy is the update_strategy of a VPC worker pool.
+type vpcWorkerPoolUpdateStrategy struct {
+	maxUnavailable int
+	maxSurge       int
+	drainTimeout   time.Duration
+	kubeConfigPath string
+	pauseOnFailure bool
+}
+
+func expandVpcWorkerPoolUpdateStrategy(updateStrategy []interface{}) (vpcWorkerPoolUpdateStrategy, error) {
+	// Without update_strategy, the workers are replaced one at a time
+	strategy := vpcWorkerPoolUpdateStrategy{
+		maxUnavailable: 1,
+		pauseOnFailure: true,
+	}
+	if len(updateStrategy) == 0 || updateStrategy[0] == nil {
+		return strategy, nil
+	}
+
+	s := updateStrategy[0].(map[string]interface{})
+	strategy.maxUnavailable = s["max_unavailable"].(int)
+	strategy.maxSurge = s["max_surge"].(int)
+	strategy.kubeConfigPath = s["kube_config_path"].(string)
+	strategy.pauseOnFailure = s["pause_on_failure"].(bool)
+	if v := s["drain_timeout"].(string); v != "" {
+		if strategy.kubeConfigPath == "" {
+			return strategy, fmt.Errorf("[ERROR] kube_config_path argument of update_strategy must be specified if drain_timeout is set")
+		}
+		drainTimeout, err := time.ParseDuration(v)
+		if err != nil {
+			return strategy, fmt.Errorf("[ERROR] Error parsing drain_timeout: %s", err)
+		}
+		strategy.drainTimeout = drainTimeout
+	}
+	return strategy, nil
+}
+
+// resourceIBMContainerVpcWorkerPoolUpdateStrategyCustomizeDiff rejects an update_strategy that replaces no
+// worker at a time.
+func resourceIBMContainerVpcWorkerPoolUpdateStrategyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("update_strategy.0.max_unavailable") || !diff.NewValueKnown("update_strategy.0.max_surge") {
+		return nil
+	}
+	updateStrategy := diff.Get("update_strategy").([]interface{})
+	if len(updateStrategy) == 0 || updateStrategy[0] == nil {
+		return nil
+	}
+	s := updateStrategy[0].(map[string]interface{})
+	if s["max_unavailable"].(int) == 0 && s["max_surge"].(int) == 0 {
+		return fmt.Errorf("[ERROR] max_unavailable and max_surge of update_strategy cannot both be 0")
+	}
+	return nil
+}
+
+// vpcWorkerKubeVersionMatches is whether the kube version of a worker, such as 1.30.4_1530_openshift, is at
+// patchVersion, such as 1.30.4_1530.
+func vpcWorkerKubeVersionMatches(kubeVersion, patchVersion string) bool {
+	return kubeVersion == patchVersion || strings.HasPrefix(kubeVersion, patchVersion+"_")
+}
+
+// updateVpcWorkerPoolWorkers replaces the workers of the worker pool that are not at their target kube version,
+// or not at the operating system of the worker pool. The workers are replaced at their target kube version, that
+// must be patchVersion when it is set. The worker pool is resized up by max_surge workers per zone for the update,
+// and the workers are replaced in batches of max_unavailable workers plus max_surge workers per zone.
+func updateVpcWorkerPoolWorkers(meta interface{}, clusterNameOrID, workerPoolID, patchVersion string, strategy vpcWorkerPoolUpdateStrategy, timeout time.Duration, target v2.ClusterTargetHeader) (err error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterNameOrID, workerPoolID, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+	}
+	workers, err := csClient.Workers().ListByWorkerPool(clusterNameOrID, workerPoolID, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	var outdated []v2.Worker
+	for _, worker := range workers {
+		if patchVersion != "" && !vpcWorkerKubeVersionMatches(worker.KubeVersion.Target, patchVersion) {
+			return fmt.Errorf("[ERROR] The target version %s of worker %s is not the patch_version %s", worker.KubeVersion.Target, worker.ID, patchVersion)
+		}
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != workerPool.OperatingSystem {
+			outdated = append(outdated, worker)
+		}
+	}
+	if len(outdated) == 0 {
+		log.Printf("[INFO] Workers of worker pool %s are up to date", workerPoolID)
+		return nil
+	}
+
+	var clientset *kubernetes.Clientset
+	if strategy.drainTimeout > 0 {
+		config, err := clientcmd.BuildConfigFromFlags("", strategy.kubeConfigPath)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Invalid kubeconfig, failed to set context: %s", err)
+		}
+		clientset, err = kubernetes.NewForConfig(config)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Invalid kubeconfig, failed to create clientset: %s", err)
+		}
+	}
+
+	workersCount := len(workers)
+	if strategy.maxSurge > 0 {
+		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
+		if err != nil {
+			return err
+		}
+		Env := v1.ClusterTargetHeader{ResourceGroup: target.ResourceGroup}
+		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPool.PoolName, workerPool.WorkerCount+strategy.maxSurge, Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding the surge workers to the worker pool: %s", err)
+		}
+		defer func() {
+			resizeErr := ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPool.PoolName, workerPool.WorkerCount, Env)
+			if resizeErr != nil {
+				err = errors.Join(err, fmt.Errorf("[ERROR] Error removing the surge workers from the worker pool: %s", resizeErr))
+			}
+		}()
+		workersCount += strategy.maxSurge * len(workerPool.Zones)
+		_, err = waitForVpcWorkerPoolWorkersNormal(csClient.Workers(), clusterNameOrID, workerPoolID, workersCount, timeout, target)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the surge workers of the worker pool: %s", err)
+		}
+	}
+
+	batchSize := strategy.maxUnavailable + strategy.maxSurge*len(workerPool.Zones)
+	var errs []error
+	for start := 0; start < len(outdated); start += batchSize {
+		batch := outdated[start:min(start+batchSize, len(outdated))]
+		replaceErr := replaceVpcWorkers(csClient.Workers(), clientset, clusterNameOrID, workerPoolID, batch, workersCount, strategy.drainTimeout, timeout, target)
+		if replaceErr != nil {
+			if strategy.pauseOnFailure {
+				errs = append(errs, fmt.Errorf("[ERROR] Paused the update after %d of %d workers: %s", start, len(outdated), replaceErr))
+				return errors.Join(errs...)
+			}
+			errs = append(errs, replaceErr)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// replaceVpcWorkers drains and replaces the workers, and waits for the worker pool to be back to workersCount
+// normal workers.
+func replaceVpcWorkers(client v2.Workers, clientset *kubernetes.Clientset, clusterNameOrID, workerPoolID string, workers []v2.Worker, workersCount int, drainTimeout, timeout time.Duration, target v2.ClusterTargetHeader) error {
+	for _, worker := range workers {
+		if clientset != nil {
+			if err := drainVpcWorker(clientset, worker, drainTimeout); err != nil {
+				return fmt.Errorf("[ERROR] Error draining worker node %s: %s", worker.ID, err)
+			}
+		}
+		_, err := client.ReplaceWokerNode(clusterNameOrID, worker.ID, target)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			return fmt.Errorf("[ERROR] Error replacing the worker node %s: %s", worker.ID, err)
+		}
+	}
+	for _, worker := range workers {
+		if _, err := waitForVpcWorkerDeleted(client, clusterNameOrID, worker.ID, timeout, target); err != nil {
+			return fmt.Errorf("[ERROR] Worker node - %s is failed to replace: %s", worker.ID, err)
+		}
+	}
+	if _, err := waitForVpcWorkerPoolWorkersNormal(client, clusterNameOrID, workerPoolID, workersCount, timeout, target); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the new worker nodes: %s", err)
+	}
+	return nil
+}
+
+func waitForVpcWorkerDeleted(client v2.Workers, clusterNameOrID, workerID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerDeletePending},
+		Target:  []string{workerDeleteState},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := client.Get(clusterNameOrID, workerID, target)
+			if err != nil {
+				return worker, workerDeletePending, nil
+			}
+			if worker.LifeCycle.ActualState == "deleted" {
+				return worker, workerDeleteState, nil
+			}
+			return worker, workerDeletePending, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+// waitForVpcWorkerPoolWorkersNormal waits for the worker pool to have workersCount deployed workers, that are
+// all normal.
+func waitForVpcWorkerPoolWorkersNormal(client v2.Workers, clusterNameOrID, workerPoolID string, workersCount int, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := client.ListByWorkerPool(clusterNameOrID, workerPoolID, false, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+			if len(workers) < workersCount {
+				return workers, versionUpdating, nil
+			}
+			for _, worker := range workers {
+				if strings.HasSuffix(worker.LifeCycle.ActualState, "_failed") {
+					return workers, worker.LifeCycle.ActualState, fmt.Errorf("[ERROR] Worker node %s is %s: %s", worker.ID, worker.LifeCycle.ActualState, worker.LifeCycle.Message)
+				}
+				if worker.LifeCycle.ActualState != workerDesired || worker.Health.State != workerNormal {
+					log.Printf("worker: %s state: %s health: %s", worker.ID, worker.LifeCycle.ActualState, worker.Health.State)
+					return workers, versionUpdating, nil
+				}
+			}
+			return workers, workerNormal, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	return stateConf.WaitForState()
+}
+
+// drainVpcWorker cordons the node of the worker and evicts its pods, except the pods of daemon sets, and waits
+// up to timeout for the pods to be evicted. The node is uncordoned if the pods are not evicted.
+func drainVpcWorker(clientset *kubernetes.Clientset, worker v2.Worker, timeout time.Duration) error {
+	// The nodes of VPC workers are named by their IP
+	var node string
+	for _, network := range worker.NetworkInterfaces {
+		if network.Primary || node == "" {
+			node = network.IpAddress
+		}
+	}
+	if err := setNodeUnschedulable(clientset, node, true); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"draining"},
+		Target:  []string{"drained"},
+		Refresh: func() (interface{}, string, error) {
+			pods, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{FieldSelector: "spec.nodeName=" + node})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting Pods from worker node %s - %s", node, err)
+			}
+			remaining := 0
+			for _, pod := range pods.Items {
+				if isDaemonSetPod(pod) || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+					continue
+				}
+				remaining++
+				if pod.DeletionTimestamp != nil {
+					continue
+				}
+				err := clientset.PolicyV1().Evictions(pod.Namespace).Evict(context.TODO(), &policyv1.Eviction{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pod.Name,
+						Namespace: pod.Namespace,
+					},
+				})
+				// A pod disruption budget rejects the eviction until the other pods are ready
+				if err != nil && !apierrors.IsTooManyRequests(err) && !apierrors.IsNotFound(err) {
+					return nil, "", fmt.Errorf("[ERROR] Error evicting Pod %s/%s: %s", pod.Namespace, pod.Name, err)
+				}
+			}
+			if remaining > 0 {
+				log.Printf("Waiting for %d Pods to be evicted from node %s", remaining, node)
+				return pods, "draining", nil
+			}
+			return pods, "drained", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		if uncordonErr := setNodeUnschedulable(clientset, node, false); uncordonErr != nil {
+			log.Printf("[WARN] %s", uncordonErr)
+		}
+		return err
+	}
+	log.Printf("Node %s has been drained", node)
+	return nil
+}
+
+func setNodeUnschedulable(clientset *kubernetes.Clientset, node string, unschedulable bool) error {
+	n, err := clientset.CoreV1().Nodes().Get(context.TODO(), node, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Node %s not found: %s", node, err)
+	}
+	n.Spec.Unschedulable = unschedulable
+	_, err = clientset.CoreV1().Nodes().Update(context.TODO(), n, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Unable to update the node %s: %s", node, err)
+	}
+	return nil
+}
+
+func isDaemonSetPod(pod corev1.Pod) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
@@ -238,6 +238,80 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(cluster_name string) string {
 		`, acc.IksClusterVpcID, acc.IksClusterSubnetID, cluster_name, workerpool_name)
 }
 
+// TestAccIBMContainerVpcClusterWorkerPoolResourceUpdateStrategy ...
+func TestAccIBMContainerVpcClusterWorkerPoolResourceUpdateStrategy(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-wp-strategy-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name, "UBUNTU_20_64", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "operating_system", "UBUNTU_20_64"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.max_surge", "1"),
+				),
+			},
+			{
+				// The workers are replaced with the new operating system, and the surge workers are removed
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name, "UBUNTU_24_64", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "operating_system", "UBUNTU_24_64"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "patch_version", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(cluster_name, operatingSystem, patchVersion string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[3]s"
+	  vpc_id            = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  wait_till         = "MasterNodeReady"
+	  zones {
+		subnet_id = "%[2]s"
+		name      = "us-south-1"
+	  }
+	}
+
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[3]s-wp"
+	  flavor            = "cx2.2x4"
+	  vpc_id            = "%[1]s"
+	  worker_count      = 2
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  operating_system  = "%[4]s"
+	  patch_version     = "%[5]s"
+	  zones {
+		name      = "us-south-1"
+		subnet_id = "%[2]s"
+	  }
+	  update_strategy {
+		max_unavailable = 0
+		max_surge       = 1
+	  }
+	}
+		`, acc.IksClusterVpcID, acc.IksClusterSubnetID, cluster_name, operatingSystem, patchVersion)
+}
+
 // TestAccIBMContainerVpcClusterWorkerPoolResourceSecurityGroups ...
 func TestAccIBMContainerVpcClusterWorkerPoolResourceSecurityGroups(t *testing.T) {
 
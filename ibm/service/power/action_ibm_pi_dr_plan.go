@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// drPlanDefaultWaitTimeout is the default wait for the replication state of the volume groups.
+const drPlanDefaultWaitTimeout = 30 * time.Minute
+
+var (
+	_ action.Action                   = &drPlanAction{}
+	_ action.ActionWithConfigure      = &drPlanAction{}
+	_ action.ActionWithValidateConfig = &drPlanAction{}
+)
+
+// drPlanAction runs a volume group action on the volume groups of a disaster recovery plan, and
+// optionally waits for their replication to reach the target states.
+type drPlanAction struct {
+	typeName                 string
+	description              string
+	cloudInstanceDescription string
+	volumeGroupsDescription  string
+	// auxiliary is whether the volume groups must be auxiliary volume groups, that replicate from
+	// another workspace.
+	auxiliary bool
+	// sources are the allowed values of pi_source. The action has no pi_source when it is empty.
+	sources []string
+	// steps are the volume group actions that run before request, for pi_source. The action always
+	// waits for the replication of every volume group to reach the target states of a step before the
+	// next step runs.
+	steps func(source string) []drPlanStep
+	// targetStates are the replication states of the volume groups once the action completes.
+	targetStates []string
+	request      func(source string) *models.VolumeGroupAction
+	session      conns.ClientSession
+}
+
+// drPlanStep is a volume group action of a disaster recovery plan action, and the replication states
+// of the volume groups once it completes.
+type drPlanStep struct {
+	request      *models.VolumeGroupAction
+	targetStates []string
+}
+
+func (a *drPlanAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.typeName
+}
+
+func (a *drPlanAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		Arg_CloudInstanceID: schema.StringAttribute{
+			Required:    true,
+			Description: a.cloudInstanceDescription,
+		},
+		Arg_VolumeGroupIDs: schema.ListAttribute{
+			ElementType: types.StringType,
+			Required:    true,
+			Description: a.volumeGroupsDescription,
+		},
+		Arg_Wait: schema.BoolAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Whether to wait for the replication of the volume groups to be %s. The default value is `true`.", strings.Join(a.targetStates, " or ")),
+		},
+		Arg_WaitTimeout: schema.Int64Attribute{
+			Optional:    true,
+			Description: "The maximum time in seconds to wait for the replication of the volume groups, for all the steps of the action. The default value is `1800`.",
+		},
+	}
+	if len(a.sources) > 0 {
+		attributes[Arg_Source] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("The source of the replication, one of `%s`. The default value is `%s`.", strings.Join(a.sources, "` and `"), a.sources[0]),
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: a.description,
+		Attributes:  attributes,
+	}
+}
+
+func (a *drPlanAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *drPlanAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var vgIDs types.List
+	var waitTimeout types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_VolumeGroupIDs), &vgIDs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_WaitTimeout), &waitTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !vgIDs.IsNull() && !vgIDs.IsUnknown() && len(vgIDs.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root(Arg_VolumeGroupIDs), "Missing Volume Groups", "At least one volume group is required.")
+	}
+	if !waitTimeout.IsNull() && !waitTimeout.IsUnknown() && waitTimeout.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root(Arg_WaitTimeout), "Invalid Wait Timeout", fmt.Sprintf("The %s must be at least 1 second.", Arg_WaitTimeout))
+	}
+	if len(a.sources) > 0 {
+		var source types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_Source), &source)...)
+		if !source.IsNull() && !source.IsUnknown() && !slices.Contains(a.sources, source.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root(Arg_Source), "Invalid Source",
+				fmt.Sprintf("The %s must be one of %s, got: %q", Arg_Source, strings.Join(a.sources, ", "), source.ValueString()))
+		}
+	}
+}
+
+func (a *drPlanAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var cloudInstanceID, source types.String
+	var vgIDs []string
+	var wait types.Bool
+	var waitTimeoutSeconds types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_CloudInstanceID), &cloudInstanceID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_VolumeGroupIDs), &vgIDs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_Wait), &wait)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_WaitTimeout), &waitTimeoutSeconds)...)
+	if len(a.sources) > 0 {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(Arg_Source), &source)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sess, err := a.session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Power Client", err.Error())
+		return
+	}
+	client := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID.ValueString())
+
+	// Every volume group is checked before any action runs, so that a wrong volume group does not
+	// leave the plan half failed over
+	for _, vgID := range vgIDs {
+		vg, err := client.GetDetails(vgID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Get Volume Group", fmt.Sprintf("failed to get volume group %s: %s", vgID, err))
+			return
+		}
+		auxiliary := vg.Auxiliary != nil && *vg.Auxiliary
+		if auxiliary != a.auxiliary {
+			kind, expected := "a primary", "auxiliary"
+			if auxiliary {
+				kind, expected = "an auxiliary", "primary"
+			}
+			resp.Diagnostics.AddAttributeError(path.Root(Arg_VolumeGroupIDs), "Invalid Volume Group",
+				fmt.Sprintf("Volume group %s is %s volume group of workspace %s, %s runs on %s volume groups.", vgID, kind, cloudInstanceID.ValueString(), a.typeName, expected))
+			return
+		}
+	}
+
+	src := source.ValueString()
+	if src == "" && len(a.sources) > 0 {
+		src = a.sources[0]
+	}
+
+	waitTimeout := drPlanDefaultWaitTimeout
+	if !waitTimeoutSeconds.IsNull() {
+		waitTimeout = time.Duration(waitTimeoutSeconds.ValueInt64()) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+
+	var steps []drPlanStep
+	if a.steps != nil {
+		steps = a.steps(src)
+	}
+	for _, step := range steps {
+		if !a.runStep(ctx, client, vgIDs, step.request, resp) || !a.waitForStep(ctx, client, vgIDs, step.targetStates, waitTimeout, resp) {
+			return
+		}
+	}
+
+	if !a.runStep(ctx, client, vgIDs, a.request(src), resp) {
+		return
+	}
+
+	if !wait.IsNull() && !wait.ValueBool() {
+		return
+	}
+
+	if !a.waitForStep(ctx, client, vgIDs, a.targetStates, waitTimeout, resp) {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("The replication of volume groups %s is %s", strings.Join(vgIDs, ", "), strings.Join(a.targetStates, " or ")),
+	})
+}
+
+// runStep runs a volume group action on every volume group, and returns whether it was accepted for
+// all of them.
+func (a *drPlanAction) runStep(ctx context.Context, client *instance.IBMPIVolumeGroupClient, vgIDs []string, request *models.VolumeGroupAction, resp *action.InvokeResponse) bool {
+	for _, vgID := range vgIDs {
+		_, err := client.VolumeGroupAction(vgID, request)
+		if err != nil {
+			resp.Diagnostics.AddError("Volume Group Action Failed", fmt.Sprintf("failed to run the %s action on volume group %s: %s", a.typeName, vgID, err))
+			return false
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Requested %s of volume group %s", drPlanStepName(request), vgID),
+		})
+	}
+	return true
+}
+
+// waitForStep waits for the replication of every volume group to reach one of the target states,
+// and returns whether it did.
+func (a *drPlanAction) waitForStep(ctx context.Context, client *instance.IBMPIVolumeGroupClient, vgIDs []string, targetStates []string, waitTimeout time.Duration, resp *action.InvokeResponse) bool {
+	for _, vgID := range vgIDs {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Waiting for the replication of volume group %s to be %s (timeout: %v)...", vgID, strings.Join(targetStates, " or "), waitTimeout),
+		})
+		_, err := isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, targetStates, waitTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Volume Group Action Failed", err.Error())
+			return false
+		}
+	}
+	return true
+}
+
+// drPlanStepName describes a volume group action in the progress messages.
+func drPlanStepName(request *models.VolumeGroupAction) string {
+	switch {
+	case request.Start != nil && request.Start.Source != nil:
+		return fmt.Sprintf("the start of the replication from %s", *request.Start.Source)
+	case request.Stop != nil && request.Stop.Access != nil && *request.Stop.Access:
+		return "the stop of the replication with access"
+	case request.Stop != nil:
+		return "the stop of the replication"
+	}
+	return "the reset of the replication"
+}
+
+func isWaitForIBMPIVolumeGroupReplicationState(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, targetStates []string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the replication of Volume Group (%s) to be %s.", id, strings.Join(targetStates, " or "))
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Pending},
+		Target:     targetStates,
+		Refresh:    isIBMPIVolumeGroupReplicationStateRefreshFunc(client, id, targetStates),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupReplicationStateRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string, targetStates []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if vg.Status == State_Error {
+			return vg, State_Error, fmt.Errorf("volume group %s is in the %s status", id, State_Error)
+		}
+
+		live, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if vg.Status == State_Available && slices.Contains(targetStates, live.State) {
+			return live, live.State, nil
+		}
+		log.Printf("[DEBUG] volume group %s is %s with replication state %s", id, vg.Status, live.State)
+		return live, State_Pending, nil
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// NewIBMPIDRPlanFailbackAction returns the ibm_pi_dr_plan_failback action, which makes the volume
+// groups of the primary site writable and replicates them to the paired site again.
+func NewIBMPIDRPlanFailbackAction() action.Action {
+	return &drPlanAction{
+		typeName:                 "ibm_pi_dr_plan_failback",
+		description:              "Fails back the volume groups of a disaster recovery plan to the primary site, after a failover. With the aux source, the changes made at the paired site are copied back to the primary site and the replication is stopped with access on the primary site. The replication of the volume groups is then started again from the primary site, and the volume groups are consistent once the changes are copied. Actions do not return output values.",
+		cloudInstanceDescription: "The GUID of the primary workspace of the disaster recovery plan.",
+		volumeGroupsDescription:  "The IDs of the volume groups of the disaster recovery plan, in the primary workspace.",
+		// aux copies the changes made at the paired site during the failover back to the primary
+		// site, master discards them
+		sources: []string{Aux, Master},
+		steps: func(source string) []drPlanStep {
+			if source != Aux {
+				return nil
+			}
+			return []drPlanStep{
+				{
+					request: &models.VolumeGroupAction{
+						Start: &models.VolumeGroupActionStart{Source: sl.String(Aux)},
+					},
+					targetStates: []string{State_ConsistentSynchronized},
+				},
+				{
+					request: &models.VolumeGroupAction{
+						Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)},
+					},
+					targetStates: []string{State_Idling, State_IdlingDisconnected},
+				},
+			}
+		},
+		targetStates: []string{State_ConsistentSynchronized, State_ConsistentCopying},
+		request: func(string) *models.VolumeGroupAction {
+			return &models.VolumeGroupAction{
+				Start: &models.VolumeGroupActionStart{Source: sl.String(Master)},
+			}
+		},
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// NewIBMPIDRPlanFailoverAction returns the ibm_pi_dr_plan_failover action, which makes the auxiliary
+// volume groups of the paired site writable.
+func NewIBMPIDRPlanFailoverAction() action.Action {
+	return &drPlanAction{
+		typeName:                 "ibm_pi_dr_plan_failover",
+		description:              "Fails over the volume groups of a disaster recovery plan to the paired site. The replication of the auxiliary volume groups is stopped with access, so that the instances of the paired site can use their volumes. Actions do not return output values.",
+		cloudInstanceDescription: "The GUID of the workspace in the paired disaster recovery location that takes over, the target workspace of the disaster recovery plan.",
+		volumeGroupsDescription:  "The IDs of the auxiliary volume groups of the workspace, that the volume groups of the plan replicate to.",
+		auxiliary:                true,
+		targetStates:             []string{State_Idling, State_IdlingDisconnected},
+		request: func(string) *models.VolumeGroupAction {
+			return &models.VolumeGroupAction{
+				Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)},
+			}
+		},
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIDRPlanFailoverAndFailbackActions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDRPlanActionConfig("ibm_pi_dr_plan_failover", acc.Pi_dr_target_workspace_id, acc.Pi_auxiliary_volume_group_id, "", "1"),
+			},
+			{
+				Config: testAccCheckIBMPIDRPlanActionConfig("ibm_pi_dr_plan_failback", acc.Pi_cloud_instance_id, acc.Pi_volume_group_id, `pi_source = "aux"`, "2"),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIDRPlanFailoverActionPrimaryVolumeGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIDRPlanActionConfig("ibm_pi_dr_plan_failover", acc.Pi_cloud_instance_id, acc.Pi_volume_group_id, "", "1"),
+				ExpectError: regexp.MustCompile("Invalid Volume Group"),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIDRPlanFailbackActionInvalidSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIDRPlanActionConfig("ibm_pi_dr_plan_failback", acc.Pi_cloud_instance_id, acc.Pi_volume_group_id, `pi_source = "primary"`, "1"),
+				ExpectError: regexp.MustCompile("Invalid Source"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDRPlanActionConfig(actionType, cloudInstanceID, vgID, extra, trigger string) string {
+	return fmt.Sprintf(`
+	action "%[1]s" "dr" {
+		config {
+			pi_cloud_instance_id = "%[2]s"
+			pi_volume_group_ids  = ["%[3]s"]
+			%[4]s
+		}
+	}
+
+	resource "terraform_data" "trigger" {
+		input = "%[5]s"
+		lifecycle {
+			action_trigger {
+				events  = [after_create, after_update]
+				actions = [action.%[1]s.dr]
+			}
+		}
+	}`, actionType, cloudInstanceID, vgID, extra, trigger)
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIJob() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIJobRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_JobID: {
+				Description:  "The ID of the job.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_CreateTimestamp: {
+				Computed:    true,
+				Description: "The timestamp when the job was created.",
+				Type:        schema.TypeString,
+			},
+			Attr_Operation: jobOperationSchema(),
+			Attr_Status:    jobStatusSchema(),
+		},
+	}
+}
+
+func jobOperationSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The operation of the job.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				Attr_Action: {
+					Computed:    true,
+					Description: "The action of the operation, for example imageCapture.",
+					Type:        schema.TypeString,
+				},
+				Attr_ID: {
+					Computed:    true,
+					Description: "The ID of the target resource of the operation.",
+					Type:        schema.TypeString,
+				},
+				Attr_Target: {
+					Computed:    true,
+					Description: "The type of the target resource of the operation.",
+					Type:        schema.TypeString,
+				},
+			},
+		},
+		Type: schema.TypeList,
+	}
+}
+
+func jobStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The status of the job.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				Attr_Message: {
+					Computed:    true,
+					Description: "The message of the current state of the job. For a failed job, it is the reason of the failure.",
+					Type:        schema.TypeString,
+				},
+				Attr_Progress: {
+					Computed:    true,
+					Description: "The progress of the job.",
+					Type:        schema.TypeString,
+				},
+				Attr_State: {
+					Computed:    true,
+					Description: "The state of the job, for example queued, running, completed or failed.",
+					Type:        schema.TypeString,
+				},
+			},
+		},
+		Type: schema.TypeList,
+	}
+}
+
+func dataSourceIBMPIJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "(Data) ibm_pi_job", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	job, err := client.Get(d.Get(Arg_JobID).(string))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "(Data) ibm_pi_job", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(*job.ID)
+	d.Set(Attr_CreateTimestamp, job.CreateTimestamp.String())
+	d.Set(Attr_Operation, flattenJobOperation(job.Operation))
+	d.Set(Attr_Status, flattenJobStatus(job.Status))
+
+	return nil
+}
+
+func flattenJobOperation(operation *models.Operation) []map[string]interface{} {
+	if operation == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		Attr_Action: derefString(operation.Action),
+		Attr_ID:     derefString(operation.ID),
+		Attr_Target: derefString(operation.Target),
+	}}
+}
+
+func flattenJobStatus(status *models.Status) []map[string]interface{} {
+	if status == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		Attr_Message:  status.Message,
+		Attr_Progress: derefString(status.Progress),
+		Attr_State:    derefString(status.State),
+	}}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIJobDataSource_basic(t *testing.T) {
+	jobData := "data.ibm_pi_job.testacc_ds_job"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIJobDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(jobData, "id", acc.Pi_job_id),
+					resource.TestCheckResourceAttrSet(jobData, "create_timestamp"),
+					resource.TestCheckResourceAttrSet(jobData, "operation.0.action"),
+					resource.TestCheckResourceAttrSet(jobData, "status.0.state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIJobDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_job" "testacc_ds_job" {
+			pi_cloud_instance_id = "%s"
+			pi_job_id            = "%s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_job_id)
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIJobsRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_OperationAction: {
+				Description: "Only return the jobs of this operation action, for example imageCapture.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_OperationID: {
+				Description: "Only return the jobs of the operations on the resource with this ID.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_OperationTarget: {
+				Description: "Only return the jobs of the operations on this type of resource.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+
+			// Attributes
+			Attr_Jobs: {
+				Computed:    true,
+				Description: "The jobs of the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CreateTimestamp: {
+							Computed:    true,
+							Description: "The timestamp when the job was created.",
+							Type:        schema.TypeString,
+						},
+						Attr_ID: {
+							Computed:    true,
+							Description: "The ID of the job.",
+							Type:        schema.TypeString,
+						},
+						Attr_Operation: jobOperationSchema(),
+						Attr_Status:    jobStatusSchema(),
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "(Data) ibm_pi_jobs", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	jobs, err := client.GetAll()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetAll failed: %s", err.Error()), "(Data) ibm_pi_jobs", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	filters := map[string]string{}
+	for _, arg := range []string{Arg_OperationAction, Arg_OperationID, Arg_OperationTarget} {
+		if v, ok := d.GetOk(arg); ok {
+			filters[arg] = v.(string)
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(jobs.Jobs))
+	for _, job := range jobs.Jobs {
+		if job == nil || job.ID == nil {
+			continue
+		}
+		if len(filters) > 0 {
+			if job.Operation == nil {
+				continue
+			}
+			operation := map[string]*string{
+				Arg_OperationAction: job.Operation.Action,
+				Arg_OperationID:     job.Operation.ID,
+				Arg_OperationTarget: job.Operation.Target,
+			}
+			matches := true
+			for arg, value := range filters {
+				if operation[arg] == nil || *operation[arg] != value {
+					matches = false
+					break
+				}
+			}
+			if !matches {
+				continue
+			}
+		}
+		result = append(result, map[string]interface{}{
+			Attr_CreateTimestamp: job.CreateTimestamp.String(),
+			Attr_ID:              *job.ID,
+			Attr_Operation:       flattenJobOperation(job.Operation),
+			Attr_Status:          flattenJobStatus(job.Status),
+		})
+	}
+
+	var clientgenU, _ = uuid.GenerateUUID()
+	d.SetId(clientgenU)
+	d.Set(Attr_Jobs, result)
+
+	return nil
+}
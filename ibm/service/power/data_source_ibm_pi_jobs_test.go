@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIJobsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIJobsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_jobs.testacc_ds_jobs", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_jobs.testacc_ds_jobs", "jobs.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIJobsDataSource_operationFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIJobsDataSourceOperationFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_pi_jobs.testacc_ds_jobs", "jobs.0.operation.0.action", "data.ibm_pi_job.testacc_ds_job", "operation.0.action"),
+					resource.TestCheckResourceAttrPair("data.ibm_pi_jobs.testacc_ds_jobs", "jobs.0.operation.0.id", "data.ibm_pi_job.testacc_ds_job", "operation.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIJobsDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_jobs" "testacc_ds_jobs" {
+			pi_cloud_instance_id = "%s"
+		}`, acc.Pi_cloud_instance_id)
+}
+
+func testAccCheckIBMPIJobsDataSourceOperationFilterConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_job" "testacc_ds_job" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_job_id            = "%[2]s"
+		}
+
+		data "ibm_pi_jobs" "testacc_ds_jobs" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_operation_action  = data.ibm_pi_job.testacc_ds_job.operation[0].action
+			pi_operation_id      = data.ibm_pi_job.testacc_ds_job.operation[0].id
+		}`, acc.Pi_cloud_instance_id, acc.Pi_job_id)
+}
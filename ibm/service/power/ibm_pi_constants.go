@@ -84,10 +84,13 @@ const (
 	Arg_ImageStoragePool                     = "pi_image_storage_pool"
 	Arg_ImageStorageType                     = "pi_image_storage_type"
 	Arg_Index                                = "pi_index"
+	Arg_InstanceCount                        = "pi_instance_count"
 	Arg_InstanceID                           = "pi_instance_id"
 	Arg_InstanceName                         = "pi_instance_name"
+	Arg_InstanceNameTemplate                 = "pi_instance_name_template"
 	Arg_IPAddress                            = "pi_ip_address"
 	Arg_IPAddressRange                       = "pi_ipaddress_range"
+	Arg_JobID                                = "pi_job_id"
 	Arg_Key                                  = "pi_ssh_key"
 	Arg_KeyName                              = "pi_key_name"
 	Arg_KeyPairName                          = "pi_key_pair_name"
@@ -114,6 +117,9 @@ const (
 	Arg_NextHop                              = "pi_next_hop"
 	Arg_NextHopType                          = "pi_next_hop_type"
 	Arg_OnboardingVolumes                    = "pi_onboarding_volumes"
+	Arg_OperationAction                      = "pi_operation_action"
+	Arg_OperationID                          = "pi_operation_id"
+	Arg_OperationTarget                      = "pi_operation_target"
 	Arg_Parameters                           = "pi_parameters"
 	Arg_PeerInterfaceID                      = "pi_peer_interface_id"
 	Arg_PinPolicy                            = "pi_pin_policy"
@@ -135,8 +141,10 @@ const (
 	Arg_ReplicationPolicy                    = "pi_replication_policy"
 	Arg_ReplicationScheme                    = "pi_replication_scheme"
 	Arg_ReplicationSites                     = "pi_replication_sites"
+	Arg_ResizePolicy                         = "pi_resize_policy"
 	Arg_ResourceGroupID                      = "pi_resource_group_id"
 	Arg_RetainVirtualSerialNumber            = "pi_retain_virtual_serial_number"
+	Arg_RetryCount                           = "pi_retry_count"
 	Arg_RouteFilterID                        = "pi_route_filter_id"
 	Arg_RouteID                              = "pi_route_id"
 	Arg_SAP                                  = "sap"
@@ -155,6 +163,7 @@ const (
 	Arg_SnapShotName                         = "pi_snap_shot_name"
 	Arg_SnapshotName                         = "pi_snapshot_name"
 	Arg_SoftwareTier                         = "pi_software_tier"
+	Arg_Source                               = "pi_source"
 	Arg_SourceCRN                            = "pi_source_crn"
 	Arg_SourcePort                           = "pi_source_port"
 	Arg_SourcePorts                          = "pi_source_ports"
@@ -170,6 +179,7 @@ const (
 	Arg_StorageType                          = "pi_storage_type"
 	Arg_SysType                              = "pi_sys_type"
 	Arg_Target                               = "pi_target"
+	Arg_TargetCloudInstanceID                = "pi_target_cloud_instance_id"
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
@@ -184,6 +194,7 @@ const (
 	Arg_VolumeCloneTaskID                    = "pi_volume_clone_task_id"
 	Arg_VolumeGroupAction                    = "pi_volume_group_action"
 	Arg_VolumeGroupID                        = "pi_volume_group_id"
+	Arg_VolumeGroupIDs                       = "pi_volume_group_ids"
 	Arg_VolumeGroupName                      = "pi_volume_group_name"
 	Arg_VolumeID                             = "pi_volume_id"
 	Arg_VolumeIDs                            = "pi_volume_ids"
@@ -197,6 +208,8 @@ const (
 	Arg_VPMEMVolumeID                        = "pi_vpmem_volume_id"
 	Arg_VPMEMVolumes                         = "pi_vpmem_volumes"
 	Arg_VTL                                  = "vtl"
+	Arg_Wait                                 = "pi_wait"
+	Arg_WaitTimeout                          = "pi_wait_timeout"
 
 	// Attributes
 	Attr_Access                              = "access"
@@ -233,6 +246,7 @@ const (
 	Attr_ConnectionMode                      = "connection_mode"
 	Attr_Connections                         = "connections"
 	Attr_ConsistencyGroupName                = "consistency_group_name"
+	Attr_Consistent                          = "consistent"
 	Attr_ConsoleLanguages                    = "console_languages"
 	Attr_ContainerFormat                     = "container_format"
 	Attr_CopyRate                            = "copy_rate"
@@ -243,6 +257,7 @@ const (
 	Attr_CPUs                                = "cpus"
 	Attr_Created                             = "created"
 	Attr_CreateTime                          = "create_time"
+	Attr_CreateTimestamp                     = "create_timestamp"
 	Attr_CreationDate                        = "creation_date"
 	Attr_CRN                                 = "crn"
 	Attr_CustomerASN                         = "customer_asn"
@@ -261,6 +276,7 @@ const (
 	Attr_DefaultExportRouteFilter            = "default_export_route_filter"
 	Attr_DefaultImportRouteFilter            = "default_import_route_filter"
 	Attr_DefaultSystem                       = "default_system"
+	Attr_DeferredChanges                     = "deferred_changes"
 	Attr_DeleteOnTermination                 = "delete_on_termination"
 	Attr_DeploymentType                      = "deployment_type"
 	Attr_Description                         = "description"
@@ -336,6 +352,7 @@ const (
 	Attr_InputVolumes                        = "input_volumes"
 	Attr_Instance                            = "instance"
 	Attr_InstanceID                          = "instance_id"
+	Attr_InstanceIDs                         = "instance_ids"
 	Attr_InstanceIP                          = "instance_ip"
 	Attr_InstanceMac                         = "instance_mac"
 	Attr_Instances                           = "instances"
@@ -348,6 +365,7 @@ const (
 	Attr_IPaddress                           = "ipaddress"
 	Attr_IPOctet                             = "ipoctet"
 	Attr_IsActive                            = "is_active"
+	Attr_Jobs                                = "jobs"
 	Attr_Key                                 = "key"
 	Attr_KeyCreationDate                     = "creation_date"
 	Attr_KeyID                               = "key_id"
@@ -415,6 +433,7 @@ const (
 	Attr_OnboardingID                        = "onboarding_id"
 	Attr_Onboardings                         = "onboardings"
 	Attr_OperatingSystem                     = "operating_system"
+	Attr_Operation                           = "operation"
 	Attr_OSType                              = "os_type"
 	Attr_OutOfBandDeleted                    = "out_of_band_deleted"
 	Attr_PeerID                              = "peer_id"
@@ -451,6 +470,7 @@ const (
 	Attr_PVMInstances                        = "pvm_instances"
 	Attr_PVMSnapshots                        = "pvm_snapshots"
 	Attr_Reason                              = "reason"
+	Attr_RebootRequired                      = "reboot_required"
 	Attr_Region                              = "region"
 	Attr_RegionStorageTiers                  = "region_storage_tiers"
 	Attr_Remote                              = "remote"
@@ -524,6 +544,7 @@ const (
 	Attr_SysType                             = "sys_type"
 	Attr_Systype                             = "systype"
 	Attr_Target                              = "target"
+	Attr_TargetLocation                      = "target_location"
 	Attr_TargetLocations                     = "target_locations"
 	Attr_TargetVolumeName                    = "target_volume_name"
 	Attr_TaskID                              = "task_id"
@@ -595,6 +616,7 @@ const (
 	Affinity                   = "affinity"
 	All                        = "all"
 	Allow                      = "allow"
+	AllowReboot                = "allow_reboot"
 	AntiAffinity               = "anti-affinity"
 	Attach                     = "attach"
 	AutoAssign                 = "auto-assign"
@@ -612,6 +634,7 @@ const (
 	DCNetworkBGP               = "dcnetwork_bgp"
 	Dedicated                  = "dedicated"
 	DefaultNAG                 = "default-network-address-group"
+	Defer                      = "defer"
 	Deliver                    = "deliver"
 	Deny                       = "deny"
 	DeploymentTypeEpic         = "EPIC"
@@ -623,6 +646,7 @@ const (
 	EchoReply                  = "echo-reply"
 	Enable                     = "enable"
 	Export                     = "export"
+	FailIfRebootRequired       = "fail_if_reboot_required"
 	Hana                       = "Hana"
 	Hard                       = "hard"
 	Host                       = "host"
@@ -674,49 +698,54 @@ const (
 	Action_Stop              = "stop"
 
 	// States
-	NotFound                 = "not found"
-	State_Active             = "active"
-	State_ACTIVE             = "ACTIVE"
-	State_Added              = "added"
-	State_Adding             = "adding"
-	State_Available          = "available"
-	State_Build              = "build"
-	State_Building           = "building"
-	State_Completed          = "completed"
-	State_Configuring        = "configuring"
-	State_Creating           = "creating"
-	State_Deleted            = "deleted"
-	State_Deleting           = "deleting"
-	State_Detaching          = "detaching"
-	State_Down               = "down"
-	State_Error              = "error"
-	State_ERROR              = "ERROR"
-	State_Failed             = "failed"
-	State_Found              = "Found"
-	State_Inactive           = "inactive"
-	State_InProgress         = "in progress"
-	State_inProgress         = "inProgress"
-	State_InUse              = "in-use"
-	State_NotFound           = "not found"
-	State_Pending            = "pending"
-	State_PENDING            = "PENDING"
-	State_PendingReclamation = "pending_reclamation"
-	State_Provisioning       = "provisioning"
-	State_Queued             = "queued"
-	State_ReadyForProcessing = "readyForProcessing"
-	State_Removed            = "removed"
-	State_Removing           = "removing"
-	State_Resize             = "resize"
-	State_RESIZE             = "RESIZE"
-	State_Retry              = "retry"
-	State_Running            = "running"
-	State_Shutoff            = "shutoff"
-	State_SHUTOFF            = "SHUTOFF"
-	State_Stopping           = "stopping"
-	State_Up                 = "up"
-	State_Updating           = "updating"
-	State_VerifyResize       = "verify_resize"
-	State_Waiting            = "waiting"
+	NotFound                     = "not found"
+	State_Active                 = "active"
+	State_ACTIVE                 = "ACTIVE"
+	State_Added                  = "added"
+	State_Adding                 = "adding"
+	State_Available              = "available"
+	State_Build                  = "build"
+	State_Building               = "building"
+	State_Completed              = "completed"
+	State_Configuring            = "configuring"
+	State_ConsistentCopying      = "consistent_copying"
+	State_ConsistentSynchronized = "consistent_synchronized"
+	State_Creating               = "creating"
+	State_Deleted                = "deleted"
+	State_Deleting               = "deleting"
+	State_Detaching              = "detaching"
+	State_Down                   = "down"
+	State_Enabled                = "enabled"
+	State_Error                  = "error"
+	State_ERROR                  = "ERROR"
+	State_Failed                 = "failed"
+	State_Found                  = "Found"
+	State_Idling                 = "idling"
+	State_IdlingDisconnected     = "idling_disconnected"
+	State_Inactive               = "inactive"
+	State_InProgress             = "in progress"
+	State_inProgress             = "inProgress"
+	State_InUse                  = "in-use"
+	State_NotFound               = "not found"
+	State_Pending                = "pending"
+	State_PENDING                = "PENDING"
+	State_PendingReclamation     = "pending_reclamation"
+	State_Provisioning           = "provisioning"
+	State_Queued                 = "queued"
+	State_ReadyForProcessing     = "readyForProcessing"
+	State_Removed                = "removed"
+	State_Removing               = "removing"
+	State_Resize                 = "resize"
+	State_RESIZE                 = "RESIZE"
+	State_Retry                  = "retry"
+	State_Running                = "running"
+	State_Shutoff                = "shutoff"
+	State_SHUTOFF                = "SHUTOFF"
+	State_Stopping               = "stopping"
+	State_Up                     = "up"
+	State_Updating               = "updating"
+	State_VerifyResize           = "verify_resize"
+	State_Waiting                = "waiting"
 
 	// Timeout values
 	Timeout_Active   = 2 * time.Minute
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// powerResourceIdentity returns the identity of a Power Systems resource,
+// which is made of the ID of the workspace and the ID of the resource.
+func powerResourceIdentity(description string) flex.ResourceIdentity {
+	return flex.ResourceIdentity{
+		Attributes: []flex.IdentityAttribute{
+			{Name: "cloud_instance_id", Description: "The GUID of the service instance associated with an account."},
+			{Name: "id", Description: description},
+		},
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	pierrors "github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
+)
+
+// jobPendingStates are the states of a job that has not completed yet.
+var jobPendingStates = []string{State_Queued, State_ReadyForProcessing, State_inProgress, State_Running, State_Waiting}
+
+// jobError is returned when a Power Virtual Server job fails, or does not complete in time. It
+// reports the operation of the job and the last status of the job.
+type jobError struct {
+	JobID     string
+	Operation *models.Operation
+	Status    *models.Status
+	Timeout   time.Duration
+	// Err is the error of the wait when the job did not complete, for example a timeout.
+	Err error
+}
+
+func (e *jobError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "job %s", e.JobID)
+	if e.Operation != nil && e.Operation.Action != nil && e.Operation.Target != nil {
+		fmt.Fprintf(&b, " (%s on %s %s)", *e.Operation.Action, *e.Operation.Target, derefString(e.Operation.ID))
+	}
+	switch {
+	case e.Err == nil:
+		b.WriteString(" failed")
+	case errors.Is(e.Err, context.DeadlineExceeded):
+		fmt.Fprintf(&b, " did not complete within %s", e.Timeout)
+	default:
+		fmt.Fprintf(&b, " did not complete: %s", e.Err)
+	}
+	if e.Status != nil {
+		if e.Err != nil && e.Status.State != nil {
+			fmt.Fprintf(&b, "; last state %q", *e.Status.State)
+			if e.Status.Progress != nil && *e.Status.Progress != "" {
+				fmt.Fprintf(&b, " at %s", *e.Status.Progress)
+			}
+		}
+		if e.Status.Message != "" {
+			fmt.Fprintf(&b, ": %s", e.Status.Message)
+		}
+	}
+	return b.String()
+}
+
+func (e *jobError) Unwrap() error {
+	return e.Err
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// waitForIBMPIJobCompleted waits for the job to complete, polling with the waiter policy of the
+// provider. A job that fails, or that does not complete before the timeout, returns a *jobError with
+// the failure message of the job.
+func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClient, meta interface{}, jobID string, timeout time.Duration) (interface{}, error) {
+	var lastJob *models.Job
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("job (%s)", jobID),
+		Pending:  jobPendingStates,
+		Target:   []string{State_Completed, State_Failed},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			job, err := client.Get(jobID)
+			if err != nil {
+				log.Printf("[DEBUG] get job failed %v", err)
+				return nil, "", fmt.Errorf(pierrors.GetJobOperationFailed, jobID, err)
+			}
+			if job == nil || job.Status == nil || job.Status.State == nil {
+				log.Printf("[DEBUG] get job failed with empty response")
+				return nil, "", fmt.Errorf("failed to get job status for job id %s", jobID)
+			}
+			lastJob = job
+			log.Printf("[DEBUG] job %s is %s at %s", jobID, *job.Status.State, derefString(job.Status.Progress))
+			return job, *job.Status.State, nil
+		},
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
+	}
+	job, err := stateWaiter.Wait(ctx)
+	if err != nil {
+		var stateErr *waiter.StateError
+		if lastJob != nil && errors.As(err, &stateErr) && stateErr.Err != nil {
+			return lastJob, &jobError{JobID: jobID, Operation: lastJob.Operation, Status: lastJob.Status, Timeout: timeout, Err: stateErr.Err}
+		}
+		return job, err
+	}
+	if lastJob != nil && *lastJob.Status.State == State_Failed {
+		log.Printf("[DEBUG] job status failed with message: %s", lastJob.Status.Message)
+		return lastJob, &jobError{JobID: jobID, Operation: lastJob.Operation, Status: lastJob.Status}
+	}
+	return job, nil
+}
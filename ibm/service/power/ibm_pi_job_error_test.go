@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobError(t *testing.T) {
+	action, target, id := "capture", "vm", "vm-1"
+	operation := &models.Operation{Action: &action, Target: &target, ID: &id}
+	running, failed, progress := State_Running, State_Failed, "40%"
+
+	testcases := []struct {
+		description string
+		err         *jobError
+		expected    string
+	}{
+		{
+			description: "When the job failed, Expect the failure message of the job",
+			err:         &jobError{JobID: "job-1", Operation: operation, Status: &models.Status{State: &failed, Message: "disk full"}},
+			expected:    "job job-1 (capture on vm vm-1) failed: disk full",
+		},
+		{
+			description: "When the wait timed out, Expect the timeout and the last state of the job",
+			err:         &jobError{JobID: "job-1", Status: &models.Status{State: &running, Progress: &progress}, Timeout: 20 * time.Minute, Err: context.DeadlineExceeded},
+			expected:    `job job-1 did not complete within 20m0s; last state "running" at 40%`,
+		},
+		{
+			description: "When the wait was cancelled, Expect the error of the wait",
+			err:         &jobError{JobID: "job-1", Status: &models.Status{State: &running}, Err: context.Canceled},
+			expected:    `job job-1 did not complete: context canceled; last state "running"`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.err.Error())
+			require.Equal(t, tc.err.Err != nil, errors.Is(tc.err, tc.err.Err))
+		})
+	}
+}
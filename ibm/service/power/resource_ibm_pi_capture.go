@@ -204,7 +204,7 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, capturename, capturedestination))
 	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForIBMPIJobCompleted failed: %s", err.Error()), "ibm_pi_capture", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
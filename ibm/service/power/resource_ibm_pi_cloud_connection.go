@@ -26,8 +26,11 @@ var (
 	vpcUnavailable = regexp.MustCompile("pcloudCloudconnectionsPostServiceUnavailable|pcloudCloudconnectionsPutServiceUnavailable")
 )
 
+// ibmPICloudConnectionIdentity is the identity of ibm_pi_cloud_connection, which can be used to import it.
+var ibmPICloudConnectionIdentity = powerResourceIdentity("The unique identifier of the cloud connection.")
+
 func ResourceIBMPICloudConnection() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPICloudConnectionCreate,
 		ReadContext:   resourceIBMPICloudConnectionRead,
 		UpdateContext: resourceIBMPICloudConnectionUpdate,
@@ -156,7 +159,7 @@ func ResourceIBMPICloudConnection() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPICloudConnectionIdentity)
 }
 
 func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -251,7 +254,7 @@ func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceD
 		jobID := *cloudConnectionJob.JobRef.ID
 
 		client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, client, meta, jobID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -358,7 +361,7 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 			}
 		}
 		if cloudConnectionJob != nil {
-			_, err = waitForIBMPIJobCompleted(ctx, jobClient, *cloudConnectionJob.ID, d.Timeout(schema.TimeoutCreate))
+			_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *cloudConnectionJob.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -379,7 +382,7 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -393,7 +396,7 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -510,7 +513,7 @@ func resourceIBMPICloudConnectionDelete(ctx context.Context, d *schema.ResourceD
 	if deleteJob != nil {
 		jobID := *deleteJob.ID
 		client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutDelete))
+		_, err = waitForIBMPIJobCompleted(ctx, client, meta, jobID, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -79,7 +79,7 @@ func resourceIBMPICloudConnectionNetworkAttachCreate(ctx context.Context, d *sch
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, cloudConnectionID, networkID))
 	if jobReference != nil {
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *jobReference.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForIBMPIJobCompleted failed: %s", err.Error()), "ibm_pi_cloud_connection_network_attach", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -138,7 +138,7 @@ func resourceIBMPICloudConnectionNetworkAttachDelete(ctx context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 	if jobReference != nil {
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutDelete))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *jobReference.ID, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForIBMPIJobCompleted failed: %s", err.Error()), "ibm_pi_cloud_connection_network_attach", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -15,14 +15,18 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_service_d_h_c_p"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIDHCPIdentity is the identity of ibm_pi_dhcp, which can be used to import it.
+var ibmPIDHCPIdentity = powerResourceIdentity("The unique identifier of the DHCP server.")
+
 func ResourceIBMPIDhcp() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIDhcpCreate,
 		ReadContext:   resourceIBMPIDhcpRead,
 		DeleteContext: resourceIBMPIDhcpDelete,
@@ -114,7 +118,7 @@ func ResourceIBMPIDhcp() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPIDHCPIdentity)
 }
 
 func resourceIBMPIDhcpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,345 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ibmPIDRPlanIdentity is the identity of ibm_pi_dr_plan, which can be used to import it.
+var ibmPIDRPlanIdentity = powerResourceIdentity("The GUID of the target workspace of the disaster recovery plan.")
+
+func ResourceIBMPIDRPlan() *schema.Resource {
+	return flex.WithResourceIdentity(&schema.Resource{
+		CreateContext: resourceIBMPIDRPlanCreate,
+		ReadContext:   resourceIBMPIDRPlanRead,
+		UpdateContext: resourceIBMPIDRPlanUpdate,
+		DeleteContext: resourceIBMPIDRPlanDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the primary workspace of the volume groups.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_TargetCloudInstanceID: {
+				Description:  "The GUID of the workspace in the paired disaster recovery location that the volume groups replicate to.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupIDs: {
+				Description: "The IDs of the volume groups of the primary workspace that replicate to the target workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+
+			// Attributes
+			Attr_Consistent: {
+				Computed:    true,
+				Description: "Indicates whether the replication of every volume group is consistent.",
+				Type:        schema.TypeBool,
+			},
+			Attr_Location: {
+				Computed:    true,
+				Description: "The location of the primary workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_TargetLocation: {
+				Computed:    true,
+				Description: "The location of the target workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_VolumeGroups: {
+				Computed:    true,
+				Description: "The replication of the volume groups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_ConsistencyGroupName: {
+							Computed:    true,
+							Description: "The name of the consistency group at the storage controller level.",
+							Type:        schema.TypeString,
+						},
+						Attr_CyclingMode: {
+							Computed:    true,
+							Description: "The type of cycling mode used.",
+							Type:        schema.TypeString,
+						},
+						Attr_ID: {
+							Computed:    true,
+							Description: "The ID of the volume group.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the volume group.",
+							Type:        schema.TypeString,
+						},
+						Attr_PrimaryRole: {
+							Computed:    true,
+							Description: "Indicates whether master or aux volumes are acting as the primary of the replication.",
+							Type:        schema.TypeString,
+						},
+						Attr_ReplicationStatus: {
+							Computed:    true,
+							Description: "The replication status of the volume group.",
+							Type:        schema.TypeString,
+						},
+						Attr_State: {
+							Computed:    true,
+							Description: "The replication state of the consistency group, for example consistent_synchronized.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the volume group.",
+							Type:        schema.TypeString,
+						},
+						Attr_Synchronized: {
+							Computed:    true,
+							Description: "Indicates whether the relationships of the consistency group are synchronized.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}, ibmPIDRPlanIdentity)
+}
+
+func resourceIBMPIDRPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_dr_plan", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	targetCloudInstanceID := d.Get(Arg_TargetCloudInstanceID).(string)
+	location, targetLocation, err := validateIBMPIDRPlanTarget(ctx, sess, cloudInstanceID, targetCloudInstanceID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_pi_dr_plan", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	client := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vgIDs := flex.ExpandStringList(d.Get(Arg_VolumeGroupIDs).(*schema.Set).List())
+	err = validateIBMPIDRPlanVolumeGroups(client, vgIDs, targetLocation)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_pi_dr_plan", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, targetCloudInstanceID))
+	d.Set(Attr_Location, location)
+	d.Set(Attr_TargetLocation, targetLocation)
+
+	return resourceIBMPIDRPlanRead(ctx, d, meta)
+}
+
+func resourceIBMPIDRPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_dr_plan", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, targetCloudInstanceID, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_dr_plan", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_TargetCloudInstanceID, targetCloudInstanceID)
+
+	// The locations are only set on create, and on import
+	if _, ok := d.GetOk(Attr_TargetLocation); !ok {
+		location, targetLocation, err := validateIBMPIDRPlanTarget(ctx, sess, cloudInstanceID, targetCloudInstanceID)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_pi_dr_plan", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		d.Set(Attr_Location, location)
+		d.Set(Attr_TargetLocation, targetLocation)
+	}
+
+	client := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	var vgIDs []string
+	if v, ok := d.GetOk(Arg_VolumeGroupIDs); ok {
+		vgIDs = flex.ExpandStringList(v.(*schema.Set).List())
+	} else {
+		// On import, the plan covers the volume groups that replicate to the target location
+		vgs, err := client.GetAllDetails()
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetAllDetails failed: %s", err.Error()), "ibm_pi_dr_plan", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		for _, vg := range vgs.VolumeGroups {
+			if vg == nil || vg.ID == nil || (vg.Auxiliary != nil && *vg.Auxiliary) {
+				continue
+			}
+			if slices.Contains(vg.ReplicationSites, d.Get(Attr_TargetLocation).(string)) {
+				vgIDs = append(vgIDs, *vg.ID)
+			}
+		}
+	}
+
+	consistent := true
+	ids := make([]string, 0, len(vgIDs))
+	volumeGroups := make([]map[string]interface{}, 0, len(vgIDs))
+	for _, vgID := range vgIDs {
+		vg, err := client.GetDetails(vgID)
+		if err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+				log.Printf("[WARN] volume group %s of disaster recovery plan %s is not found, removing it from the plan", vgID, d.Id())
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetDetails failed: %s", err.Error()), "ibm_pi_dr_plan", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		live, err := client.GetVolumeGroupLiveDetails(vgID)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVolumeGroupLiveDetails failed: %s", err.Error()), "ibm_pi_dr_plan", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+
+		ids = append(ids, vgID)
+		consistent = consistent && strings.HasPrefix(live.State, "consistent_")
+		volumeGroups = append(volumeGroups, map[string]interface{}{
+			Attr_ConsistencyGroupName: vg.ConsistencyGroupName,
+			Attr_CyclingMode:          live.CyclingMode,
+			Attr_ID:                   vgID,
+			Attr_Name:                 derefString(vg.Name),
+			Attr_PrimaryRole:          live.PrimaryRole,
+			Attr_ReplicationStatus:    vg.ReplicationStatus,
+			Attr_State:                live.State,
+			Attr_Status:               vg.Status,
+			Attr_Synchronized:         live.Sync,
+		})
+	}
+
+	d.Set(Arg_VolumeGroupIDs, ids)
+	d.Set(Attr_Consistent, consistent && len(ids) > 0)
+	d.Set(Attr_VolumeGroups, volumeGroups)
+
+	return nil
+}
+
+func resourceIBMPIDRPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_dr_plan", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if d.HasChange(Arg_VolumeGroupIDs) {
+		cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+		client := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+		oldRaw, newRaw := d.GetChange(Arg_VolumeGroupIDs)
+		added := flex.ExpandStringList(newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set)).List())
+		err = validateIBMPIDRPlanVolumeGroups(client, added, d.Get(Attr_TargetLocation).(string))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_pi_dr_plan", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMPIDRPlanRead(ctx, d, meta)
+}
+
+func resourceIBMPIDRPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The plan only tracks the volume groups, which keep replicating when the plan is deleted
+	d.SetId("")
+	return nil
+}
+
+// validateIBMPIDRPlanTarget returns the locations of the primary and the target workspace, and an
+// error when the location of the target workspace is not an active replication site of the
+// disaster recovery location of the primary workspace.
+func validateIBMPIDRPlanTarget(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID, targetCloudInstanceID string) (string, string, error) {
+	drClient := instance.NewIBMPIDisasterRecoveryLocationClient(ctx, sess, cloudInstanceID)
+	drLocation, err := drClient.Get()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the disaster recovery location of workspace %s: %w", cloudInstanceID, err)
+	}
+
+	// The target workspace can be in another region, so it is read from the resource controller
+	wsClient := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+	target, _, err := wsClient.GetRC(targetCloudInstanceID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the target workspace %s: %w", targetCloudInstanceID, err)
+	}
+	targetLocation := ""
+	if target.RegionID != nil {
+		targetLocation = *target.RegionID
+	}
+
+	sites := make([]string, 0, len(drLocation.ReplicationSites))
+	for _, site := range drLocation.ReplicationSites {
+		if site == nil {
+			continue
+		}
+		if site.Location == targetLocation {
+			if !site.IsActive {
+				return "", "", fmt.Errorf("the replication site %s of the target workspace %s is not active", targetLocation, targetCloudInstanceID)
+			}
+			return drLocation.Location, targetLocation, nil
+		}
+		sites = append(sites, site.Location)
+	}
+	return "", "", fmt.Errorf("the target workspace %s in %s is not in the disaster recovery location pair of %s, which replicates to %s", targetCloudInstanceID, targetLocation, drLocation.Location, strings.Join(sites, ", "))
+}
+
+// validateIBMPIDRPlanVolumeGroups returns an error when a volume group is an auxiliary volume
+// group, or does not replicate to the target location.
+func validateIBMPIDRPlanVolumeGroups(client *instance.IBMPIVolumeGroupClient, vgIDs []string, targetLocation string) error {
+	for _, vgID := range vgIDs {
+		vg, err := client.GetDetails(vgID)
+		if err != nil {
+			return fmt.Errorf("failed to get volume group %s: %w", vgID, err)
+		}
+		if vg.Auxiliary != nil && *vg.Auxiliary {
+			return fmt.Errorf("volume group %s is an auxiliary volume group, which replicates from another workspace", vgID)
+		}
+		if vg.ReplicationStatus != State_Enabled {
+			return fmt.Errorf("the replication of volume group %s is %q, it must be %q", vgID, vg.ReplicationStatus, State_Enabled)
+		}
+		if !slices.Contains(vg.ReplicationSites, targetLocation) {
+			return fmt.Errorf("volume group %s replicates to %s, not to the target location %s", vgID, strings.Join(vg.ReplicationSites, ", "), targetLocation)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIDRPlanBasic(t *testing.T) {
+	drPlanRes := "ibm_pi_dr_plan.dr_plan"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDRPlanConfig(acc.Pi_dr_target_workspace_id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(drPlanRes, "pi_volume_group_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(drPlanRes, "location"),
+					resource.TestCheckResourceAttrSet(drPlanRes, "target_location"),
+					resource.TestCheckResourceAttrSet(drPlanRes, "consistent"),
+					resource.TestCheckResourceAttr(drPlanRes, "volume_groups.0.id", acc.Pi_volume_group_id),
+					resource.TestCheckResourceAttrSet(drPlanRes, "volume_groups.0.state"),
+					resource.TestCheckResourceAttrSet(drPlanRes, "volume_groups.0.primary_role"),
+				),
+			},
+			{
+				ResourceName:      drPlanRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// An import covers every volume group that replicates to the target location
+				ImportStateVerifyIgnore: []string{"pi_volume_group_ids", "volume_groups"},
+			},
+		},
+	})
+}
+
+func TestAccIBMPIDRPlanTargetNotPaired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// A workspace is not in the disaster recovery location pair of itself
+				Config:      testAccCheckIBMPIDRPlanConfig(acc.Pi_cloud_instance_id),
+				ExpectError: regexp.MustCompile("is not in the disaster recovery location pair"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDRPlanConfig(targetCloudInstanceID string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_dr_plan" "dr_plan" {
+		pi_cloud_instance_id        = "%[1]s"
+		pi_target_cloud_instance_id = "%[2]s"
+		pi_volume_group_ids         = ["%[3]s"]
+	}`, acc.Pi_cloud_instance_id, targetCloudInstanceID, acc.Pi_volume_group_id)
+}
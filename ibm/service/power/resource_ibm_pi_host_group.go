@@ -23,8 +23,11 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 )
 
+// ibmPIHostGroupIdentity is the identity of ibm_pi_host_group, which can be used to import it.
+var ibmPIHostGroupIdentity = powerResourceIdentity("The unique identifier of the host group.")
+
 func ResourceIBMPIHostGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIHostGroupCreate,
 		ReadContext:   resourceIBMPIHostGroupRead,
 		DeleteContext: resourceIBMPIHostGroupDelete,
@@ -151,7 +154,7 @@ func ResourceIBMPIHostGroup() *schema.Resource {
 				Type: schema.TypeList,
 			},
 		},
-	}
+	}, ibmPIHostGroupIdentity)
 }
 
 func resourceIBMPIHostGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -109,7 +109,7 @@ func resourceIBMPIImageExportCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(fmt.Sprintf("%s/%s/%s", imageid, bucketName, d.Get(Arg_ImageBucketRegion).(string)))
 
 	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -25,8 +25,11 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
+// ibmPIInstanceIdentity is the identity of ibm_pi_instance, which can be used to import it.
+var ibmPIInstanceIdentity = powerResourceIdentity("The unique identifier of the instance. The IDs of several instances created together are separated by \"/\".")
+
 func ResourceIBMPIInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIInstanceCreate,
 		ReadContext:   resourceIBMPIInstanceRead,
 		UpdateContext: resourceIBMPIInstanceUpdate,
@@ -42,6 +45,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPIInstanceResizeCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -288,6 +292,13 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Set:         schema.HashString,
 				Type:        schema.TypeSet,
 			},
+			Arg_ResizePolicy: {
+				Default:      AllowReboot,
+				Description:  "How to apply changes that require stopping the instance, such as memory or processors outside of the minimum and maximum values of the instance. allow_reboot stops and restarts the instance, fail_if_reboot_required fails the plan, and defer skips the changes until the instance is shut off.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{AllowReboot, FailIfRebootRequired, Defer}),
+			},
 			Arg_RetainVirtualSerialNumber: {
 				Default:     false,
 				Description: "Indicates whether to retain virtual serial number when changed or deleted.",
@@ -437,6 +448,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "The dedicated host ID where the shared processor pool resides.",
 				Type:        schema.TypeString,
 			},
+			Attr_DeferredChanges: {
+				Computed:    true,
+				Description: "The changes that require the instance to be stopped, and that the last apply did not apply because pi_resize_policy is defer.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
 			Attr_EffectiveProcessorCompatibilityMode: {
 				Computed:    true,
 				Description: "Effective processor compatibility mode.",
@@ -514,6 +531,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Progress of the operation",
 				Type:        schema.TypeFloat,
 			},
+			Attr_RebootRequired: {
+				Computed:    true,
+				Description: "Whether the last apply stopped and restarted the instance to apply changes that require the instance to be stopped.",
+				Type:        schema.TypeBool,
+			},
 			Attr_SharedProcessorPoolID: {
 				Computed:    true,
 				Description: "Shared Processor Pool ID the instance is deployed on",
@@ -526,7 +548,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 			},
 			Attr_VPMEMVolumes: vpmemVolumeSchema(),
 		},
-	}
+	}, ibmPIInstanceIdentity)
 }
 
 func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -540,11 +562,12 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	sapClient := instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
 	imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 
+	name := d.Get(Arg_InstanceName).(string)
 	var pvmList *models.PVMInstanceList
 	if _, ok := d.GetOk(Arg_SAPProfileID); ok {
-		pvmList, err = createSAPInstance(d, sapClient)
+		pvmList, err = createSAPInstance(d, sapClient, name)
 	} else {
-		pvmList, err = createPVMInstance(d, client, imageClient)
+		pvmList, err = createPVMInstance(d, client, imageClient, name)
 	}
 	if err != nil {
 		return diag.FromErr(err)
@@ -766,6 +789,39 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
 	}
 
+	var diags diag.Diagnostics
+	resizePolicy := d.Get(Arg_ResizePolicy).(string)
+	stopChanges := instanceChangesRequiringStop(d)
+	if len(stopChanges) > 0 {
+		switch resizePolicy {
+		case FailIfRebootRequired:
+			return diag.Errorf("the changes require the lpar to be stopped, which %s %s does not allow: %s", Arg_ResizePolicy, FailIfRebootRequired, stopChanges)
+		case Defer:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Changes deferred until the lpar is shut off",
+				Detail:   fmt.Sprintf("The changes require the lpar to be stopped, so they are not applied with %s %s: %s. They are applied by the next apply once the lpar is shut off.", Arg_ResizePolicy, Defer, stopChanges),
+			})
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The lpar was stopped and restarted",
+				Detail:   fmt.Sprintf("The lpar was stopped and restarted to apply the changes: %s.", stopChanges),
+			})
+		}
+	}
+	deferred := func(arg string) bool {
+		return resizePolicy == Defer && stopChanges.has(arg)
+	}
+	// Report what this apply does with the changes that stop the lpar
+	if resizePolicy == Defer {
+		d.Set(Attr_DeferredChanges, stopChanges.reasons())
+		d.Set(Attr_RebootRequired, false)
+	} else {
+		d.Set(Attr_DeferredChanges, []string{})
+		d.Set(Attr_RebootRequired, len(stopChanges) > 0)
+	}
+
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.Errorf("failed to get the session from the IBM Cloud Service")
@@ -817,7 +873,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(Arg_ProcType) {
+	if d.HasChange(Arg_ProcType) && !deferred(Arg_ProcType) {
 		// Stop the lpar
 		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Shutoff {
@@ -869,23 +925,24 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	// Start of the change for Memory and Processors
-	if d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors) {
-
-		maxMemLpar := d.Get(Attr_MaxMemory).(float64)
-		maxCPULpar := d.Get(Attr_MaxProcessors).(float64)
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-			log.Printf("Will require a shutdown to perform the change")
-		} else {
-			log.Printf("maxMemLpar is set to %f", maxMemLpar)
-			log.Printf("maxCPULpar is set to %f", maxCPULpar)
-		}
-
+	// Start of the change for Memory and Processors. A deferred change of one of them keeps its
+	// current value, and the change of the other one is applied.
+	memChanged := d.HasChange(Arg_Memory) && !deferred(Arg_Memory)
+	procsChanged := d.HasChange(Arg_Processors) && !deferred(Arg_Processors)
+	if memChanged || procsChanged {
 		instanceState := d.Get(Attr_Status).(string)
 		log.Printf("the instance state is %s", instanceState)
+		if !memChanged {
+			oldMem, _ := d.GetChange(Arg_Memory)
+			mem = oldMem.(float64)
+		}
+		if !procsChanged {
+			oldProcs, _ := d.GetChange(Arg_Processors)
+			procs = oldProcs.(float64)
+		}
 
-		if (mem > maxMemLpar || procs > maxCPULpar) && strings.ToLower(instanceState) != State_Shutoff {
+		if (memChanged && stopChanges.has(Arg_Memory)) || (procsChanged && stopChanges.has(Arg_Processors)) {
+			log.Printf("Will require a shutdown to perform the change")
 			err = performChangeAndReboot(ctx, client, d, instanceID, mem, procs)
 			if err != nil {
 				return diag.FromErr(err)
@@ -932,7 +989,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(Arg_SAPProfileID) {
+	if d.HasChange(Arg_SAPProfileID) && !deferred(Arg_SAPProfileID) {
 		// Stop the lpar
 		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Shutoff {
@@ -1063,7 +1120,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange(Arg_VirtualSerialNumber) {
+	if d.HasChange(Arg_VirtualSerialNumber) && !deferred(Arg_VirtualSerialNumber) {
 		vsnClient := instance.NewIBMPIVSNClient(ctx, sess, cloudInstanceID)
 		restartInstance := false
 		if d.HasChange(Arg_VirtualSerialNumber + ".0." + Attr_Serial) {
@@ -1185,7 +1242,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1560,6 +1617,113 @@ func startLparAfterResourceChange(ctx context.Context, client *instance.IBMPIIns
 	return err
 }
 
+// instanceChange is implemented by both *schema.ResourceData and *schema.ResourceDiff, so that
+// the plan and the apply of an update decide the same way whether the lpar must be stopped.
+type instanceChange interface {
+	Get(key string) interface{}
+	HasChange(key string) bool
+}
+
+// instanceStopChange is a change of an argument that can only be applied while the lpar is stopped.
+type instanceStopChange struct {
+	Arg    string
+	Reason string
+}
+
+type instanceStopChanges []instanceStopChange
+
+func (c instanceStopChanges) has(arg string) bool {
+	for _, change := range c {
+		if change.Arg == arg {
+			return true
+		}
+	}
+	return false
+}
+
+// reasons returns the argument and the reason of every change.
+func (c instanceStopChanges) reasons() []string {
+	reasons := make([]string, 0, len(c))
+	for _, change := range c {
+		reasons = append(reasons, fmt.Sprintf("%s %s", change.Arg, change.Reason))
+	}
+	return reasons
+}
+
+func (c instanceStopChanges) String() string {
+	return strings.Join(c.reasons(), "; ")
+}
+
+// instanceChangesRequiringStop returns the changes of an update that stop the lpar. Memory and
+// processors are only resized in place within the current minimum and maximum of the lpar. A
+// shut off lpar does not need to be stopped.
+func instanceChangesRequiringStop(d instanceChange) instanceStopChanges {
+	if strings.ToLower(d.Get(Attr_Status).(string)) == State_Shutoff {
+		return nil
+	}
+
+	var changes instanceStopChanges
+	for _, r := range []struct{ arg, min, max string }{
+		{Arg_Memory, Attr_MinMemory, Attr_MaxMemory},
+		{Arg_Processors, Attr_MinProcessors, Attr_MaxProcessors},
+	} {
+		if !d.HasChange(r.arg) {
+			continue
+		}
+		// An unknown value is 0 in a plan
+		value := d.Get(r.arg).(float64)
+		minValue, maxValue := d.Get(r.min).(float64), d.Get(r.max).(float64)
+		if value == 0 {
+			continue
+		}
+		if maxValue > 0 && value > maxValue {
+			changes = append(changes, instanceStopChange{r.arg, fmt.Sprintf("%v is above the maximum %v of the lpar", value, maxValue)})
+		} else if minValue > 0 && value < minValue {
+			changes = append(changes, instanceStopChange{r.arg, fmt.Sprintf("%v is below the minimum %v of the lpar", value, minValue)})
+		}
+	}
+	if d.HasChange(Arg_ProcType) {
+		changes = append(changes, instanceStopChange{Arg_ProcType, "changes"})
+	}
+	if d.HasChange(Arg_SAPProfileID) {
+		changes = append(changes, instanceStopChange{Arg_SAPProfileID, "changes"})
+	}
+	if d.HasChange(Arg_VirtualSerialNumber+".0."+Attr_Serial) || d.HasChange(Arg_VirtualSerialNumber+".0."+Attr_SoftwareTier) {
+		changes = append(changes, instanceStopChange{Arg_VirtualSerialNumber, "serial or software tier changes"})
+	}
+	return changes
+}
+
+// resourceIBMPIInstanceResizeCustomizeDiff plans the changes that stop the lpar according to
+// pi_resize_policy. reboot_required and deferred_changes report what the apply does, so they are
+// known after the apply of changes that stop the lpar, and after the apply that resets them.
+func resourceIBMPIInstanceResizeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	stopChanges := instanceChangesRequiringStop(diff)
+	if len(stopChanges) > 0 || diff.Get(Attr_RebootRequired).(bool) || len(diff.Get(Attr_DeferredChanges).([]interface{})) > 0 {
+		if err := diff.SetNewComputed(Attr_RebootRequired); err != nil {
+			return err
+		}
+		if err := diff.SetNewComputed(Attr_DeferredChanges); err != nil {
+			return err
+		}
+	}
+	if len(stopChanges) == 0 {
+		return nil
+	}
+	switch diff.Get(Arg_ResizePolicy).(string) {
+	case FailIfRebootRequired:
+		return fmt.Errorf("the changes require the lpar to be stopped, which %s %s does not allow: %s", Arg_ResizePolicy, FailIfRebootRequired, stopChanges)
+	case Defer:
+		log.Printf("[WARN] the changes of lpar %s are deferred until it is shut off: %s", diff.Id(), stopChanges)
+	default:
+		log.Printf("[WARN] the lpar %s is stopped and restarted to apply the changes: %s", diff.Id(), stopChanges)
+	}
+	return nil
+}
+
 // Stop / Modify / Start only when the lpar is off limits
 func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceClient, d *schema.ResourceData, id string, mem, procs float64) error {
 	/*
@@ -1639,8 +1803,10 @@ func expandPVMNetworks(networks []interface{}) []*models.PVMInstanceAddNetwork {
 	pvmNetworks := make([]*models.PVMInstanceAddNetwork, 0, len(networks))
 	for _, v := range networks {
 		network := v.(map[string]interface{})
+		// ibm_pi_instance_set has no ip_address, every instance gets its own
+		ipAddress, _ := network[Attr_IPAddress].(string)
 		pvmInstanceNetwork := &models.PVMInstanceAddNetwork{
-			IPAddress:               network[Attr_IPAddress].(string),
+			IPAddress:               ipAddress,
 			NetworkID:               flex.PtrToString(network[Attr_NetworkID].(string)),
 			NetworkSecurityGroupIDs: flex.ExpandStringList((network[Attr_NetworkSecurityGroupIDs].(*schema.Set)).List()),
 		}
@@ -1673,8 +1839,9 @@ func checkCloudInstanceCapability(cloudInstance *models.CloudInstance, custom_ca
 	return false
 }
 
-func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInstanceClient) (*models.PVMInstanceList, error) {
-	name := d.Get(Arg_InstanceName).(string)
+// createSAPInstance creates the SAP instances named name from the arguments of d, which are shared
+// by ibm_pi_instance and ibm_pi_instance_set.
+func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInstanceClient, name string) (*models.PVMInstanceList, error) {
 	profileID := d.Get(Arg_SAPProfileID).(string)
 	imageid := d.Get(Arg_ImageID).(string)
 
@@ -1809,9 +1976,9 @@ func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInsta
 	return pvmList, nil
 }
 
-func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, imageClient *instance.IBMPIImageClient) (*models.PVMInstanceList, error) {
-
-	name := d.Get(Arg_InstanceName).(string)
+// createPVMInstance creates the instances named name from the arguments of d, which are shared by
+// ibm_pi_instance and ibm_pi_instance_set.
+func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, imageClient *instance.IBMPIImageClient, name string) (*models.PVMInstanceList, error) {
 	imageid := d.Get(Arg_ImageID).(string)
 
 	var mem, procs float64
@@ -1843,18 +2010,6 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 	if v, ok := d.GetOk(Arg_VolumeIDs); ok {
 		volids = flex.ExpandStringList((v.(*schema.Set)).List())
 	}
-	var replicants float64
-	if r, ok := d.GetOk(Arg_Replicants); ok {
-		replicants = float64(r.(int))
-	}
-	var replicationpolicy string
-	if r, ok := d.GetOk(Arg_ReplicationPolicy); ok {
-		replicationpolicy = r.(string)
-	}
-	var replicationNamingScheme string
-	if r, ok := d.GetOk(Arg_ReplicationScheme); ok {
-		replicationNamingScheme = r.(string)
-	}
 	var pinpolicy string
 	if p, ok := d.GetOk(Arg_PinPolicy); ok {
 		pinpolicy = p.(string)
@@ -1869,17 +2024,24 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 	}
 
 	body := &models.PVMInstanceCreate{
-		Processors:              &procs,
-		Memory:                  &mem,
-		ServerName:              flex.PtrToString(name),
-		SysType:                 systype,
-		ImageID:                 flex.PtrToString(imageid),
-		ProcType:                flex.PtrToString(processortype),
-		Replicants:              &replicants,
-		UserData:                encodeBase64(userData),
-		ReplicantNamingScheme:   flex.PtrToString(replicationNamingScheme),
-		ReplicantAffinityPolicy: flex.PtrToString(replicationpolicy),
-		Networks:                pvmNetworks,
+		Processors: &procs,
+		Memory:     &mem,
+		ServerName: flex.PtrToString(name),
+		SysType:    systype,
+		ImageID:    flex.PtrToString(imageid),
+		ProcType:   flex.PtrToString(processortype),
+		UserData:   encodeBase64(userData),
+		Networks:   pvmNetworks,
+	}
+	// ibm_pi_instance_set has no replicants, it creates its instances one at a time
+	if r, ok := d.GetOk(Arg_Replicants); ok {
+		body.Replicants = core.Float64Ptr(float64(r.(int)))
+	}
+	if r, ok := d.GetOk(Arg_ReplicationPolicy); ok {
+		body.ReplicantAffinityPolicy = flex.PtrToString(r.(string))
+	}
+	if r, ok := d.GetOk(Arg_ReplicationScheme); ok {
+		body.ReplicantNamingScheme = flex.PtrToString(r.(string))
 	}
 	if s, ok := d.GetOk(Arg_KeyPairName); ok {
 		sshkey := s.(string)
@@ -0,0 +1,644 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// instanceSetIndexRegexp matches the {index} and {index:N} placeholders of pi_instance_name_template.
+var instanceSetIndexRegexp = regexp.MustCompile(`\{index(?::(\d+))?\}`)
+
+func ResourceIBMPIInstanceSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIInstanceSetCreate,
+		ReadContext:   resourceIBMPIInstanceSetRead,
+		UpdateContext: resourceIBMPIInstanceSetUpdate,
+		DeleteContext: resourceIBMPIInstanceSetDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: resourceIBMPIInstanceSetCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_DeploymentType: {
+				Description:  "Custom Deployment Type Information",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{DeploymentTypeEpic, DeploymentTypeVMNoStorage}),
+			},
+			Arg_HealthStatus: {
+				Default:      OK,
+				Description:  "The health status an instance must reach to be ready.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{OK, Warning}),
+			},
+			Arg_ImageID: {
+				Description:  "The ID of the image of the instances.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceCount: {
+				Description:  "The number of instances in the set.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_InstanceNameTemplate: {
+				Description:  "The name of the instances, where {index} is replaced by the index of the instance starting at 1, and {index:N} by the index padded with zeros to N digits.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(instanceSetIndexRegexp, "must contain {index} or {index:N}"),
+			},
+			Arg_KeyPairName: {
+				Description: "The name of the SSH key of the instances.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_Memory: {
+				ConflictsWith: []string{Arg_SAPProfileID},
+				Description:   "The amount of memory of each instance, in GB.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeFloat,
+			},
+			Arg_Network: {
+				Description: "The networks to attach to the instances. Every instance gets its own IP address.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_NetworkID: {
+							Description: "The network ID.",
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_NetworkSecurityGroupIDs: {
+							Description: "The network security groups that the network interfaces of the instances are members of.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Set:         schema.HashString,
+							Type:        schema.TypeSet,
+						},
+					},
+				},
+				ForceNew: true,
+				MinItems: 1,
+				Required: true,
+				Type:     schema.TypeList,
+			},
+			Arg_PinPolicy: {
+				Default:      None,
+				Description:  "The pin policy of the instances.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{None, Soft, Hard}),
+			},
+			Arg_PlacementGroupID: {
+				Description: "The ID of the placement group of the instances. The instances are created one at a time when set.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_ProcType: {
+				ConflictsWith: []string{Arg_SAPProfileID},
+				Description:   "The processor type of the instances.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+				ValidateFunc:  validate.ValidateAllowedStringValues([]string{Dedicated, Shared, Capped}),
+			},
+			Arg_Processors: {
+				ConflictsWith: []string{Arg_SAPProfileID},
+				Description:   "The number of processors of each instance.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeFloat,
+			},
+			Arg_RetryCount: {
+				Default:      1,
+				Description:  "The number of times an instance that fails to create is deleted and created again.",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			Arg_SAPProfileID: {
+				ConflictsWith: []string{Arg_Processors, Arg_Memory, Arg_ProcType},
+				Description:   "The SAP profile ID for the cores and memory of the instances.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			Arg_SharedProcessorPool: {
+				ConflictsWith: []string{Arg_SAPProfileID},
+				Description:   "The shared processor pool the instances are deployed on.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			Arg_StorageConnection: {
+				Description:  "The storage connectivity group of the instances.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{vSCSI, MaxVolumeSupport}),
+			},
+			Arg_StoragePool: {
+				Description: "The storage pool of the instances.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_StorageType: {
+				Description: "The storage type of the instances.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_SysType: {
+				Description: "The type of system on which to create the instances.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_UserData: {
+				Description: "The user data for the cloud init script of the instances.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_UserTags: {
+				Description: "The user tags attached to the instances.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+
+			// Attributes
+			Attr_InstanceIDs: {
+				Computed:    true,
+				Description: "The IDs of the instances, sorted by index.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_Instances: {
+				Computed:    true,
+				Description: "The instances of the set, sorted by index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_ID: {
+							Computed:    true,
+							Description: "The ID of the instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_Index: {
+							Computed:    true,
+							Description: "The index of the instance.",
+							Type:        schema.TypeInt,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the instance.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func resourceIBMPIInstanceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_instance_set", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	setID, err := uuid.GenerateUUID()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GenerateUUID failed: %s", err.Error()), "ibm_pi_instance_set", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	members, err := reconcileIBMPIInstanceSet(ctx, d, sess, cloudInstanceID, nil, d.Timeout(schema.TimeoutCreate))
+	if len(members) == 0 {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("failed to create the instances of the set: %s", err.Error()), "ibm_pi_instance_set", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, setID))
+	setIBMPIInstanceSetMembers(d, members)
+
+	diags := resourceIBMPIInstanceSetRead(ctx, d, meta)
+	if err != nil {
+		// A set with some instances is not tainted, the next apply creates the missing instances
+		tfWarning := flex.TerraformWarningf(err, fmt.Sprintf("Created %d of %d instances of the set: %s", len(members), d.Get(Arg_InstanceCount).(int), err.Error()), "ibm_pi_instance_set", "create").
+			WithAttribute(Arg_InstanceCount).
+			WithRemediation("Run apply again to create the missing instances.")
+		diags = append(diags, tfWarning.GetDiag()...)
+	}
+	return diags
+}
+
+func resourceIBMPIInstanceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_instance_set", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_instance_set", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	members := expandIBMPIInstanceSetMembers(d.Get(Attr_Instances).([]interface{}))
+	found := make([]instanceSetMember, 0, len(members))
+	for _, m := range members {
+		pvm, err := client.Get(m.ID)
+		if err != nil {
+			var notFound *p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound
+			if errors.As(err, &notFound) {
+				// The next plan creates the instance again
+				log.Printf("[WARN] Instance %s of the instance set %s is not found, removing it from the set", m.ID, d.Id())
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "ibm_pi_instance_set", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		if pvm.ServerName != nil {
+			m.Name = *pvm.ServerName
+		}
+		if pvm.Status != nil {
+			m.Status = *pvm.Status
+		}
+		found = append(found, m)
+	}
+	setIBMPIInstanceSetMembers(d, found)
+
+	return nil
+}
+
+func resourceIBMPIInstanceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_instance_set", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_instance_set", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// The planned instances are unknown, the instances in the state are reconciled
+	oldMembers, _ := d.GetChange(Attr_Instances)
+	members, err := reconcileIBMPIInstanceSet(ctx, d, sess, cloudInstanceID, expandIBMPIInstanceSetMembers(oldMembers.([]interface{})), d.Timeout(schema.TimeoutUpdate))
+	setIBMPIInstanceSetMembers(d, members)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("failed to update the instances of the set: %s", err.Error()), "ibm_pi_instance_set", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	return resourceIBMPIInstanceSetRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_instance_set", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_instance_set", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	members := expandIBMPIInstanceSetMembers(d.Get(Attr_Instances).([]interface{}))
+	remaining, err := deleteIBMPIInstanceSetMembers(ctx, client, members, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		setIBMPIInstanceSetMembers(d, remaining)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("failed to delete the instances of the set: %s", err.Error()), "ibm_pi_instance_set", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIInstanceSetCustomizeDiff plans new instances when the count or the name template changes,
+// or when instances of the set are missing or in the error status.
+func resourceIBMPIInstanceSetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	count := diff.Get(Arg_InstanceCount).(int)
+	members := expandIBMPIInstanceSetMembers(diff.Get(Attr_Instances).([]interface{}))
+	failed := slices.ContainsFunc(members, instanceSetMember.failed)
+	if diff.HasChange(Arg_InstanceCount) || diff.HasChange(Arg_InstanceNameTemplate) || len(members) != count || failed {
+		if err := diff.SetNewComputed(Attr_Instances); err != nil {
+			return err
+		}
+		if err := diff.SetNewComputed(Attr_InstanceIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instanceSetMember is an instance of an ibm_pi_instance_set.
+type instanceSetMember struct {
+	Index  int
+	ID     string
+	Name   string
+	Status string
+}
+
+// failed is whether the member is in the error status, either reported by the instance or set when the
+// member failed to create and could not be deleted.
+func (m instanceSetMember) failed() bool {
+	return strings.EqualFold(m.Status, State_Error)
+}
+
+func expandIBMPIInstanceSetMembers(list []interface{}) []instanceSetMember {
+	members := make([]instanceSetMember, 0, len(list))
+	for _, v := range list {
+		member := v.(map[string]interface{})
+		members = append(members, instanceSetMember{
+			Index:  member[Attr_Index].(int),
+			ID:     member[Attr_ID].(string),
+			Name:   member[Attr_Name].(string),
+			Status: member[Attr_Status].(string),
+		})
+	}
+	return members
+}
+
+func setIBMPIInstanceSetMembers(d *schema.ResourceData, members []instanceSetMember) {
+	sort.Slice(members, func(i, j int) bool { return members[i].Index < members[j].Index })
+	instances := make([]map[string]interface{}, 0, len(members))
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		instances = append(instances, map[string]interface{}{
+			Attr_ID:     m.ID,
+			Attr_Index:  m.Index,
+			Attr_Name:   m.Name,
+			Attr_Status: m.Status,
+		})
+		ids = append(ids, m.ID)
+	}
+	d.Set(Attr_Instances, instances)
+	d.Set(Attr_InstanceIDs, ids)
+}
+
+// instanceSetMemberName returns the name of the instance at index from pi_instance_name_template.
+func instanceSetMemberName(template string, index int) string {
+	return instanceSetIndexRegexp.ReplaceAllStringFunc(template, func(placeholder string) string {
+		width := 0
+		if match := instanceSetIndexRegexp.FindStringSubmatch(placeholder); match[1] != "" {
+			width, _ = strconv.Atoi(match[1])
+		}
+		return fmt.Sprintf("%0*d", width, index)
+	})
+}
+
+// splitIBMPIInstanceSetMembers returns the members that are kept in the set, and the members to delete
+// because they are above count or in the error status.
+func splitIBMPIInstanceSetMembers(members []instanceSetMember, count int) (kept, removed []instanceSetMember) {
+	kept = make([]instanceSetMember, 0, count)
+	for _, m := range members {
+		if m.Index > count || m.failed() {
+			removed = append(removed, m)
+		} else {
+			kept = append(kept, m)
+		}
+	}
+	return kept, removed
+}
+
+// reconcileIBMPIInstanceSet deletes the members above pi_instance_count and the members in the error
+// status, renames the members that do not match pi_instance_name_template, and creates the missing
+// members. A member that fails to create is deleted and created again up to pi_retry_count times. It returns the members of the set, with the
+// errors of the members it could not reconcile.
+func reconcileIBMPIInstanceSet(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID string, members []instanceSetMember, timeout time.Duration) ([]instanceSetMember, error) {
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	count := d.Get(Arg_InstanceCount).(int)
+	template := d.Get(Arg_InstanceNameTemplate).(string)
+	instanceReadyStatus := d.Get(Arg_HealthStatus).(string)
+	var errs []error
+
+	// Scale down and delete the failed members, a member that fails to delete stays in the set
+	reconciled, removed := splitIBMPIInstanceSetMembers(members, count)
+	remaining, err := deleteIBMPIInstanceSetMembers(ctx, client, removed, timeout)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// Rename
+	indexes := make(map[int]bool, count)
+	for _, m := range remaining {
+		// A failed member that is not deleted keeps its index, the next apply retries it
+		indexes[m.Index] = true
+	}
+	for i, m := range reconciled {
+		indexes[m.Index] = true
+		name := instanceSetMemberName(template, m.Index)
+		if m.Name == name {
+			continue
+		}
+		_, err := client.Update(m.ID, &models.PVMInstanceUpdate{ServerName: name})
+		if err == nil {
+			_, err = isWaitForPIInstanceAvailableOrShutoffAfterUpdate(ctx, client, m.ID, instanceReadyStatus, timeout)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to rename instance %s to %s: %w", m.ID, name, err))
+			continue
+		}
+		reconciled[i].Name = name
+	}
+
+	// Scale up
+	var missing []int
+	for i := 1; i <= count; i++ {
+		if !indexes[i] {
+			missing = append(missing, i)
+		}
+	}
+	var createErr error
+	for attempt := 0; attempt <= d.Get(Arg_RetryCount).(int) && len(missing) > 0; attempt++ {
+		var created []instanceSetMember
+		created, missing, createErr = createIBMPIInstanceSetMembers(ctx, d, sess, cloudInstanceID, missing, timeout)
+		reconciled = append(reconciled, created...)
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for _, i := range missing {
+			names = append(names, instanceSetMemberName(template, i))
+		}
+		errs = append(errs, fmt.Errorf("failed to create the instances %s: %w", strings.Join(names, ", "), createErr))
+	}
+
+	return append(reconciled, remaining...), errors.Join(errs...)
+}
+
+// createIBMPIInstanceSetMembers creates the members at indexes and waits for them to be ready. The
+// members are created together, or one at a time with a placement group. A member that fails to be
+// ready is deleted so that it can be created again, unless the delete fails too. It returns the created
+// members, and the indexes of the members that failed.
+func createIBMPIInstanceSetMembers(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID string, indexes []int, timeout time.Duration) ([]instanceSetMember, []int, error) {
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	sapClient := instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
+	imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	template := d.Get(Arg_InstanceNameTemplate).(string)
+	_, sequential := d.GetOk(Arg_PlacementGroupID)
+
+	var created []instanceSetMember
+	var failed []int
+	var errs []error
+	wait := func(m instanceSetMember) {
+		var pvm interface{}
+		var err error
+		if d.Get(Arg_DeploymentType).(string) == DeploymentTypeVMNoStorage {
+			pvm, err = isWaitForPIInstanceShutoff(ctx, client, m.ID, d.Get(Arg_HealthStatus).(string), timeout)
+		} else {
+			pvm, err = isWaitForPIInstanceAvailable(ctx, client, m.ID, d.Get(Arg_HealthStatus).(string), timeout)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("instance %s: %w", m.Name, err))
+			if _, err := deleteIBMPIInstanceSetMembers(ctx, client, []instanceSetMember{m}, timeout); err != nil {
+				// The instance is kept in the set with its status, so that it is not orphaned
+				errs = append(errs, err)
+				m.Status = State_Error
+				created = append(created, m)
+				return
+			}
+			failed = append(failed, m.Index)
+			return
+		}
+		if p, ok := pvm.(*models.PVMInstance); ok && p.Status != nil {
+			m.Status = *p.Status
+		}
+		created = append(created, m)
+	}
+
+	var pending []instanceSetMember
+	for _, i := range indexes {
+		name := instanceSetMemberName(template, i)
+		var pvmList *models.PVMInstanceList
+		var err error
+		if _, ok := d.GetOk(Arg_SAPProfileID); ok {
+			pvmList, err = createSAPInstance(d, sapClient, name)
+		} else {
+			pvmList, err = createPVMInstance(d, client, imageClient, name)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("instance %s: %w", name, err))
+			failed = append(failed, i)
+			continue
+		}
+		for _, pvm := range *pvmList {
+			m := instanceSetMember{Index: i, ID: *pvm.PvmInstanceID, Name: name}
+			if sequential {
+				wait(m)
+			} else {
+				pending = append(pending, m)
+			}
+		}
+	}
+	for _, m := range pending {
+		wait(m)
+	}
+
+	return created, failed, errors.Join(errs...)
+}
+
+// deleteIBMPIInstanceSetMembers deletes the members and waits for them to be deleted. It returns the
+// members that failed to delete.
+func deleteIBMPIInstanceSetMembers(ctx context.Context, client *instance.IBMPIInstanceClient, members []instanceSetMember, timeout time.Duration) ([]instanceSetMember, error) {
+	var remaining, deleting []instanceSetMember
+	var errs []error
+	for _, m := range members {
+		if err := client.Delete(m.ID); err != nil {
+			var notFound *p_cloud_p_vm_instances.PcloudPvminstancesDeleteNotFound
+			if !errors.As(err, &notFound) {
+				errs = append(errs, fmt.Errorf("failed to delete instance %s: %w", m.ID, err))
+				remaining = append(remaining, m)
+			}
+			continue
+		}
+		deleting = append(deleting, m)
+	}
+	for _, m := range deleting {
+		if _, err := isWaitForPIInstanceDeleted(ctx, client, m.ID, timeout); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete instance %s: %w", m.ID, err))
+			remaining = append(remaining, m)
+		}
+	}
+	return remaining, errors.Join(errs...)
+}
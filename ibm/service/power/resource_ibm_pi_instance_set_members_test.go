@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceSetMemberName(t *testing.T) {
+	testcases := []struct {
+		description string
+		template    string
+		index       int
+		expected    string
+	}{
+		{
+			description: "When the template has an {index} placeholder, Expect the index without padding",
+			template:    "web-{index}",
+			index:       7,
+			expected:    "web-7",
+		},
+		{
+			description: "When the template has an {index:N} placeholder, Expect the index padded to N digits",
+			template:    "web-{index:3}",
+			index:       7,
+			expected:    "web-007",
+		},
+		{
+			description: "When the index is wider than N, Expect the index not to be truncated",
+			template:    "web-{index:2}",
+			index:       123,
+			expected:    "web-123",
+		},
+		{
+			description: "When the template has several placeholders, Expect each to be replaced",
+			template:    "{index}-web-{index:2}",
+			index:       4,
+			expected:    "4-web-04",
+		},
+		{
+			description: "When the template has no placeholder, Expect the template",
+			template:    "web",
+			index:       1,
+			expected:    "web",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			require.Equal(t, tc.expected, instanceSetMemberName(tc.template, tc.index))
+		})
+	}
+}
+
+func TestSplitIBMPIInstanceSetMembers(t *testing.T) {
+	members := []instanceSetMember{
+		{Index: 1, ID: "a", Status: State_Active},
+		{Index: 2, ID: "b", Status: State_ERROR},
+		{Index: 3, ID: "c", Status: State_Shutoff},
+		{Index: 4, ID: "d", Status: State_Error},
+	}
+	testcases := []struct {
+		description string
+		count       int
+		kept        []string
+		removed     []string
+	}{
+		{
+			description: "When the count is unchanged, Expect only the members in the error status to be removed",
+			count:       4,
+			kept:        []string{"a", "c"},
+			removed:     []string{"b", "d"},
+		},
+		{
+			description: "When the count decreases, Expect the members above the count to be removed",
+			count:       1,
+			kept:        []string{"a"},
+			removed:     []string{"b", "c", "d"},
+		},
+		{
+			description: "When the count increases, Expect the members in the error status to be removed",
+			count:       6,
+			kept:        []string{"a", "c"},
+			removed:     []string{"b", "d"},
+		},
+		{
+			description: "When a member above the count is in the error status, Expect it to be removed once",
+			count:       3,
+			kept:        []string{"a", "c"},
+			removed:     []string{"b", "d"},
+		},
+	}
+	ids := func(members []instanceSetMember) []string {
+		var ids []string
+		for _, m := range members {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			kept, removed := splitIBMPIInstanceSetMembers(members, tc.count)
+			require.Equal(t, tc.kept, ids(kept))
+			require.Equal(t, tc.removed, ids(removed))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+)
+
+func TestAccIBMPIInstanceSetBasic(t *testing.T) {
+	instanceSetRes := "ibm_pi_instance_set.power_instance_set"
+	name := fmt.Sprintf("tf-pi-instance-set-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceSetConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceSetExists(instanceSetRes),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.#", "2"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instance_ids.#", "2"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.0.index", "1"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.0.name", name+"-01"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.1.name", name+"-02"),
+				),
+			},
+			{
+				// Scaling up keeps the existing instances
+				Config: testAccCheckIBMPIInstanceSetConfig(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceSetExists(instanceSetRes),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.#", "3"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.2.index", "3"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.2.name", name+"-03"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceSetConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceSetExists(instanceSetRes),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.#", "1"),
+					resource.TestCheckResourceAttr(instanceSetRes, "instances.0.name", name+"-01"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceSetConfig(name string, count int) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance_set" "power_instance_set" {
+		pi_cloud_instance_id      = "%[1]s"
+		pi_image_id               = data.ibm_pi_image.power_image.id
+		pi_instance_count         = %[5]d
+		pi_instance_name_template = "%[2]s-{index:2}"
+		pi_memory                 = 2
+		pi_proc_type              = "shared"
+		pi_processors             = 0.25
+		pi_storage_type           = "%[6]s"
+		pi_sys_type               = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, count, acc.PiStorageType)
+}
+
+func testAccCheckIBMPIInstanceSetDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_instance_set" {
+			continue
+		}
+
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_cloud_instance_id"])
+		count, _ := strconv.Atoi(rs.Primary.Attributes["instance_ids.#"])
+		for i := 0; i < count; i++ {
+			instanceID := rs.Primary.Attributes[fmt.Sprintf("instance_ids.%d", i)]
+			if _, err := client.Get(instanceID); err == nil {
+				return fmt.Errorf("PI Instance %s of set %s still exists", instanceID, rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIInstanceSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_cloud_instance_id"])
+		count, _ := strconv.Atoi(rs.Primary.Attributes["instance_ids.#"])
+		for i := 0; i < count; i++ {
+			if _, err := client.Get(rs.Primary.Attributes[fmt.Sprintf("instance_ids.%d", i)]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -19,8 +19,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIInstanceSnapshotIdentity is the identity of ibm_pi_instance_snapshot, which can be used to import it.
+var ibmPIInstanceSnapshotIdentity = powerResourceIdentity("The unique identifier of the snapshot.")
+
 func ResourceIBMPIInstanceSnapshot() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIInstanceSnapshotCreate,
 		ReadContext:   resourceIBMPIInstanceSnapshotRead,
 		UpdateContext: resourceIBMPIInstanceSnapshotUpdate,
@@ -112,7 +115,7 @@ func ResourceIBMPIInstanceSnapshot() *schema.Resource {
 				Type:        schema.TypeMap,
 			},
 		},
-	}
+	}, ibmPIInstanceSnapshotIdentity)
 }
 
 func resourceIBMPIInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -696,6 +697,66 @@ func TestAccIBMPIInstanceUpdateStoppedState(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceResizePolicy(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceResizePolicyConfig(name, power.OK, "2", power.FailIfRebootRequired),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_resize_policy", power.FailIfRebootRequired),
+					resource.TestCheckResourceAttr(instanceRes, "reboot_required", "false"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIInstanceResizePolicyConfig(name, power.OK, "128", power.FailIfRebootRequired),
+				ExpectError: regexp.MustCompile("require the lpar to be stopped"),
+			},
+			{
+				Config:             testAccCheckIBMPIInstanceResizePolicyConfig(name, power.OK, "128", power.Defer),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceStatus(instanceRes, strings.ToUpper(power.State_Active)),
+					resource.TestCheckResourceAttr(instanceRes, "pi_memory", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceResizePolicyConfig(name, instanceHealthStatus, memory, resizePolicy string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_health_status     = "%[5]s"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_instance_name     = "%[2]s"
+		pi_memory            = "%[6]s"
+		pi_proc_type         = "shared"
+		pi_processors        = "0.25"
+		pi_resize_policy     = "%[7]s"
+		pi_storage_pool      = data.ibm_pi_image.power_image.storage_pool
+		pi_sys_type          = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, instanceHealthStatus, memory, resizePolicy)
+}
+
 func testAccCheckIBMPIActiveInstanceConfigUpdate(name, instanceHealthStatus, proc, memory string) string {
 	return fmt.Sprintf(`
 	data "ibm_pi_image" "power_image" {
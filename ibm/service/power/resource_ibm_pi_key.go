@@ -20,8 +20,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIKeyIdentity is the identity of ibm_pi_key, which can be used to import it.
+var ibmPIKeyIdentity = powerResourceIdentity("The unique identifier of the SSH key.")
+
 func ResourceIBMPIKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v any) error {
 				return customizeNameAndSSHKeyPIKeyDiff(diff)
@@ -101,7 +104,7 @@ func ResourceIBMPIKey() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPIKeyIdentity)
 }
 
 func resourceIBMPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
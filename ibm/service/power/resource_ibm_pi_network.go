@@ -28,8 +28,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPINetworkIdentity is the identity of ibm_pi_network, which can be used to import it.
+var ibmPINetworkIdentity = powerResourceIdentity("The unique identifier of the network.")
+
 func ResourceIBMPINetwork() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPINetworkCreate,
 		ReadContext:   resourceIBMPINetworkRead,
 		UpdateContext: resourceIBMPINetworkUpdate,
@@ -219,7 +222,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Type:        schema.TypeFloat,
 			},
 		},
-	}
+	}, ibmPINetworkIdentity)
 }
 
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
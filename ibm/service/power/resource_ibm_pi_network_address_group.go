@@ -22,8 +22,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPINetworkAddressGroupIdentity is the identity of ibm_pi_network_address_group, which can be used to import it.
+var ibmPINetworkAddressGroupIdentity = powerResourceIdentity("The unique identifier of the network address group.")
+
 func ResourceIBMPINetworkAddressGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPINetworkAddressGroupCreate,
 		ReadContext:   resourceIBMPINetworkAddressGroupRead,
 		UpdateContext: resourceIBMPINetworkAddressGroupUpdate,
@@ -93,7 +96,7 @@ func ResourceIBMPINetworkAddressGroup() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPINetworkAddressGroupIdentity)
 }
 
 func resourceIBMPINetworkAddressGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -22,8 +22,11 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
+// ibmPINetworkPeerIdentity is the identity of ibm_pi_network_peer, which can be used to import it.
+var ibmPINetworkPeerIdentity = powerResourceIdentity("The unique identifier of the network peer.")
+
 func ResourceIBMPINetworkPeer() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPINetworkPeerCreate,
 		ReadContext:   resourceIBMPINetworkPeerRead,
 		UpdateContext: resourceIBMPINetworkPeerUpdate,
@@ -137,7 +140,7 @@ func ResourceIBMPINetworkPeer() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPINetworkPeerIdentity)
 }
 
 func resourceIBMPINetworkPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
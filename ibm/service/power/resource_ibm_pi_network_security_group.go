@@ -23,8 +23,11 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/models"
 )
 
+// ibmPINetworkSecurityGroupIdentity is the identity of ibm_pi_network_security_group, which can be used to import it.
+var ibmPINetworkSecurityGroupIdentity = powerResourceIdentity("The unique identifier of the network security group.")
+
 func ResourceIBMPINetworkSecurityGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPINetworkSecurityGroupCreate,
 		ReadContext:   resourceIBMPINetworkSecurityGroupRead,
 		UpdateContext: resourceIBMPINetworkSecurityGroupUpdate,
@@ -222,7 +225,7 @@ func ResourceIBMPINetworkSecurityGroup() *schema.Resource {
 				Type: schema.TypeList,
 			},
 		},
-	}
+	}, ibmPINetworkSecurityGroupIdentity)
 }
 
 func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
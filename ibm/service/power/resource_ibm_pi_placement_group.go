@@ -22,8 +22,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIPlacementGroupIdentity is the identity of ibm_pi_placement_group, which can be used to import it.
+var ibmPIPlacementGroupIdentity = powerResourceIdentity("The unique identifier of the placement group.")
+
 func ResourceIBMPIPlacementGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIPlacementGroupCreate,
 		ReadContext:   resourceIBMPIPlacementGroupRead,
 		UpdateContext: resourceIBMPIPlacementGroupUpdate,
@@ -91,7 +94,7 @@ func ResourceIBMPIPlacementGroup() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPIPlacementGroupIdentity)
 }
 
 func resourceIBMPIPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -22,8 +22,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIRouteIdentity is the identity of ibm_pi_route, which can be used to import it.
+var ibmPIRouteIdentity = powerResourceIdentity("The unique identifier of the route.")
+
 func ResourceIBMPIRoute() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIRouteCreate,
 		ReadContext:   resourceIBMPIRouteRead,
 		UpdateContext: resourceIBMPIRouteUpdate,
@@ -124,7 +127,7 @@ func ResourceIBMPIRoute() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPIRouteIdentity)
 }
 
 func resourceIBMPIRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
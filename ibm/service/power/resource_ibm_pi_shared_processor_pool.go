@@ -21,8 +21,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ibmPISharedProcessorPoolIdentity is the identity of ibm_pi_shared_processor_pool, which can be used to import it.
+var ibmPISharedProcessorPoolIdentity = powerResourceIdentity("The unique identifier of the shared processor pool.")
+
 func ResourceIBMPISharedProcessorPool() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPISharedProcessorPoolCreate,
 		ReadContext:   resourceIBMPISharedProcessorPoolRead,
 		UpdateContext: resourceIBMPISharedProcessorPoolUpdate,
@@ -196,7 +199,7 @@ func ResourceIBMPISharedProcessorPool() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPISharedProcessorPoolIdentity)
 }
 
 func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
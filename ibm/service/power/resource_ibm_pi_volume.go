@@ -24,8 +24,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIVolumeIdentity is the identity of ibm_pi_volume, which can be used to import it.
+var ibmPIVolumeIdentity = powerResourceIdentity("The unique identifier of the volume.")
+
 func ResourceIBMPIVolume() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIVolumeCreate,
 		ReadContext:   resourceIBMPIVolumeRead,
 		UpdateContext: resourceIBMPIVolumeUpdate,
@@ -232,7 +235,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPIVolumeIdentity)
 }
 
 func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
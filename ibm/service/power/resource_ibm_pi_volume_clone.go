@@ -239,6 +239,9 @@ func isIBMPIVolumeCloneRefreshFunc(client *instance.IBMPICloneVolumeClient, id s
 		if *volClone.Status == State_Completed {
 			return volClone, State_Completed, nil
 		}
+		if *volClone.Status == State_Failed {
+			return volClone, State_Failed, fmt.Errorf("volume clone task %s failed: %s", id, volClone.FailedReason)
+		}
 
 		return volClone, State_Creating, nil
 	}
@@ -21,8 +21,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// ibmPIVolumeGroupIdentity is the identity of ibm_pi_volume_group, which can be used to import it.
+var ibmPIVolumeGroupIdentity = powerResourceIdentity("The unique identifier of the volume group.")
+
 func ResourceIBMPIVolumeGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIVolumeGroupCreate,
 		ReadContext:   resourceIBMPIVolumeGroupRead,
 		UpdateContext: resourceIBMPIVolumeGroupUpdate,
@@ -116,7 +119,7 @@ func ResourceIBMPIVolumeGroup() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, ibmPIVolumeGroupIdentity)
 }
 
 func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
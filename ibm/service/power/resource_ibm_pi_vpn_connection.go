@@ -165,7 +165,7 @@ func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceDat
 		jobID := *vpnConnection.JobRef.ID
 		jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
 
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, jobID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -227,7 +227,7 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -239,7 +239,7 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -354,7 +354,7 @@ func resourceIBMPIVPNConnectionDelete(ctx context.Context, d *schema.ResourceDat
 	}
 	if jobRef != nil {
 		jobID := *jobRef.ID
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, meta, jobID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
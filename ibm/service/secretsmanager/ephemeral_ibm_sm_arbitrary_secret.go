@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmArbitrarySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    ArbitrarySecretResourceName,
+		secretType:  ArbitrarySecretType,
+		description: "Reads the payload of an arbitrary secret without storing it in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The arbitrary secret's data payload.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			arbitrarySecret, ok := secret.(*secretsmanagerv2.ArbitrarySecret)
+			if !ok {
+				return nil, false
+			}
+			return &smSecretEphemeralData{
+				ID:            arbitrarySecret.ID,
+				Name:          arbitrarySecret.Name,
+				Crn:           arbitrarySecret.Crn,
+				SecretGroupID: arbitrarySecret.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"payload": types.StringPointerValue(arbitrarySecret.Payload),
+				},
+			}, true
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmIamCredentialsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    IAMCredentialsSecretResourceName,
+		secretType:  IAMCredentialsSecretType,
+		description: "Reads the API key of an IAM credentials secret without storing it in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			iAMCredentialsSecret, ok := secret.(*secretsmanagerv2.IAMCredentialsSecret)
+			if !ok {
+				return nil, false
+			}
+			return &smSecretEphemeralData{
+				ID:            iAMCredentialsSecret.ID,
+				Name:          iAMCredentialsSecret.Name,
+				Crn:           iAMCredentialsSecret.Crn,
+				SecretGroupID: iAMCredentialsSecret.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"api_key":    types.StringPointerValue(iAMCredentialsSecret.ApiKey),
+					"api_key_id": types.StringPointerValue(iAMCredentialsSecret.ApiKeyID),
+					"service_id": types.StringPointerValue(iAMCredentialsSecret.ServiceID),
+				},
+			}, true
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmIamCredentialsSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmIamCredentialsSecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.data_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmIamCredentialsSecretEphemeralResourceConfigBasic() string {
+	return iamCredentialsSecretConfigBasic() + fmt.Sprintf(`
+		ephemeral "ibm_sm_iam_credentials_secret" "sm_iam_credentials_secret" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_iam_credentials_secret.sm_iam_credentials_secret_basic.secret_id
+		}
+
+		resource "terraform_data" "data_check" {
+			lifecycle {
+				precondition {
+					condition     = length(ephemeral.ibm_sm_iam_credentials_secret.sm_iam_credentials_secret.api_key) > 0
+					error_message = "Expected the ephemeral IAM credentials secret to return the API key."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmImportedCertificateEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmImportedCertificateEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.data_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmImportedCertificateEphemeralResourceConfigBasic() string {
+	return importedCertificateConfigBasic() + fmt.Sprintf(`
+		ephemeral "ibm_sm_imported_certificate" "sm_imported_certificate" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_imported_certificate.sm_imported_certificate_basic.secret_id
+		}
+
+		resource "terraform_data" "data_check" {
+			lifecycle {
+				precondition {
+					condition     = startswith(ephemeral.ibm_sm_imported_certificate.sm_imported_certificate.certificate, "-----BEGIN CERTIFICATE-----")
+					error_message = "Expected the ephemeral imported certificate to return the certificate."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmKvSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    KvSecretResourceName,
+		secretType:  KvSecretType,
+		description: "Reads the data of a key-value secret without storing it in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"data": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			kVSecret, ok := secret.(*secretsmanagerv2.KVSecret)
+			if !ok {
+				return nil, false
+			}
+			data, _ := types.MapValueFrom(ctx, types.StringType, map[string]string(flex.Flatten(kVSecret.Data)))
+			return &smSecretEphemeralData{
+				ID:            kVSecret.ID,
+				Name:          kVSecret.Name,
+				Crn:           kVSecret.Crn,
+				SecretGroupID: kVSecret.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"data": data,
+				},
+			}, true
+		},
+	}
+}
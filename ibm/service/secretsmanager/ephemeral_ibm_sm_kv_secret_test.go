@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmKvSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.data_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmKvSecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_kv_secret" "sm_kv_secret_instance" {
+			  instance_id   = "%s"
+       		  region        = "%s"
+  			  data = {"key":"value"}
+  			  secret_group_id = "default"
+			  name = "kv-secret-ephemeral-terraform-test"
+		}
+
+		ephemeral "ibm_sm_kv_secret" "sm_kv_secret" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_kv_secret.sm_kv_secret_instance.secret_id
+		}
+
+		resource "terraform_data" "data_check" {
+			lifecycle {
+				precondition {
+					condition     = ephemeral.ibm_sm_kv_secret.sm_kv_secret.data["key"] == "value"
+					error_message = "Expected the ephemeral key-value secret to return the data."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmPrivateCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    PrivateCertSecretResourceName,
+		secretType:  PrivateCertSecretType,
+		description: "Reads the certificate and private key of a private certificate without storing them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded contents of your certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key that is associated with the certificate.",
+			},
+			"issuing_ca": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded certificate of the certificate authority that signed and issued this certificate.",
+			},
+			"ca_chain": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The chain of certificate authorities that are associated with the certificate.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			privateCertificate, ok := secret.(*secretsmanagerv2.PrivateCertificate)
+			if !ok {
+				return nil, false
+			}
+			caChain, _ := types.ListValueFrom(ctx, types.StringType, privateCertificate.CaChain)
+			return &smSecretEphemeralData{
+				ID:            privateCertificate.ID,
+				Name:          privateCertificate.Name,
+				Crn:           privateCertificate.Crn,
+				SecretGroupID: privateCertificate.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"certificate": types.StringPointerValue(privateCertificate.Certificate),
+					"private_key": types.StringPointerValue(privateCertificate.PrivateKey),
+					"issuing_ca":  types.StringPointerValue(privateCertificate.IssuingCa),
+					"ca_chain":    caChain,
+				},
+			}, true
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmPrivateCertificateEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.data_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPrivateCertificateEphemeralResourceConfigBasic() string {
+	return privateCertificateConfigBasic() + fmt.Sprintf(`
+		ephemeral "ibm_sm_private_certificate" "sm_private_certificate" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_private_certificate.sm_private_certificate_basic.secret_id
+		}
+
+		resource "terraform_data" "data_check" {
+			lifecycle {
+				precondition {
+					condition     = startswith(ephemeral.ibm_sm_private_certificate.sm_private_certificate.certificate, "-----BEGIN CERTIFICATE-----") && length(ephemeral.ibm_sm_private_certificate.sm_private_certificate.private_key) > 0
+					error_message = "Expected the ephemeral private certificate to return the certificate and the private key."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
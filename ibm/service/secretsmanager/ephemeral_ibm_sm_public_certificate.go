@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmPublicCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    PublicCertSecretResourceName,
+		secretType:  PublicCertSecretType,
+		description: "Reads the certificate and private key of a public certificate without storing them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded contents of your certificate.",
+			},
+			"intermediate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded intermediate certificate that is associated with the root certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key that is associated with the certificate.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			publicCertificate, ok := secret.(*secretsmanagerv2.PublicCertificate)
+			if !ok {
+				return nil, false
+			}
+			return &smSecretEphemeralData{
+				ID:            publicCertificate.ID,
+				Name:          publicCertificate.Name,
+				Crn:           publicCertificate.Crn,
+				SecretGroupID: publicCertificate.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"certificate":  types.StringPointerValue(publicCertificate.Certificate),
+					"intermediate": types.StringPointerValue(publicCertificate.Intermediate),
+					"private_key":  types.StringPointerValue(publicCertificate.PrivateKey),
+				},
+			}, true
+		},
+	}
+}
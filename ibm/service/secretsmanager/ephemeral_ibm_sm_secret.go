@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smSecretEphemeralResource{}
+)
+
+// smSecretEphemeralResource is the shared implementation of the Secrets Manager ephemeral resources.
+// Each secret type provides its payload attributes and a function that extracts them from the secret.
+type smSecretEphemeralResource struct {
+	typeName          string
+	secretType        string
+	description       string
+	payloadAttributes map[string]schema.Attribute
+	flattenSecret     func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool)
+
+	clientSession conns.ClientSession
+}
+
+// smSecretEphemeralData holds the values of a secret that are returned by the ephemeral resources
+type smSecretEphemeralData struct {
+	ID            *string
+	Name          *string
+	Crn           *string
+	SecretGroupID *string
+	Payload       map[string]attr.Value
+}
+
+func (r *smSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *smSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "public or private.",
+		},
+		"secret_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of the secret group that contains the secret. Required when the secret is looked up by name.",
+		},
+		"secret_group_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "A UUID identifier, or `default` secret group.",
+		},
+		"crn": schema.StringAttribute{
+			Computed:    true,
+			Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+		},
+	}
+	for name, attribute := range r.payloadAttributes {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes:  attributes,
+	}
+}
+
+func (r *smSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var secretId, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() || secretId.IsUnknown() || name.IsUnknown() || groupName.IsUnknown() {
+		return
+	}
+
+	if secretId.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			"Exactly one of \"secret_id\" or \"name\" must be specified.",
+		)
+		return
+	}
+	if !name.IsNull() && groupName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_group_name"),
+			"Missing Required Attribute",
+			"\"secret_group_name\" must be specified when the secret is looked up by \"name\".",
+		)
+	}
+}
+
+func (r *smSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clientSession = session
+}
+
+func (r *smSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var instanceId, region, endpointType, secretId, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("instance_id"), &instanceId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_type"), &endpointType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup := secretLookup{
+		InstanceId:      instanceId.ValueString(),
+		Region:          region.ValueString(),
+		EndpointType:    endpointType.ValueString(),
+		SecretId:        secretId.ValueString(),
+		Name:            name.ValueString(),
+		SecretGroupName: groupName.ValueString(),
+	}
+	secret, secretRegion, tfErr := getSecretByLookup(ctx, r.clientSession, lookup, r.secretType, fmt.Sprintf("(Ephemeral) %s", r.typeName))
+	if tfErr != nil {
+		resp.Diagnostics.AddError("Unable to Read Secret", tfErr.GetConsoleMessage())
+		return
+	}
+
+	data, ok := r.flattenSecret(ctx, secret)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Wrong Secret Type",
+			fmt.Sprintf("The provided secret is not a secret of type %s.", r.secretType),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("region"), secretRegion)...)
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("secret_id"), data.ID)...)
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("name"), data.Name)...)
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("secret_group_id"), data.SecretGroupID)...)
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("crn"), data.Crn)...)
+	for attributeName, value := range data.Payload {
+		resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root(attributeName), value)...)
+	}
+}
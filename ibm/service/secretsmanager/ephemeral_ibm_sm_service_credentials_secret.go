@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmServiceCredentialsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    ServiceCredentialsSecretResourceName,
+		secretType:  ServiceCredentialsSecretType,
+		description: "Reads the credentials of a service credentials secret without storing them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"credentials": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The properties of the service credentials secret payload.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			serviceCredentialsSecret, ok := secret.(*secretsmanagerv2.ServiceCredentialsSecret)
+			if !ok {
+				return nil, false
+			}
+			var credInterface map[string]interface{}
+			if serviceCredentialsSecret.Credentials != nil {
+				cred, _ := json.Marshal(serviceCredentialsSecret.Credentials)
+				json.Unmarshal(cred, &credInterface)
+			}
+			credentials, _ := types.MapValueFrom(ctx, types.StringType, map[string]string(flex.Flatten(credInterface)))
+			return &smSecretEphemeralData{
+				ID:            serviceCredentialsSecret.ID,
+				Name:          serviceCredentialsSecret.Name,
+				Crn:           serviceCredentialsSecret.Crn,
+				SecretGroupID: serviceCredentialsSecret.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"credentials": credentials,
+				},
+			}, true
+		},
+	}
+}
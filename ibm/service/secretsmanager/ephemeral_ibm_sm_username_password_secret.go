@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewIbmSmUsernamePasswordSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    UsernamePasswordSecretResourceName,
+		secretType:  UsernamePasswordSecretType,
+		description: "Reads the credentials of a user credentials secret without storing them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username that is assigned to the secret.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password that is assigned to the secret.",
+			},
+		},
+		flattenSecret: func(ctx context.Context, secret secretsmanagerv2.SecretIntf) (*smSecretEphemeralData, bool) {
+			usernamePasswordSecret, ok := secret.(*secretsmanagerv2.UsernamePasswordSecret)
+			if !ok {
+				return nil, false
+			}
+			return &smSecretEphemeralData{
+				ID:            usernamePasswordSecret.ID,
+				Name:          usernamePasswordSecret.Name,
+				Crn:           usernamePasswordSecret.Crn,
+				SecretGroupID: usernamePasswordSecret.SecretGroupID,
+				Payload: map[string]attr.Value{
+					"username": types.StringPointerValue(usernamePasswordSecret.Username),
+					"password": types.StringPointerValue(usernamePasswordSecret.Password),
+				},
+			}, true
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmUsernamePasswordSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.credentials_check", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_group_id = "default"
+			username = "username"
+			password = "password"
+			name = "username_password-ephemeral-terraform-test"
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_username_password_secret.sm_username_password_secret_instance.name
+			secret_group_name = "default"
+		}
+
+		resource "terraform_data" "credentials_check" {
+			lifecycle {
+				precondition {
+					condition     = ephemeral.ibm_sm_username_password_secret.sm_username_password_secret.username == "username" && ephemeral.ibm_sm_username_password_secret.sm_username_password_secret.password == "password"
+					error_message = "Expected the ephemeral user credentials secret to return the credentials."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -21,8 +22,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmArbitrarySecretIdentity is the identity of ibm_sm_arbitrary_secret, which can be used to import it.
+var ibmSmArbitrarySecretIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmArbitrarySecret() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmArbitrarySecretCreate,
 		ReadContext:   resourceIbmSmArbitrarySecretRead,
 		UpdateContext: resourceIbmSmArbitrarySecretUpdate,
@@ -41,10 +45,26 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 			"payload": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				Description:  "The arbitrary secret data payload.",
+			},
+			"payload_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"payload_wo_version"},
+				Description:  "The arbitrary secret data payload as a write-only argument. The value is sent to Secrets Manager but never stored in the Terraform plan or state.",
+			},
+			"payload_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"payload_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of the write-only payload. Change this value to create a new secret version from payload_wo.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -141,7 +161,7 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The number of versions of the secret.",
 			},
 		},
-	}
+	}, ibmSmArbitrarySecretIdentity)
 }
 
 func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -293,9 +313,12 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting expiration_date"), ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("payload", secret.Payload); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
-		return tfErr.GetDiag()
+	// A payload provided through payload_wo must never be written to the state
+	if _, ok := d.GetOk("payload_wo_version"); !ok {
+		if err = d.Set("payload", secret.Payload); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	// Call get version metadata API to get the current version_custom_metadata
@@ -376,7 +399,9 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 			patchVals.ExpirationDate = &parseToDateTime
 			hasChange = true
 		} else {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf(`The "expiration_date" field cannot be removed. To disable expiration set expiration date to a far future date'`), ArbitrarySecretResourceName, "update")
+			tfErr := flex.TerraformErrorf(nil, `The "expiration_date" field cannot be removed`, ArbitrarySecretResourceName, "update").
+				WithAttribute("expiration_date").
+				WithRemediation("To disable expiration, set expiration_date to a far future date.")
 			return tfErr.GetDiag()
 		}
 	}
@@ -393,9 +418,9 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("payload") {
+	if payload, ok := flex.GetUpdatedSecretString(d, "payload", "payload_wo"); ok {
 		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{}
-		versionModel.Payload = core.StringPtr(d.Get("payload").(string))
+		versionModel.Payload = core.StringPtr(payload)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -481,6 +506,9 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("payload"); ok {
 		model.Payload = core.StringPtr(d.Get("payload").(string))
 	}
+	if payload, ok := flex.GetWriteOnlyString(d, "payload_wo"); ok {
+		model.Payload = core.StringPtr(payload)
+	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
 	}
@@ -88,6 +88,45 @@ func TestAccIbmSmArbitrarySecretAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmArbitrarySecretWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_arbitrary_secret.sm_arbitrary_secret_wo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: arbitrarySecretConfigWriteOnly(payload, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "payload"),
+					resource.TestCheckNoResourceAttr(resourceName, "payload_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+				),
+			},
+			{
+				Config: arbitrarySecretConfigWriteOnly(modifiedPayload, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "payload"),
+					resource.TestCheckNoResourceAttr(resourceName, "payload_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+			{
+				// Removing the write-only pair only creates a version for the new payload
+				Config: arbitrarySecretConfigPlainPayload(modifiedPayload),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload", modifiedPayload),
+					resource.TestCheckNoResourceAttr(resourceName, "payload_wo_version"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "3"),
+				),
+			},
+		},
+	})
+}
+
 var arbitrarySecretBasicConfigFormat = `
 		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_basic" {
 			instance_id   = "%s"
@@ -109,6 +148,30 @@ var arbitrarySecretFullConfigFormat = `
 			secret_group_id = "default"
 		}`
 
+var arbitrarySecretWriteOnlyConfigFormat = `
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_wo" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+  			payload_wo = "%s"
+  			payload_wo_version = %d
+		}`
+
+func arbitrarySecretConfigWriteOnly(payloadValue string, payloadVersion int) string {
+	return fmt.Sprintf(arbitrarySecretWriteOnlyConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		arbitrarySecretName, payloadValue, payloadVersion)
+}
+
+func arbitrarySecretConfigPlainPayload(payloadValue string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_wo" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+  			payload = "%s"
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, arbitrarySecretName, payloadValue)
+}
+
 func arbitrarySecretConfigBasic() string {
 	return fmt.Sprintf(arbitrarySecretBasicConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		arbitrarySecretName, payload)
@@ -18,8 +18,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmCustomCredentialsConfigurationIdentity is the identity of ibm_sm_custom_credentials_configuration, which can be used to import it.
+var ibmSmCustomCredentialsConfigurationIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmCustomCredentialsConfiguration() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmCustomCredentialsConfigurationCreate,
 		ReadContext:   resourceIbmSmCustomCredentialsConfigurationRead,
 		UpdateContext: resourceIbmSmCustomCredentialsConfigurationUpdate,
@@ -143,7 +146,7 @@ func ResourceIbmSmCustomCredentialsConfiguration() *schema.Resource {
 				Description: "The date when the configuration was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, ibmSmCustomCredentialsConfigurationIdentity)
 }
 
 func resourceIbmSmCustomCredentialsConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
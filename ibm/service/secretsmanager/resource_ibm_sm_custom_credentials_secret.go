@@ -22,8 +22,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmCustomCredentialsSecretIdentity is the identity of ibm_sm_custom_credentials_secret, which can be used to import it.
+var ibmSmCustomCredentialsSecretIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmCustomCredentialsSecret() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmCustomCredentialsSecretCreate,
 		ReadContext:   resourceIbmSmCustomCredentialsSecretRead,
 		UpdateContext: resourceIbmSmCustomCredentialsSecretUpdate,
@@ -241,7 +244,7 @@ func ResourceIbmSmCustomCredentialsSecret() *schema.Resource {
 				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
 			},
 		},
-	}
+	}, ibmSmCustomCredentialsSecretIdentity)
 }
 
 func resourceIbmSmCustomCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -18,8 +18,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmIamCredentialsConfigurationIdentity is the identity of ibm_sm_iam_credentials_configuration, which can be used to import it.
+var ibmSmIamCredentialsConfigurationIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmIamCredentialsConfiguration() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmIamCredentialsConfigurationCreate,
 		ReadContext:   resourceIbmSmIamCredentialsConfigurationRead,
 		UpdateContext: resourceIbmSmIamCredentialsConfigurationUpdate,
@@ -71,7 +74,7 @@ func ResourceIbmSmIamCredentialsConfiguration() *schema.Resource {
 				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, ibmSmIamCredentialsConfigurationIdentity)
 }
 
 func resourceIbmSmIamCredentialsConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
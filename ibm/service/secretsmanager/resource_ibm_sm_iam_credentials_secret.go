@@ -17,8 +17,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmIamCredentialsSecretIdentity is the identity of ibm_sm_iam_credentials_secret, which can be used to import it.
+var ibmSmIamCredentialsSecretIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmIamCredentialsSecretCreate,
 		ReadContext:   resourceIbmSmIamCredentialsSecretRead,
 		UpdateContext: resourceIbmSmIamCredentialsSecretUpdate,
@@ -214,7 +217,7 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 				Description: "The API key that is generated for this secret.After the secret reaches the end of its lease (see the `ttl` field), the API key is deleted automatically.",
 			},
 		},
-	}
+	}, ibmSmIamCredentialsSecretIdentity)
 }
 
 func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -17,8 +17,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmImportedCertificateIdentity is the identity of ibm_sm_imported_certificate, which can be used to import it.
+var ibmSmImportedCertificateIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmImportedCertificate() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmImportedCertificateCreate,
 		ReadContext:   resourceIbmSmImportedCertificateRead,
 		UpdateContext: resourceIbmSmImportedCertificateUpdate,
@@ -416,7 +419,7 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmSmImportedCertificateIdentity)
 }
 
 func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
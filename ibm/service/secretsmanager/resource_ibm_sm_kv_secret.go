@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -12,12 +13,16 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
 )
 
+// ibmSmKvSecretIdentity is the identity of ibm_sm_kv_secret, which can be used to import it.
+var ibmSmKvSecretIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmKvSecret() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmKvSecretCreate,
 		ReadContext:   resourceIbmSmKvSecretRead,
 		UpdateContext: resourceIbmSmKvSecretUpdate,
@@ -55,11 +60,27 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"data": &schema.Schema{
-				Type:        schema.TypeMap,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The payload data of a key-value secret.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"data", "data_wo"},
+				Description:  "The payload data of a key-value secret.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"data_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"data_wo_version"},
+				Description:  "The payload data of a key-value secret as a JSON-encoded write-only argument. The value is sent to Secrets Manager but never stored in the Terraform plan or state.",
+			},
+			"data_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"data_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of the write-only data. Change this value to create a new secret version from data_wo.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -130,7 +151,7 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 				Description: "A UUID identifier.",
 			},
 		},
-	}
+	}, ibmSmKvSecretIdentity)
 }
 
 func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -278,7 +299,8 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 			return tfErr.GetDiag()
 		}
 	}
-	if secret.Data != nil {
+	// Data provided through data_wo must never be written to the state
+	if _, ok := d.GetOk("data_wo_version"); !ok && secret.Data != nil {
 		d.Set("data", secret.Data)
 	}
 
@@ -360,9 +382,23 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 	}
 
 	// Apply change in secret data (if changed)
-	if d.HasChange("data") {
+	// data_wo is only sent when data_wo_version changes. Removing data_wo changes data_wo_version
+	// without providing new data, no version is created then
+	var dataWo map[string]interface{}
+	var hasDataWo bool
+	if d.HasChange("data_wo_version") {
+		dataWo, hasDataWo, err = resourceIbmSmKvSecretWriteOnlyData(d)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", KvSecretResourceName, "update").WithAttribute("data_wo")
+			return tfErr.GetDiag()
+		}
+	}
+	if hasDataWo || (d.HasChange("data") && len(d.Get("data").(map[string]interface{})) > 0) {
 		versionModel := &secretsmanagerv2.KVSecretVersionPrototype{}
 		versionModel.Data = d.Get("data").(map[string]interface{})
+		if hasDataWo {
+			versionModel.Data = dataWo
+		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -462,6 +498,13 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 	if _, ok := d.GetOk("data"); ok {
 		model.Data = d.Get("data").(map[string]interface{})
 	}
+	data, ok, err := resourceIbmSmKvSecretWriteOnlyData(d)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		model.Data = data
+	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
 	}
@@ -470,3 +513,16 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 	}
 	return model, nil
 }
+
+// Decode the JSON-encoded data_wo write-only argument
+func resourceIbmSmKvSecretWriteOnlyData(d *schema.ResourceData) (map[string]interface{}, bool, error) {
+	dataJson, ok := flex.GetWriteOnlyString(d, "data_wo")
+	if !ok {
+		return nil, false, nil
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(dataJson), &data); err != nil {
+		return nil, false, fmt.Errorf("Failed to parse \"data_wo\" as a JSON object: %s", err)
+	}
+	return data, true, nil
+}
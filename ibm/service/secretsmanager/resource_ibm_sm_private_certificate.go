@@ -16,8 +16,11 @@ import (
 	"strings"
 )
 
+// ibmSmPrivateCertificateIdentity is the identity of ibm_sm_private_certificate, which can be used to import it.
+var ibmSmPrivateCertificateIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmPrivateCertificate() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateCreate,
 		ReadContext:   resourceIbmSmPrivateCertificateRead,
 		UpdateContext: resourceIbmSmPrivateCertificateUpdate,
@@ -315,7 +318,7 @@ func ResourceIbmSmPrivateCertificate() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
-	}
+	}, ibmSmPrivateCertificateIdentity)
 }
 
 func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
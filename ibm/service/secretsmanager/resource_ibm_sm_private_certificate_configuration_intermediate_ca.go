@@ -19,8 +19,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPrivateCertificateConfigurationIntermediateCAIdentity is the identity of ibm_sm_private_certificate_configuration_intermediate_ca, which can be used to import it.
+var ibmSmPrivateCertificateConfigurationIntermediateCAIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmPrivateCertificateConfigurationIntermediateCA() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate,
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationIntermediateCARead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationIntermediateCAUpdate,
@@ -383,7 +386,7 @@ func ResourceIbmSmPrivateCertificateConfigurationIntermediateCA() *schema.Resour
 				Description: "Determines whether to use values from a certificate signing request (CSR) to complete a `private_cert_configuration_action_sign_csr` action.",
 			},
 		},
-	}
+	}, ibmSmPrivateCertificateConfigurationIntermediateCAIdentity)
 }
 
 func resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,8 +19,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPrivateCertificateConfigurationRootCAIdentity is the identity of ibm_sm_private_certificate_configuration_root_ca, which can be used to import it.
+var ibmSmPrivateCertificateConfigurationRootCAIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmPrivateCertificateConfigurationRootCA() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateConfigurationRootCACreate,
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationRootCARead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationRootCAUpdate,
@@ -387,7 +390,7 @@ func ResourceIbmSmPrivateCertificateConfigurationRootCA() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmSmPrivateCertificateConfigurationRootCAIdentity)
 }
 
 func resourceIbmSmPrivateCertificateConfigurationRootCACreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -18,8 +18,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPrivateCertificateConfigurationTemplateIdentity is the identity of ibm_sm_private_certificate_configuration_template, which can be used to import it.
+var ibmSmPrivateCertificateConfigurationTemplateIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmPrivateCertificateConfigurationTemplate() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPrivateCertificateConfigurationTemplateCreate,
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationTemplateRead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationTemplateUpdate,
@@ -319,7 +322,7 @@ func ResourceIbmSmPrivateCertificateConfigurationTemplate() *schema.Resource {
 				Description: "The duration in seconds by which to backdate the `not_before` property of an issued private certificate.",
 			},
 		},
-	}
+	}, ibmSmPrivateCertificateConfigurationTemplateIdentity)
 }
 
 func resourceIbmSmPrivateCertificateConfigurationTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
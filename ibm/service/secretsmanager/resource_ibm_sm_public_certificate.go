@@ -29,8 +29,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPublicCertificateIdentity is the identity of ibm_sm_public_certificate, which can be used to import it.
+var ibmSmPublicCertificateIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmPublicCertificate() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPublicCertificateCreate,
 		ReadContext:   resourceIbmSmPublicCertificateRead,
 		UpdateContext: resourceIbmSmPublicCertificateUpdate,
@@ -409,7 +412,7 @@ func ResourceIbmSmPublicCertificate() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(37 * time.Minute),
 		},
-	}
+	}, ibmSmPublicCertificateIdentity)
 }
 
 func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -17,8 +17,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPublicCertificateConfigurationCALetsEncryptIdentity is the identity of ibm_sm_public_certificate_configuration_ca_lets_encrypt, which can be used to import it.
+var ibmSmPublicCertificateConfigurationCALetsEncryptIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate,
 		ReadContext:   resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead,
 		UpdateContext: resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate,
@@ -65,7 +68,7 @@ func ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 		},
-	}
+	}, ibmSmPublicCertificateConfigurationCALetsEncryptIdentity)
 }
 
 func resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,8 +19,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPublicCertificateConfigurationDNSCisIdentity is the identity of ibm_sm_public_certificate_configuration_dns_cis, which can be used to import it.
+var ibmSmPublicCertificateConfigurationDNSCisIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmConfigurationPublicCertificateDNSCis() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmConfigurationPublicCertificateDNSCisCreate,
 		ReadContext:   resourceIbmSmConfigurationPublicCertificateDNSCisRead,
 		UpdateContext: resourceIbmSmConfigurationPublicCertificateDNSCisUpdate,
@@ -72,7 +75,7 @@ func ResourceIbmSmConfigurationPublicCertificateDNSCis() *schema.Resource {
 				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, ibmSmPublicCertificateConfigurationDNSCisIdentity)
 }
 
 func ResourceIbmSmConfigurationPublicCertificateDNSCisValidator() *validate.ResourceValidator {
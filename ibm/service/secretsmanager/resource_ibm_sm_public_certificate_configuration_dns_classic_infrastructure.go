@@ -19,8 +19,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmPublicCertificateConfigurationDNSClassicInfrastructureIdentity is the identity of ibm_sm_public_certificate_configuration_dns_classic_infrastructure, which can be used to import it.
+var ibmSmPublicCertificateConfigurationDNSClassicInfrastructureIdentity = secretsManagerResourceIdentity("name", "The name of the configuration.")
+
 func ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureCreate,
 		ReadContext:   resourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureRead,
 		UpdateContext: resourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureUpdate,
@@ -72,7 +75,7 @@ func ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure() *sche
 				Description: "The date when a resource was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, ibmSmPublicCertificateConfigurationDNSClassicInfrastructureIdentity)
 }
 
 func ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureValidator() *validate.ResourceValidator {
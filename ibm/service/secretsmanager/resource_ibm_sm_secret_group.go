@@ -18,8 +18,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmSecretGroupIdentity is the identity of ibm_sm_secret_group, which can be used to import it.
+var ibmSmSecretGroupIdentity = secretsManagerResourceIdentity("secret_group_id", "The ID of the secret group.")
+
 func ResourceIbmSmSecretGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmSecretGroupCreate,
 		ReadContext:   resourceIbmSmSecretGroupRead,
 		UpdateContext: resourceIbmSmSecretGroupUpdate,
@@ -55,7 +58,7 @@ func ResourceIbmSmSecretGroup() *schema.Resource {
 				Description: "The date that a resource was recently modified. The date format follows RFC 3339.",
 			},
 		},
-	}
+	}, ibmSmSecretGroupIdentity)
 }
 
 func ResourceIbmSmSecretGroupValidator() *validate.ResourceValidator {
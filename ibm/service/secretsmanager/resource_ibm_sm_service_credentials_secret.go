@@ -18,8 +18,11 @@ import (
 	"strings"
 )
 
+// ibmSmServiceCredentialsSecretIdentity is the identity of ibm_sm_service_credentials_secret, which can be used to import it.
+var ibmSmServiceCredentialsSecretIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmServiceCredentialsSecret() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmServiceCredentialsSecretCreate,
 		ReadContext:   resourceIbmSmServiceCredentialsSecretRead,
 		UpdateContext: resourceIbmSmServiceCredentialsSecretUpdate,
@@ -306,7 +309,7 @@ func ResourceIbmSmServiceCredentialsSecret() *schema.Resource {
 				Description: "A UUID identifier.",
 			},
 		},
-	}
+	}, ibmSmServiceCredentialsSecretIdentity)
 }
 
 func resourceIbmSmServiceCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
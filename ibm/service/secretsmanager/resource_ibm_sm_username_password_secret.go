@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -20,8 +21,11 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
+// ibmSmUsernamePasswordSecretIdentity is the identity of ibm_sm_username_password_secret, which can be used to import it.
+var ibmSmUsernamePasswordSecretIdentity = secretsManagerResourceIdentity("secret_id", "The ID of the secret.")
+
 func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmSmUsernamePasswordSecretCreate,
 		ReadContext:   resourceIbmSmUsernamePasswordSecretRead,
 		UpdateContext: resourceIbmSmUsernamePasswordSecretUpdate,
@@ -123,11 +127,27 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Description: "The username that is assigned to the secret.",
 			},
 			"password": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The password that is assigned to the secret.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "The password that is assigned to the secret.",
+			},
+			"password_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"password_wo_version"},
+				Description:  "The password that is assigned to the secret as a write-only argument. The value is sent to Secrets Manager but never stored in the Terraform plan or state.",
+			},
+			"password_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of the write-only password. Change this value to create a new secret version from password_wo.",
 			},
 			"password_generation_policy": &schema.Schema{
 				Type:        schema.TypeList,
@@ -215,7 +235,7 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
 			},
 		},
-	}
+	}, ibmSmUsernamePasswordSecretIdentity)
 }
 
 func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -382,9 +402,12 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting username"), UsernamePasswordSecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("password", secret.Password); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
-		return tfErr.GetDiag()
+	// A password provided through password_wo must never be written to the state
+	if _, ok := d.GetOk("password_wo_version"); !ok {
+		if err = d.Set("password", secret.Password); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	passwordPolicyMap, err := passwordGenerationPolicyToMap(secret.PasswordGenerationPolicy)
@@ -485,7 +508,9 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 			patchVals.ExpirationDate = &parseToDateTime
 			hasChange = true
 		} else {
-			tfErr := flex.TerraformErrorf(nil, `The "expiration_date" field cannot be removed. To disable expiration set expiration date to a far future date'`, UsernamePasswordSecretResourceName, "update")
+			tfErr := flex.TerraformErrorf(nil, `The "expiration_date" field cannot be removed`, UsernamePasswordSecretResourceName, "update").
+				WithAttribute("expiration_date").
+				WithRemediation("To disable expiration, set expiration_date to a far future date.")
 			return tfErr.GetDiag()
 		}
 	}
@@ -512,9 +537,9 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("password") {
+	if password, ok := flex.GetUpdatedSecretString(d, "password", "password_wo"); ok {
 		versionModel := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}
-		versionModel.Password = core.StringPtr(d.Get("password").(string))
+		versionModel.Password = core.StringPtr(password)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -632,6 +657,9 @@ func resourceIbmSmUsernamePasswordSecretMapToSecretPrototype(d *schema.ResourceD
 	if _, ok := d.GetOk("password"); ok {
 		model.Password = core.StringPtr(d.Get("password").(string))
 	}
+	if password, ok := flex.GetWriteOnlyString(d, "password_wo"); ok {
+		model.Password = core.StringPtr(password)
+	}
 	if _, ok := d.GetOk("rotation"); ok {
 		RotationModel, err := resourceIbmSmUsernamePasswordSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
 		if err != nil {
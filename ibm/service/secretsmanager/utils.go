@@ -63,27 +63,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getRegionFromClient(originalClient)
 	}
 }
 
+// Extract the region from the base URL of the client (provider config)
+func getRegionFromClient(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getEndpointTypeFromClient(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Extract the endpoint type from the base URL of the client (provider config)
+func getEndpointTypeFromClient(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -211,20 +220,58 @@ func DateTimeToRFC3339(dt *strfmt.DateTime) (s string) {
 	return
 }
 
+// secretLookup holds the arguments that identify a secret either by ID or by name and secret group name
+type secretLookup struct {
+	InstanceId      string
+	Region          string
+	EndpointType    string
+	SecretId        string
+	Name            string
+	SecretGroupName string
+}
+
 func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta interface{}, secretType string, dataSourceName string) (secretsmanagerv2.SecretIntf, string, string, diag.Diagnostics) {
+	lookup := secretLookup{
+		InstanceId:      d.Get("instance_id").(string),
+		SecretId:        d.Get("secret_id").(string),
+		Name:            d.Get("name").(string),
+		SecretGroupName: d.Get("secret_group_name").(string),
+	}
+	if region, ok := d.GetOk("region"); ok {
+		lookup.Region = region.(string)
+	}
+	if endpointType, ok := d.GetOk("endpoint_type"); ok {
+		lookup.EndpointType = endpointType.(string)
+	}
 
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
-	if err != nil {
-		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", dataSourceName), "read")
+	secretIntf, region, tfErr := getSecretByLookup(context, meta.(conns.ClientSession), lookup, secretType, fmt.Sprintf("(Data) %s", dataSourceName))
+	if tfErr != nil {
 		return nil, "", "", tfErr.GetDiag()
 	}
-	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	return secretIntf, region, lookup.InstanceId, nil
+}
 
-	secretId := d.Get("secret_id").(string)
-	secretName := d.Get("name").(string)
-	groupName := d.Get("secret_group_name").(string)
+// Locate a secret by ID or by name and secret group name. The region and endpoint type of the lookup
+// fall back to the provider configuration when they are not set.
+func getSecretByLookup(context context.Context, clientSession conns.ClientSession, lookup secretLookup, secretType string, resourceName string) (secretsmanagerv2.SecretIntf, string, *flex.TerraformProblem) {
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(clientSession)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, "", resourceName, "read")
+	}
+	region := lookup.Region
+	if region == "" {
+		region = getRegionFromClient(secretsManagerClient)
+	}
+	endpointType := lookup.EndpointType
+	if endpointType == "" {
+		endpointType = getEndpointTypeFromClient(secretsManagerClient)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, lookup.InstanceId, region, endpointType, endpointsFile)
+
+	secretId := lookup.SecretId
+	secretName := lookup.Name
+	groupName := lookup.SecretGroupName
 
 	log.Printf("[DEBUG] getSecretByIdOrByName %q %q %q %q\n", secretId, secretName, groupName, secretType)
 
@@ -238,10 +285,9 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), resourceName, "read")
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, region, nil
 	}
 
 	if secretName != "" && groupName != "" {
@@ -255,14 +301,12 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(context, getSecretByNameOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), resourceName, "read")
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, region, nil
 	}
 
-	tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-	return nil, "", "", tfErr.GetDiag()
+	return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), resourceName, "read")
 }
 
 func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsmanagerv2.SecretVersionMetadataPatch) (_patch map[string]interface{}, err error) {
@@ -274,3 +318,15 @@ func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsman
 	}
 	return
 }
+
+// secretsManagerResourceIdentity returns the identity of a resource of a
+// Secrets Manager instance, whose ID is `<region>/<instance_id>/<name>`.
+func secretsManagerResourceIdentity(name, description string) flex.ResourceIdentity {
+	return flex.ResourceIdentity{
+		Attributes: []flex.IdentityAttribute{
+			{Name: "region", Description: "The region of the Secrets Manager instance."},
+			{Name: "instance_id", Description: "The ID of the Secrets Manager instance."},
+			{Name: name, Description: description},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+// NewIBMIsBareMetalServerPowerAction returns the ibm_is_bare_metal_server_power action, which starts, stops or
+// reboots a bare metal server.
+func NewIBMIsBareMetalServerPowerAction() action.Action {
+	return &vpcPowerAction{
+		typeName:         "ibm_is_bare_metal_server_power",
+		description:      "Starts, stops or reboots a bare metal server, and optionally waits for the bare metal server to be running or stopped. Actions do not return output values.",
+		server:           "bare_metal_server",
+		forceDescription: "Whether a stop is a hard stop, which immediately powers off the bare metal server, instead of a soft stop, which shuts down its operating system. Only supported for the `stop` action. The default value is `false`.",
+		forceActions:     []string{vpcPowerActionStop},
+		pendingStatuses: []string{
+			vpcv1.BareMetalServerStatusPendingConst,
+			vpcv1.BareMetalServerStatusStartingConst,
+			vpcv1.BareMetalServerStatusRunningConst,
+			isBareMetalServerActionStatusStopping,
+			vpcv1.BareMetalServerStatusStoppedConst,
+			vpcv1.BareMetalServerStatusRestartingConst,
+			vpcv1.BareMetalServerStatusReinitializingConst,
+		},
+		power:   powerIBMIsBareMetalServer,
+		refresh: refreshIBMIsBareMetalServerPower,
+	}
+}
+
+func powerIBMIsBareMetalServer(ctx context.Context, client *vpcv1.VpcV1, id, powerAction string, force bool) error {
+	var err error
+	var operation string
+	switch powerAction {
+	case vpcPowerActionStart:
+		operation = "StartBareMetalServerWithContext"
+		_, err = client.StartBareMetalServerWithContext(ctx, &vpcv1.StartBareMetalServerOptions{ID: &id})
+	case vpcPowerActionStop:
+		stopType := vpcv1.StopBareMetalServerOptionsTypeSoftConst
+		if force {
+			stopType = vpcv1.StopBareMetalServerOptionsTypeHardConst
+		}
+		operation = "StopBareMetalServerWithContext"
+		_, err = client.StopBareMetalServerWithContext(ctx, &vpcv1.StopBareMetalServerOptions{ID: &id, Type: &stopType})
+	case vpcPowerActionReboot:
+		operation = "RestartBareMetalServerWithContext"
+		_, err = client.RestartBareMetalServerWithContext(ctx, &vpcv1.RestartBareMetalServerOptions{ID: &id})
+	default:
+		return fmt.Errorf("[ERROR] Unsupported power action %q", powerAction)
+	}
+	if err != nil {
+		return flex.TerraformErrorf(err, fmt.Sprintf("%s failed: %s", operation, err.Error()), "ibm_is_bare_metal_server_power", "invoke")
+	}
+	return nil
+}
+
+func refreshIBMIsBareMetalServerPower(client *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return waiter.StatusRefresh(func(ctx context.Context) (*vpcv1.BareMetalServer, *core.DetailedResponse, error) {
+		return client.GetBareMetalServerWithContext(ctx, &vpcv1.GetBareMetalServerOptions{ID: &id})
+	})
+}
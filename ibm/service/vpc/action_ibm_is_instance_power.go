@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+// NewIBMIsInstancePowerAction returns the ibm_is_instance_power action, which starts, stops or reboots an instance.
+func NewIBMIsInstancePowerAction() action.Action {
+	return &vpcPowerAction{
+		typeName:         "ibm_is_instance_power",
+		description:      "Starts, stops or reboots a virtual server instance, and optionally waits for the instance to be running or stopped. Actions do not return output values.",
+		server:           "instance",
+		forceDescription: "Whether the action runs immediately, and cancels the actions of the instance that are queued. The default value is `false`.",
+		forceActions:     vpcPowerActions,
+		pendingStatuses: []string{
+			isInstanceStatusPending,
+			isInstanceStatusStarting,
+			isInstanceStatusRunning,
+			isInstanceActionStatusStopping,
+			isInstanceActionStatusStopped,
+			isInstanceStatusRestarting,
+		},
+		power:   powerIBMIsInstance,
+		refresh: refreshIBMIsInstancePower,
+	}
+}
+
+func powerIBMIsInstance(ctx context.Context, client *vpcv1.VpcV1, id, powerAction string, force bool) error {
+	options := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       &powerAction,
+	}
+	if force {
+		options.Force = &force
+	}
+	_, _, err := client.CreateInstanceActionWithContext(ctx, options)
+	if err != nil {
+		return flex.TerraformErrorf(err, fmt.Sprintf("CreateInstanceActionWithContext failed: %s", err.Error()), "ibm_is_instance_power", "invoke")
+	}
+	return nil
+}
+
+func refreshIBMIsInstancePower(client *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return waiter.StatusRefresh(func(ctx context.Context) (*vpcv1.Instance, *core.DetailedResponse, error) {
+		return client.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{ID: &id})
+	})
+}
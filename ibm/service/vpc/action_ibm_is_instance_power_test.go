@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISInstancePowerAction_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-power-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-power-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-power-ssh-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-power-instance-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstancePowerActionConfig(vpcname, subnetname, sshname, publicKey, name, "stop", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstancePowerStatus("ibm_is_instance.testacc_instance", "stopped"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancePowerActionConfig(vpcname, subnetname, sshname, publicKey, name, "start", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstancePowerStatus("ibm_is_instance.testacc_instance", "running"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancePowerActionConfig(vpcname, subnetname, sshname, publicKey, name, "reboot", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstancePowerStatus("ibm_is_instance.testacc_instance", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISInstancePowerAction_invalidAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				action "ibm_is_instance_power" "test" {
+					config {
+						instance = "0717_00000000-0000-0000-0000-000000000000"
+						action   = "suspend"
+					}
+				}
+
+				resource "terraform_data" "trigger" {
+					input = "1"
+					lifecycle {
+						action_trigger {
+							events  = [after_create]
+							actions = [action.ibm_is_instance_power.test]
+						}
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Power Action"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstancePowerStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		getInstanceOptions := &vpcv1.GetInstanceOptions{
+			ID: &rs.Primary.ID,
+		}
+		instance, _, err := sess.GetInstance(getInstanceOptions)
+		if err != nil {
+			return err
+		}
+		if *instance.Status != status {
+			return fmt.Errorf("expected instance %s to be %s, got %s", rs.Primary.ID, status, *instance.Status)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISInstancePowerActionConfig(vpcname, subnetname, sshname, publicKey, name, powerAction, trigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+		lifecycle {
+			ignore_changes = [action, status]
+		}
+	}
+
+	action "ibm_is_instance_power" "testacc_power" {
+		config {
+			instance = ibm_is_instance.testacc_instance.id
+			action   = "%s"
+		}
+	}
+
+	resource "terraform_data" "testacc_trigger" {
+		input = "%s"
+		lifecycle {
+			action_trigger {
+				events  = [after_create, after_update]
+				actions = [action.ibm_is_instance_power.testacc_power]
+			}
+		}
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, powerAction, trigger)
+}
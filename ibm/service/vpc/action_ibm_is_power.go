@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	vpcPowerActionStart  = "start"
+	vpcPowerActionStop   = "stop"
+	vpcPowerActionReboot = "reboot"
+
+	// vpcPowerDefaultWaitTimeout is the default wait for the status of a power action.
+	vpcPowerDefaultWaitTimeout = 30 * time.Minute
+	// vpcPowerRebootStartTimeout bounds the wait for a rebooting server to leave the running status.
+	// A reboot that completes within a single poll is not observed, so the server is then assumed
+	// to be rebooted.
+	vpcPowerRebootStartTimeout = 2 * time.Minute
+)
+
+var vpcPowerActions = []string{vpcPowerActionStart, vpcPowerActionStop, vpcPowerActionReboot}
+
+// vpcPowerFunc requests a power action of a server.
+type vpcPowerFunc func(ctx context.Context, client *vpcv1.VpcV1, id, powerAction string, force bool) error
+
+// vpcPowerRefreshFunc returns a refresh func that reads the status of a server.
+type vpcPowerRefreshFunc func(client *vpcv1.VpcV1, id string) waiter.RefreshFunc
+
+var (
+	_ action.Action                   = &vpcPowerAction{}
+	_ action.ActionWithConfigure      = &vpcPowerAction{}
+	_ action.ActionWithValidateConfig = &vpcPowerAction{}
+)
+
+// vpcPowerAction starts, stops or reboots a VPC server, and optionally waits for the server to
+// reach the status of the power action.
+type vpcPowerAction struct {
+	typeName    string
+	description string
+	// server is the attribute with the ID of the server, and names the server in messages.
+	server           string
+	forceDescription string
+	// forceActions are the power actions that support force.
+	forceActions []string
+	// pendingStatuses are the statuses of a server on its way to running or stopped.
+	pendingStatuses []string
+	power           vpcPowerFunc
+	refresh         vpcPowerRefreshFunc
+	session         conns.ClientSession
+}
+
+func (a *vpcPowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.typeName
+}
+
+func (a *vpcPowerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: a.description,
+		Attributes: map[string]schema.Attribute{
+			a.server: schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The ID of the %s.", strings.ReplaceAll(a.server, "_", " ")),
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "The power action, one of `start`, `stop` and `reboot`.",
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: a.forceDescription,
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the server to be `running` after a start or a reboot, or `stopped` after a stop. The default value is `true`.",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum time in seconds to wait for the status of the server. The default value is `1800`.",
+			},
+		},
+	}
+}
+
+func (a *vpcPowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *vpcPowerAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var powerAction types.String
+	var force types.Bool
+	var waitTimeout types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &powerAction)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("force"), &force)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_timeout"), &waitTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !powerAction.IsNull() && !powerAction.IsUnknown() && !slices.Contains(vpcPowerActions, powerAction.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("action"), "Invalid Power Action",
+			fmt.Sprintf("The action must be one of %s, got: %q", strings.Join(vpcPowerActions, ", "), powerAction.ValueString()))
+	}
+	if force.ValueBool() && !powerAction.IsUnknown() && !slices.Contains(a.forceActions, powerAction.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("force"), "Unsupported Force",
+			fmt.Sprintf("force is only supported for the %s actions of a %s.", strings.Join(a.forceActions, ", "), strings.ReplaceAll(a.server, "_", " ")))
+	}
+	if !waitTimeout.IsNull() && !waitTimeout.IsUnknown() && waitTimeout.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid Wait Timeout", "The wait_timeout must be at least 1 second.")
+	}
+}
+
+func (a *vpcPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	// The server attribute is named after the server, so the configuration is read by attribute
+	var id, powerAction types.String
+	var force, wait types.Bool
+	var waitTimeoutSeconds types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(a.server), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &powerAction)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("force"), &force)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait"), &wait)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_timeout"), &waitTimeoutSeconds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	server := fmt.Sprintf("%s (%s)", strings.ReplaceAll(a.server, "_", " "), id.ValueString())
+
+	client, err := a.session.VpcV1API()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VPC Client", err.Error())
+		return
+	}
+
+	err = a.power(ctx, client, id.ValueString(), powerAction.ValueString(), force.ValueBool())
+	if err != nil {
+		detail := err.Error()
+		var tfErr *flex.TerraformProblem
+		if errors.As(err, &tfErr) {
+			detail = tfErr.GetConsoleMessage()
+		}
+		resp.Diagnostics.AddError("Power Action Failed", detail)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested %s of %s", powerAction.ValueString(), server),
+	})
+
+	if !wait.IsNull() && !wait.ValueBool() {
+		return
+	}
+
+	waitTimeout := vpcPowerDefaultWaitTimeout
+	if !waitTimeoutSeconds.IsNull() {
+		waitTimeout = time.Duration(waitTimeoutSeconds.ValueInt64()) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+
+	target := isInstanceStatusRunning
+	if powerAction.ValueString() == vpcPowerActionStop {
+		target = isInstanceActionStatusStopped
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for %s to be %s (timeout: %v)...", server, target, waitTimeout),
+	})
+
+	if powerAction.ValueString() == vpcPowerActionReboot {
+		err = a.waitForRebootStart(ctx, client, id.ValueString(), server)
+		if err != nil {
+			resp.Diagnostics.AddError("Power Action Failed", err.Error())
+			return
+		}
+	}
+
+	stateWaiter := &waiter.StateWaiter{
+		Resource: server,
+		Pending:  a.pendingStatuses,
+		Target:   []string{target},
+		Failed:   []string{isInstanceStatusFailed},
+		Refresh:  a.refresh(client, id.ValueString()),
+		Timeout:  waitTimeout,
+		Policy:   a.session.WaiterPolicy(),
+	}
+	if _, err = stateWaiter.Wait(ctx); err != nil {
+		resp.Diagnostics.AddError("Power Action Failed", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s is %s", server, target),
+	})
+}
+
+// waitForRebootStart waits for a rebooting server to leave the running status, so that the wait for
+// the running status does not end before the reboot has started.
+func (a *vpcPowerAction) waitForRebootStart(ctx context.Context, client *vpcv1.VpcV1, id, server string) error {
+	var started []string
+	for _, status := range a.pendingStatuses {
+		if status != isInstanceStatusRunning {
+			started = append(started, status)
+		}
+	}
+	stateWaiter := &waiter.StateWaiter{
+		Resource: server,
+		Pending:  []string{isInstanceStatusRunning},
+		Target:   started,
+		Failed:   []string{isInstanceStatusFailed},
+		Refresh:  a.refresh(client, id),
+		Timeout:  vpcPowerRebootStartTimeout,
+		Policy:   a.session.WaiterPolicy(),
+	}
+	_, err := stateWaiter.Wait(ctx)
+	var stateErr *waiter.StateError
+	if errors.As(err, &stateErr) && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		// The reboot completed between two polls
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,1104 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isNetworkPathVerdictAllow   = "allow"
+	isNetworkPathVerdictDeny    = "deny"
+	isNetworkPathVerdictSkipped = "skipped"
+
+	isNetworkPathEphemeralPortMin = 1024
+	isNetworkPathEphemeralPortMax = 65535
+)
+
+func DataSourceIBMIsNetworkPathAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsNetworkPathAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the VPC.",
+			},
+			"source": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The source of the traffic.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"source.0.instance", "source.0.virtual_network_interface", "source.0.subnet", "source.0.cidr"},
+							Description:  "The unique identifier of the instance. The traffic is sent from its primary network attachment or interface.",
+						},
+						"virtual_network_interface": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"source.0.instance", "source.0.virtual_network_interface", "source.0.subnet", "source.0.cidr"},
+							Description:  "The unique identifier of the virtual network interface.",
+						},
+						"subnet": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"source.0.instance", "source.0.virtual_network_interface", "source.0.subnet", "source.0.cidr"},
+							Description:  "The unique identifier of the subnet. The traffic must be allowed from every address of the subnet.",
+						},
+						"cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"source.0.instance", "source.0.virtual_network_interface", "source.0.subnet", "source.0.cidr"},
+							ValidateFunc: validate.ValidateCIDR,
+							Description:  "An IPv4 CIDR block in a subnet of the VPC. The traffic must be allowed from every address of the CIDR block.",
+						},
+						"port_min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      isNetworkPathEphemeralPortMin,
+							ValidateFunc: validate.ValidatePortRange(1, 65535),
+							Description:  "The lowest source port of the traffic.",
+						},
+						"port_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      isNetworkPathEphemeralPortMax,
+							ValidateFunc: validate.ValidatePortRange(1, 65535),
+							Description:  "The highest source port of the traffic.",
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The destination of the traffic.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateIP,
+							Description:  "The IPv4 address of the destination.",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"tcp", "udp", "icmp"}),
+							Description:  "The protocol of the traffic: tcp, udp or icmp.",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.ValidatePortRange(1, 65535),
+							Description:  "The destination port of the traffic. If unspecified, the traffic to every port must be allowed. Only valid for the tcp and udp protocols.",
+						},
+						"icmp_type": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 254),
+							Description:  "The ICMP type of the traffic. If unspecified, every type must be allowed. Only valid for the icmp protocol.",
+						},
+						"icmp_code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 255),
+							Description:  "The ICMP code of the traffic. If unspecified, every code must be allowed. Only valid for the icmp protocol.",
+						},
+					},
+				},
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the traffic is allowed by every hop of the path.",
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason of the first hop that denies the traffic. Empty when the traffic is allowed.",
+			},
+			"hops": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hops of the path, in the order in which the traffic passes them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the hop.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource that is evaluated at the hop.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource that is evaluated at the hop.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource that is evaluated at the hop.",
+						},
+						"verdict": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The verdict of the hop: allow, deny or skipped.",
+						},
+						"rule_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the rule or route that matched the traffic.",
+						},
+						"rule_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule or route that matched the traffic.",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the hop allows, denies or skips the traffic.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsNetworkPathAnalysisRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	analyzer := &networkPathAnalyzer{
+		context:            context,
+		sess:               sess,
+		vpcID:              d.Get("vpc").(string),
+		networkACLRules:    map[string][]networkPathRule{},
+		securityGroupRules: map[string][]networkPathRule{},
+	}
+	if err = analyzer.listSubnets(); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	source, err := analyzer.resolveSource(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read", "resolve-source")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	destination, err := analyzer.resolveDestination(d.Get("destination.0.address").(string))
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read", "resolve-destination")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	flow := networkPathFlow{
+		Protocol:         d.Get("destination.0.protocol").(string),
+		Source:           source.Prefix,
+		Destination:      destination.Prefix,
+		SourcePorts:      networkPathPorts{int64(d.Get("source.0.port_min").(int)), int64(d.Get("source.0.port_max").(int))},
+		DestinationPorts: networkPathAllPorts,
+	}
+	if flow.SourcePorts.Min > flow.SourcePorts.Max {
+		err = fmt.Errorf("source port_min %d must not be greater than port_max %d", flow.SourcePorts.Min, flow.SourcePorts.Max)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read", "validate-ports").GetDiag()
+	}
+	if port, ok := d.GetOk("destination.0.port"); ok {
+		flow.DestinationPorts = networkPathPorts{int64(port.(int)), int64(port.(int))}
+	}
+	if icmpType, ok := d.GetOkExists("destination.0.icmp_type"); ok {
+		flow.ICMPType = core.Int64Ptr(int64(icmpType.(int)))
+	}
+	if icmpCode, ok := d.GetOkExists("destination.0.icmp_code"); ok {
+		flow.ICMPCode = core.Int64Ptr(int64(icmpCode.(int)))
+	}
+	if source.Target != nil {
+		flow.SourceSecurityGroups = source.Target.securityGroupIDs()
+	}
+	if destination.Target != nil {
+		flow.DestinationSecurityGroups = destination.Target.securityGroupIDs()
+	}
+
+	hops, err := analyzer.analyze(source, destination, flow)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	allowed, reason := true, ""
+	hopsList := make([]map[string]interface{}, 0, len(hops))
+	for _, hop := range hops {
+		if allowed && hop.Verdict == isNetworkPathVerdictDeny {
+			allowed, reason = false, hop.Reason
+		}
+		hopsList = append(hopsList, hop.toMap())
+	}
+
+	d.SetId(dataSourceIBMIsNetworkPathAnalysisID(d))
+	if err = d.Set("allowed", allowed); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting allowed: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-allowed").GetDiag()
+	}
+	if err = d.Set("reason", reason); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reason: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-reason").GetDiag()
+	}
+	if err = d.Set("hops", hopsList); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting hops: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-hops").GetDiag()
+	}
+	return nil
+}
+
+// dataSourceIBMIsNetworkPathAnalysisID returns a reasonable ID for the analysis.
+func dataSourceIBMIsNetworkPathAnalysisID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+// networkPathPorts is an inclusive range of ports.
+type networkPathPorts struct {
+	Min int64
+	Max int64
+}
+
+var networkPathAllPorts = networkPathPorts{1, 65535}
+
+func (p networkPathPorts) contains(o networkPathPorts) bool {
+	return p.Min <= o.Min && o.Max <= p.Max
+}
+
+func (p networkPathPorts) overlaps(o networkPathPorts) bool {
+	return p.Min <= o.Max && o.Min <= p.Max
+}
+
+func networkPathPortRange(min, max *int64) networkPathPorts {
+	if min == nil || max == nil {
+		return networkPathAllPorts
+	}
+	return networkPathPorts{*min, *max}
+}
+
+// networkPathFlow is the traffic that is analyzed. The source is a range of
+// addresses when it is a subnet or a CIDR block, and the traffic is only
+// allowed when it is allowed from every address of the range. Likewise, a
+// port range or an unspecified ICMP type or code must be allowed entirely.
+type networkPathFlow struct {
+	Protocol                  string
+	Source                    netip.Prefix
+	Destination               netip.Prefix
+	SourceSecurityGroups      map[string]bool
+	DestinationSecurityGroups map[string]bool
+	SourcePorts               networkPathPorts
+	DestinationPorts          networkPathPorts
+	ICMPType                  *int64
+	ICMPCode                  *int64
+}
+
+// response returns the response traffic of the flow, which stateless network
+// ACLs must allow as well. The ok result is false when the flow has no
+// response, which is the case for ICMP traffic other than echo requests.
+func (f networkPathFlow) response() (networkPathFlow, bool) {
+	f.Source, f.Destination = f.Destination, f.Source
+	f.SourceSecurityGroups, f.DestinationSecurityGroups = f.DestinationSecurityGroups, f.SourceSecurityGroups
+	f.SourcePorts, f.DestinationPorts = f.DestinationPorts, f.SourcePorts
+	if f.Protocol == "icmp" {
+		if f.ICMPType == nil || *f.ICMPType != 8 {
+			return f, false
+		}
+		echoReply := int64(0)
+		f.ICMPType, f.ICMPCode = &echoReply, nil
+	}
+	return f, true
+}
+
+// networkPathRule is a network ACL rule or a security group rule. Its source
+// and destination are an IP address, a CIDR block or, for the remote of a
+// security group rule, the ID of a security group.
+type networkPathRule struct {
+	ID               string
+	Name             string
+	Action           string
+	Direction        string
+	Protocol         string
+	Source           string
+	Destination      string
+	SourcePorts      networkPathPorts
+	DestinationPorts networkPathPorts
+	ICMPType         *int64
+	ICMPCode         *int64
+}
+
+// match reports whether the rule matches all of the traffic of the flow, and
+// whether it matches any of it.
+func (r networkPathRule) match(f networkPathFlow) (all, any bool) {
+	switch r.Protocol {
+	case "any", "all", "icmp_tcp_udp", f.Protocol:
+	default:
+		return false, false
+	}
+
+	sourceAll, sourceAny := matchNetworkPathAddress(r.Source, f.Source, f.SourceSecurityGroups)
+	destinationAll, destinationAny := matchNetworkPathAddress(r.Destination, f.Destination, f.DestinationSecurityGroups)
+	all, any = sourceAll && destinationAll, sourceAny && destinationAny
+
+	switch r.Protocol {
+	case "tcp", "udp":
+		all = all && r.SourcePorts.contains(f.SourcePorts) && r.DestinationPorts.contains(f.DestinationPorts)
+		any = any && r.SourcePorts.overlaps(f.SourcePorts) && r.DestinationPorts.overlaps(f.DestinationPorts)
+	case "icmp":
+		typeAll, typeAny := matchNetworkPathICMP(r.ICMPType, f.ICMPType)
+		codeAll, codeAny := matchNetworkPathICMP(r.ICMPCode, f.ICMPCode)
+		all = all && typeAll && codeAll
+		any = any && typeAny && codeAny
+	}
+	return all, any
+}
+
+func matchNetworkPathAddress(rule string, prefix netip.Prefix, securityGroups map[string]bool) (all, any bool) {
+	if rule == "" {
+		return true, true
+	}
+	if rulePrefix, err := netip.ParsePrefix(rule); err == nil {
+		rulePrefix = rulePrefix.Masked()
+		return rulePrefix.Bits() <= prefix.Bits() && rulePrefix.Contains(prefix.Addr()), rulePrefix.Overlaps(prefix)
+	}
+	if address, err := netip.ParseAddr(rule); err == nil {
+		return prefix.IsSingleIP() && prefix.Addr() == address, prefix.Contains(address)
+	}
+	// The remote of the rule is a security group.
+	return securityGroups[rule], securityGroups[rule]
+}
+
+func matchNetworkPathICMP(rule, flow *int64) (all, any bool) {
+	if rule == nil {
+		return true, true
+	}
+	if flow == nil {
+		return false, true
+	}
+	return *rule == *flow, *rule == *flow
+}
+
+func networkPathRuleFromNetworkACLRule(rule vpcv1.NetworkACLRuleItemIntf) networkPathRule {
+	var id, name, action, direction, protocol, source, destination *string
+	pathRule := networkPathRule{
+		SourcePorts:      networkPathAllPorts,
+		DestinationPorts: networkPathAllPorts,
+	}
+	switch r := rule.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		id, name, action, direction, protocol, source, destination = r.ID, r.Name, r.Action, r.Direction, r.Protocol, r.Source, r.Destination
+		pathRule.ICMPType, pathRule.ICMPCode = r.Type, r.Code
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		id, name, action, direction, protocol, source, destination = r.ID, r.Name, r.Action, r.Direction, r.Protocol, r.Source, r.Destination
+		pathRule.SourcePorts = networkPathPortRange(r.SourcePortMin, r.SourcePortMax)
+		pathRule.DestinationPorts = networkPathPortRange(r.DestinationPortMin, r.DestinationPortMax)
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAny:
+		id, name, action, direction, protocol, source, destination = r.ID, r.Name, r.Action, r.Direction, r.Protocol, r.Source, r.Destination
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmptcpudp:
+		id, name, action, direction, protocol, source, destination = r.ID, r.Name, r.Action, r.Direction, r.Protocol, r.Source, r.Destination
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIndividual:
+		id, name, action, direction, protocol, source, destination = r.ID, r.Name, r.Action, r.Direction, r.Protocol, r.Source, r.Destination
+	case *vpcv1.NetworkACLRuleItem:
+		id, name, action, direction, protocol, source, destination = r.ID, r.Name, r.Action, r.Direction, r.Protocol, r.Source, r.Destination
+		pathRule.ICMPType, pathRule.ICMPCode = r.Type, r.Code
+		pathRule.SourcePorts = networkPathPortRange(r.SourcePortMin, r.SourcePortMax)
+		pathRule.DestinationPorts = networkPathPortRange(r.DestinationPortMin, r.DestinationPortMax)
+	}
+	pathRule.ID = flex.StringValue(id)
+	pathRule.Name = flex.StringValue(name)
+	pathRule.Action = flex.StringValue(action)
+	pathRule.Direction = flex.StringValue(direction)
+	pathRule.Protocol = flex.StringValue(protocol)
+	pathRule.Source = flex.StringValue(source)
+	pathRule.Destination = flex.StringValue(destination)
+	return pathRule
+}
+
+func networkPathRuleFromSecurityGroupRule(rule vpcv1.SecurityGroupRuleIntf) networkPathRule {
+	r := securityGroupRuleFromAPI(rule)
+	pathRule := networkPathRule{
+		ID:               r.ID,
+		Name:             r.Name,
+		Action:           isNetworkPathVerdictAllow,
+		Direction:        r.Direction,
+		Protocol:         r.Protocol,
+		SourcePorts:      networkPathAllPorts,
+		DestinationPorts: networkPathAllPorts,
+	}
+	if r.Direction == "inbound" {
+		pathRule.Source, pathRule.Destination = r.Remote, r.Local
+	} else {
+		pathRule.Source, pathRule.Destination = r.Local, r.Remote
+	}
+	if r.PortMin != 0 || r.PortMax != 0 {
+		pathRule.DestinationPorts = networkPathPorts{r.PortMin, r.PortMax}
+	}
+	// An ICMP type or code of 0 is valid, so they are taken from the API.
+	switch icmp := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		pathRule.ICMPType, pathRule.ICMPCode = icmp.Type, icmp.Code
+	case *vpcv1.SecurityGroupRule:
+		pathRule.ICMPType, pathRule.ICMPCode = icmp.Type, icmp.Code
+	}
+	return pathRule
+}
+
+// networkPathHop is a hop of the path and its verdict.
+type networkPathHop struct {
+	Type         string
+	ResourceType string
+	ResourceID   string
+	ResourceName string
+	Verdict      string
+	RuleID       string
+	RuleName     string
+	Reason       string
+}
+
+func (h networkPathHop) withVerdict(verdict, reason string) networkPathHop {
+	h.Verdict, h.Reason = verdict, reason
+	return h
+}
+
+func (h networkPathHop) withRule(verdict, ruleID, ruleName, reason string) networkPathHop {
+	h.Verdict, h.RuleID, h.RuleName, h.Reason = verdict, ruleID, ruleName, reason
+	return h
+}
+
+func (h networkPathHop) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"type":          h.Type,
+		"resource_type": h.ResourceType,
+		"resource_id":   h.ResourceID,
+		"resource_name": h.ResourceName,
+		"verdict":       h.Verdict,
+		"rule_id":       h.RuleID,
+		"rule_name":     h.RuleName,
+		"reason":        h.Reason,
+	}
+}
+
+// evaluateNetworkPathACL evaluates the rules of a network ACL in order. A
+// deny rule denies the traffic when it matches any of it, and an allow rule
+// allows the traffic when it matches all of it. Traffic that no rule allows
+// is denied.
+func evaluateNetworkPathACL(hop networkPathHop, rules []networkPathRule, direction string, f networkPathFlow) networkPathHop {
+	for _, rule := range rules {
+		if rule.Direction != direction {
+			continue
+		}
+		all, any := rule.match(f)
+		if !any {
+			continue
+		}
+		if rule.Action == "deny" {
+			return hop.withRule(isNetworkPathVerdictDeny, rule.ID, rule.Name, fmt.Sprintf("The %s traffic is denied by a rule of the network ACL.", direction))
+		}
+		if all {
+			return hop.withRule(isNetworkPathVerdictAllow, rule.ID, rule.Name, fmt.Sprintf("The %s traffic is allowed by a rule of the network ACL.", direction))
+		}
+	}
+	return hop.withVerdict(isNetworkPathVerdictDeny, fmt.Sprintf("No rule of the network ACL allows all of the %s traffic.", direction))
+}
+
+// selectNetworkPathRoute returns the route of a routing table that the
+// traffic to the destination takes: of the routes in the zone that contain
+// the destination, the route with the longest prefix and then the highest
+// priority. It returns nil when no route contains the destination.
+func selectNetworkPathRoute(routes []vpcv1.Route, zone string, destination netip.Addr) *vpcv1.Route {
+	var selected *vpcv1.Route
+	selectedBits := -1
+	for i := range routes {
+		route := &routes[i]
+		if route.Zone == nil || flex.StringValue(route.Zone.Name) != zone {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(flex.StringValue(route.Destination))
+		if err != nil || !prefix.Contains(destination) {
+			continue
+		}
+		if prefix.Bits() < selectedBits {
+			continue
+		}
+		if prefix.Bits() == selectedBits && networkPathRoutePriority(route) >= networkPathRoutePriority(selected) {
+			continue
+		}
+		selected, selectedBits = route, prefix.Bits()
+	}
+	return selected
+}
+
+func networkPathRoutePriority(route *vpcv1.Route) int64 {
+	if route.Priority == nil {
+		return 0
+	}
+	return *route.Priority
+}
+
+// networkPathTarget is the network interface of a source or a destination.
+type networkPathTarget struct {
+	ResourceType      string
+	ID                string
+	Name              string
+	Address           string
+	SubnetID          string
+	SecurityGroups    []vpcv1.SecurityGroupReference
+	FloatingIPID      string
+	FloatingIPAddress string
+}
+
+func (t *networkPathTarget) securityGroupIDs() map[string]bool {
+	ids := make(map[string]bool, len(t.SecurityGroups))
+	for _, securityGroup := range t.SecurityGroups {
+		ids[flex.StringValue(securityGroup.ID)] = true
+	}
+	return ids
+}
+
+// networkPathEndpoint is the source or the destination of the traffic. The
+// subnet is nil when the destination is outside of the VPC, and the target is
+// nil when the network interface is not known.
+type networkPathEndpoint struct {
+	Prefix netip.Prefix
+	Subnet *vpcv1.Subnet
+	Target *networkPathTarget
+}
+
+// networkPathAnalyzer fetches the resources of a VPC that the traffic passes,
+// and evaluates the traffic locally.
+type networkPathAnalyzer struct {
+	context            context.Context
+	sess               *vpcv1.VpcV1
+	vpcID              string
+	subnets            []vpcv1.Subnet
+	networkACLRules    map[string][]networkPathRule
+	securityGroupRules map[string][]networkPathRule
+}
+
+func (a *networkPathAnalyzer) listSubnets() error {
+	listSubnetsOptions := &vpcv1.ListSubnetsOptions{
+		VPCID: &a.vpcID,
+	}
+	start := ""
+	for {
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnets, response, err := a.sess.ListSubnetsWithContext(a.context, listSubnetsOptions)
+		if err != nil {
+			return fmt.Errorf("ListSubnetsWithContext failed: %s\n%s", err, response)
+		}
+		a.subnets = append(a.subnets, subnets.Subnets...)
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+// subnet returns the subnet of the VPC that contains all of the prefix.
+func (a *networkPathAnalyzer) subnet(prefix netip.Prefix) *vpcv1.Subnet {
+	for i := range a.subnets {
+		subnetPrefix, err := netip.ParsePrefix(flex.StringValue(a.subnets[i].Ipv4CIDRBlock))
+		if err != nil {
+			continue
+		}
+		if subnetPrefix.Bits() <= prefix.Bits() && subnetPrefix.Contains(prefix.Addr()) {
+			return &a.subnets[i]
+		}
+	}
+	return nil
+}
+
+func (a *networkPathAnalyzer) subnetByID(id string) *vpcv1.Subnet {
+	for i := range a.subnets {
+		if flex.StringValue(a.subnets[i].ID) == id {
+			return &a.subnets[i]
+		}
+	}
+	return nil
+}
+
+func (a *networkPathAnalyzer) resolveSource(d *schema.ResourceData) (networkPathEndpoint, error) {
+	var target *networkPathTarget
+	var err error
+	switch {
+	case d.Get("source.0.instance").(string) != "":
+		target, err = a.instanceTarget(d.Get("source.0.instance").(string))
+	case d.Get("source.0.virtual_network_interface").(string) != "":
+		target, err = a.virtualNetworkInterfaceTarget(d.Get("source.0.virtual_network_interface").(string))
+	case d.Get("source.0.subnet").(string) != "":
+		subnetID := d.Get("source.0.subnet").(string)
+		subnet := a.subnetByID(subnetID)
+		if subnet == nil {
+			return networkPathEndpoint{}, fmt.Errorf("the subnet %s is not in the VPC %s", subnetID, a.vpcID)
+		}
+		prefix, err := netip.ParsePrefix(flex.StringValue(subnet.Ipv4CIDRBlock))
+		if err != nil {
+			return networkPathEndpoint{}, fmt.Errorf("the subnet %s has an invalid CIDR block: %s", subnetID, err)
+		}
+		return networkPathEndpoint{Prefix: prefix.Masked(), Subnet: subnet}, nil
+	default:
+		cidr := d.Get("source.0.cidr").(string)
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil || !prefix.Addr().Is4() {
+			return networkPathEndpoint{}, fmt.Errorf("the source %s is not an IPv4 CIDR block", cidr)
+		}
+		prefix = prefix.Masked()
+		subnet := a.subnet(prefix)
+		if subnet == nil {
+			return networkPathEndpoint{}, fmt.Errorf("the source %s is not in a subnet of the VPC %s", cidr, a.vpcID)
+		}
+		return networkPathEndpoint{Prefix: prefix, Subnet: subnet}, nil
+	}
+	if err != nil {
+		return networkPathEndpoint{}, err
+	}
+
+	subnet := a.subnetByID(target.SubnetID)
+	if subnet == nil {
+		return networkPathEndpoint{}, fmt.Errorf("the %s %s is not in the VPC %s", target.ResourceType, target.ID, a.vpcID)
+	}
+	address, err := netip.ParseAddr(target.Address)
+	if err != nil {
+		return networkPathEndpoint{}, fmt.Errorf("the %s %s has an invalid address %q", target.ResourceType, target.ID, target.Address)
+	}
+	return networkPathEndpoint{Prefix: netip.PrefixFrom(address, address.BitLen()), Subnet: subnet, Target: target}, nil
+}
+
+func (a *networkPathAnalyzer) resolveDestination(destination string) (networkPathEndpoint, error) {
+	address, err := netip.ParseAddr(destination)
+	if err != nil || !address.Is4() {
+		return networkPathEndpoint{}, fmt.Errorf("the destination %s is not an IPv4 address", destination)
+	}
+	endpoint := networkPathEndpoint{Prefix: netip.PrefixFrom(address, address.BitLen())}
+	endpoint.Subnet = a.subnet(endpoint.Prefix)
+	if endpoint.Subnet == nil {
+		return endpoint, nil
+	}
+	endpoint.Target, err = a.findTarget(destination)
+	return endpoint, err
+}
+
+func (a *networkPathAnalyzer) instanceTarget(instanceID string) (*networkPathTarget, error) {
+	getInstanceOptions := &vpcv1.GetInstanceOptions{
+		ID: &instanceID,
+	}
+	instance, response, err := a.sess.GetInstanceWithContext(a.context, getInstanceOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetInstanceWithContext failed: %s\n%s", err, response)
+	}
+	if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.VirtualNetworkInterface != nil {
+		return a.virtualNetworkInterfaceTarget(*instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID)
+	}
+	if instance.PrimaryNetworkInterface == nil {
+		return nil, fmt.Errorf("the instance %s has no primary network attachment or interface", instanceID)
+	}
+	return a.networkInterfaceTarget(instanceID, *instance.PrimaryNetworkInterface.ID)
+}
+
+func (a *networkPathAnalyzer) virtualNetworkInterfaceTarget(id string) (*networkPathTarget, error) {
+	getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
+		ID: &id,
+	}
+	vni, response, err := a.sess.GetVirtualNetworkInterfaceWithContext(a.context, getVirtualNetworkInterfaceOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed: %s\n%s", err, response)
+	}
+	target := &networkPathTarget{
+		ResourceType:   "virtual_network_interface",
+		ID:             flex.StringValue(vni.ID),
+		Name:           flex.StringValue(vni.Name),
+		SecurityGroups: vni.SecurityGroups,
+	}
+	if vni.PrimaryIP != nil {
+		target.Address = flex.StringValue(vni.PrimaryIP.Address)
+	}
+	if vni.Subnet != nil {
+		target.SubnetID = flex.StringValue(vni.Subnet.ID)
+	}
+
+	listFloatingIpsOptions := &vpcv1.ListFloatingIpsOptions{
+		TargetID: vni.ID,
+	}
+	floatingIPs, response, err := a.sess.ListFloatingIpsWithContext(a.context, listFloatingIpsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("ListFloatingIpsWithContext failed: %s\n%s", err, response)
+	}
+	if len(floatingIPs.FloatingIps) > 0 {
+		target.FloatingIPID = flex.StringValue(floatingIPs.FloatingIps[0].ID)
+		target.FloatingIPAddress = flex.StringValue(floatingIPs.FloatingIps[0].Address)
+	}
+	return target, nil
+}
+
+func (a *networkPathAnalyzer) networkInterfaceTarget(instanceID, id string) (*networkPathTarget, error) {
+	getInstanceNetworkInterfaceOptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
+		InstanceID: &instanceID,
+		ID:         &id,
+	}
+	nic, response, err := a.sess.GetInstanceNetworkInterfaceWithContext(a.context, getInstanceNetworkInterfaceOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetInstanceNetworkInterfaceWithContext failed: %s\n%s", err, response)
+	}
+	target := &networkPathTarget{
+		ResourceType:   "network_interface",
+		ID:             flex.StringValue(nic.ID),
+		Name:           flex.StringValue(nic.Name),
+		SecurityGroups: nic.SecurityGroups,
+	}
+	if nic.PrimaryIP != nil {
+		target.Address = flex.StringValue(nic.PrimaryIP.Address)
+	}
+	if nic.Subnet != nil {
+		target.SubnetID = flex.StringValue(nic.Subnet.ID)
+	}
+	if len(nic.FloatingIps) > 0 {
+		target.FloatingIPID = flex.StringValue(nic.FloatingIps[0].ID)
+		target.FloatingIPAddress = flex.StringValue(nic.FloatingIps[0].Address)
+	}
+	return target, nil
+}
+
+// findTarget returns the virtual network interface or the instance network
+// interface in the VPC that has the address, or nil if there is none.
+func (a *networkPathAnalyzer) findTarget(address string) (*networkPathTarget, error) {
+	listVirtualNetworkInterfacesOptions := &vpcv1.ListVirtualNetworkInterfacesOptions{}
+	start := ""
+	for {
+		if start != "" {
+			listVirtualNetworkInterfacesOptions.Start = &start
+		}
+		vnis, response, err := a.sess.ListVirtualNetworkInterfacesWithContext(a.context, listVirtualNetworkInterfacesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListVirtualNetworkInterfacesWithContext failed: %s\n%s", err, response)
+		}
+		for _, vni := range vnis.VirtualNetworkInterfaces {
+			if vni.VPC == nil || flex.StringValue(vni.VPC.ID) != a.vpcID {
+				continue
+			}
+			for _, ip := range vni.Ips {
+				if flex.StringValue(ip.Address) == address {
+					return a.virtualNetworkInterfaceTarget(*vni.ID)
+				}
+			}
+			if vni.PrimaryIP != nil && flex.StringValue(vni.PrimaryIP.Address) == address {
+				return a.virtualNetworkInterfaceTarget(*vni.ID)
+			}
+		}
+		start = flex.GetNext(vnis.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	listInstancesOptions := &vpcv1.ListInstancesOptions{
+		VPCID: &a.vpcID,
+	}
+	start = ""
+	for {
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := a.sess.ListInstancesWithContext(a.context, listInstancesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListInstancesWithContext failed: %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			for _, nic := range instance.NetworkInterfaces {
+				if nic.PrimaryIP != nil && flex.StringValue(nic.PrimaryIP.Address) == address {
+					return a.networkInterfaceTarget(*instance.ID, *nic.ID)
+				}
+			}
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			return nil, nil
+		}
+	}
+}
+
+func (a *networkPathAnalyzer) getNetworkACLRules(networkACLID string) ([]networkPathRule, error) {
+	if rules, ok := a.networkACLRules[networkACLID]; ok {
+		return rules, nil
+	}
+	listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+		NetworkACLID: &networkACLID,
+	}
+	rules := []networkPathRule{}
+	start := ""
+	for {
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		ruleList, response, err := a.sess.ListNetworkACLRulesWithContext(a.context, listNetworkACLRulesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListNetworkACLRulesWithContext failed: %s\n%s", err, response)
+		}
+		for _, rule := range ruleList.Rules {
+			rules = append(rules, networkPathRuleFromNetworkACLRule(rule))
+		}
+		start = flex.GetNext(ruleList.Next)
+		if start == "" {
+			break
+		}
+	}
+	a.networkACLRules[networkACLID] = rules
+	return rules, nil
+}
+
+func (a *networkPathAnalyzer) getSecurityGroupRules(securityGroupID string) ([]networkPathRule, error) {
+	if rules, ok := a.securityGroupRules[securityGroupID]; ok {
+		return rules, nil
+	}
+	listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+		SecurityGroupID: &securityGroupID,
+	}
+	ruleList, response, err := a.sess.ListSecurityGroupRulesWithContext(a.context, listSecurityGroupRulesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("ListSecurityGroupRulesWithContext failed: %s\n%s", err, response)
+	}
+	rules := make([]networkPathRule, 0, len(ruleList.Rules))
+	for _, rule := range ruleList.Rules {
+		rules = append(rules, networkPathRuleFromSecurityGroupRule(rule))
+	}
+	a.securityGroupRules[securityGroupID] = rules
+	return rules, nil
+}
+
+func (a *networkPathAnalyzer) listRoutes(routingTableID string) ([]vpcv1.Route, error) {
+	listVPCRoutingTableRoutesOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{
+		VPCID:          &a.vpcID,
+		RoutingTableID: &routingTableID,
+	}
+	routes := []vpcv1.Route{}
+	start := ""
+	for {
+		if start != "" {
+			listVPCRoutingTableRoutesOptions.Start = &start
+		}
+		routeList, response, err := a.sess.ListVPCRoutingTableRoutesWithContext(a.context, listVPCRoutingTableRoutesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListVPCRoutingTableRoutesWithContext failed: %s\n%s", err, response)
+		}
+		routes = append(routes, routeList.Routes...)
+		start = flex.GetNext(routeList.Next)
+		if start == "" {
+			return routes, nil
+		}
+	}
+}
+
+// analyze evaluates every hop of the path from the source to the destination.
+// Hops after a hop that denies the traffic are still evaluated, so that every
+// problem of the path is reported at once.
+func (a *networkPathAnalyzer) analyze(source, destination networkPathEndpoint, f networkPathFlow) ([]networkPathHop, error) {
+	hops := []networkPathHop{}
+	sameSubnet := destination.Subnet != nil && flex.StringValue(destination.Subnet.ID) == flex.StringValue(source.Subnet.ID)
+
+	hop, err := a.securityGroupsHop("source_security_groups", source.Target, "outbound", f)
+	if err != nil {
+		return nil, err
+	}
+	hops = append(hops, hop)
+
+	if !sameSubnet {
+		hop, err = a.networkACLHop("source_network_acl", source.Subnet, "outbound", f)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+	}
+
+	hop, err = a.routeHop(source, destination)
+	if err != nil {
+		return nil, err
+	}
+	hops = append(hops, hop)
+
+	if destination.Subnet == nil {
+		hops = append(hops, a.gatewayHop(source, destination))
+	} else {
+		if !sameSubnet {
+			hop, err = a.networkACLHop("destination_network_acl", destination.Subnet, "inbound", f)
+			if err != nil {
+				return nil, err
+			}
+			hops = append(hops, hop)
+		}
+
+		hop, err = a.securityGroupsHop("destination_security_groups", destination.Target, "inbound", f)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+	}
+
+	// Security groups are stateful, but network ACLs are not, so they must
+	// allow the response as well.
+	response, ok := f.response()
+	if !ok || sameSubnet {
+		return hops, nil
+	}
+	if destination.Subnet != nil {
+		hop, err = a.networkACLHop("destination_network_acl_response", destination.Subnet, "outbound", response)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+	}
+	hop, err = a.networkACLHop("source_network_acl_response", source.Subnet, "inbound", response)
+	if err != nil {
+		return nil, err
+	}
+	return append(hops, hop), nil
+}
+
+func (a *networkPathAnalyzer) securityGroupsHop(hopType string, target *networkPathTarget, direction string, f networkPathFlow) (networkPathHop, error) {
+	if target == nil {
+		hop := networkPathHop{Type: hopType}
+		if hopType == "source_security_groups" {
+			return hop.withVerdict(isNetworkPathVerdictSkipped, "The source is a subnet or a CIDR block, which has no security groups."), nil
+		}
+		return hop.withVerdict(isNetworkPathVerdictSkipped, "No network interface in the VPC has the destination address."), nil
+	}
+
+	hop := networkPathHop{
+		Type:         hopType,
+		ResourceType: target.ResourceType,
+		ResourceID:   target.ID,
+		ResourceName: target.Name,
+	}
+	for _, securityGroup := range target.SecurityGroups {
+		rules, err := a.getSecurityGroupRules(flex.StringValue(securityGroup.ID))
+		if err != nil {
+			return hop, err
+		}
+		for _, rule := range rules {
+			if rule.Direction != direction {
+				continue
+			}
+			if all, _ := rule.match(f); all {
+				return hop.withRule(isNetworkPathVerdictAllow, rule.ID, rule.Name, fmt.Sprintf("The %s traffic is allowed by a rule of the security group %s.", direction, flex.StringValue(securityGroup.Name))), nil
+			}
+		}
+	}
+	return hop.withVerdict(isNetworkPathVerdictDeny, fmt.Sprintf("No rule of the security groups of the %s allows all of the %s traffic.", target.ResourceType, direction)), nil
+}
+
+func (a *networkPathAnalyzer) networkACLHop(hopType string, subnet *vpcv1.Subnet, direction string, f networkPathFlow) (networkPathHop, error) {
+	hop := networkPathHop{
+		Type:         hopType,
+		ResourceType: "network_acl",
+	}
+	if subnet.NetworkACL == nil {
+		return hop.withVerdict(isNetworkPathVerdictSkipped, fmt.Sprintf("The subnet %s has no network ACL.", flex.StringValue(subnet.Name))), nil
+	}
+	hop.ResourceID = flex.StringValue(subnet.NetworkACL.ID)
+	hop.ResourceName = flex.StringValue(subnet.NetworkACL.Name)
+
+	rules, err := a.getNetworkACLRules(hop.ResourceID)
+	if err != nil {
+		return hop, err
+	}
+	return evaluateNetworkPathACL(hop, rules, direction, f), nil
+}
+
+func (a *networkPathAnalyzer) routeHop(source, destination networkPathEndpoint) (networkPathHop, error) {
+	hop := networkPathHop{
+		Type:         "routing_table",
+		ResourceType: "routing_table",
+	}
+	if source.Subnet.RoutingTable == nil {
+		return hop.withVerdict(isNetworkPathVerdictSkipped, "The source subnet has no routing table."), nil
+	}
+	hop.ResourceID = flex.StringValue(source.Subnet.RoutingTable.ID)
+	hop.ResourceName = flex.StringValue(source.Subnet.RoutingTable.Name)
+
+	routes, err := a.listRoutes(hop.ResourceID)
+	if err != nil {
+		return hop, err
+	}
+	route := selectNetworkPathRoute(routes, flex.StringValue(source.Subnet.Zone.Name), destination.Prefix.Addr())
+	if route == nil {
+		return hop.withVerdict(isNetworkPathVerdictAllow, "No route matches the destination, so the traffic is routed by the system routes of the VPC."), nil
+	}
+
+	routeID, routeName := flex.StringValue(route.ID), flex.StringValue(route.Name)
+	switch flex.StringValue(route.Action) {
+	case "drop":
+		return hop.withRule(isNetworkPathVerdictDeny, routeID, routeName, "The traffic is dropped by a route of the routing table."), nil
+	case "deliver":
+		nextHop := ""
+		if next, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && next != nil {
+			nextHop = flex.StringValue(next.Address)
+		}
+		return hop.withRule(isNetworkPathVerdictAllow, routeID, routeName, fmt.Sprintf("The traffic is delivered to the next hop %s by a route of the routing table. The next hop itself is not analyzed.", nextHop)), nil
+	default:
+		return hop.withRule(isNetworkPathVerdictAllow, routeID, routeName, "The traffic is delegated to the system routes of the VPC by a route of the routing table."), nil
+	}
+}
+
+// networkPathServicePrefixes are the IBM Cloud service endpoints, which are
+// reachable from a VPC without a public gateway.
+var networkPathServicePrefixes = []netip.Prefix{
+	netip.MustParsePrefix("161.26.0.0/16"),
+	netip.MustParsePrefix("166.8.0.0/14"),
+}
+
+// gatewayHop evaluates how the traffic leaves the VPC when the destination is
+// not in a subnet of the VPC.
+func (a *networkPathAnalyzer) gatewayHop(source, destination networkPathEndpoint) networkPathHop {
+	hop := networkPathHop{Type: "gateway"}
+	address := destination.Prefix.Addr()
+	for _, prefix := range networkPathServicePrefixes {
+		if prefix.Contains(address) {
+			return hop.withVerdict(isNetworkPathVerdictAllow, "The destination is an IBM Cloud service endpoint, which is reachable without a public gateway.")
+		}
+	}
+	if address.IsPrivate() || netip.MustParsePrefix("100.64.0.0/10").Contains(address) {
+		return hop.withVerdict(isNetworkPathVerdictSkipped, "The destination is a private address outside of the VPC. Connectivity through a Transit Gateway, Direct Link or VPN gateway is not analyzed.")
+	}
+
+	if source.Target != nil && source.Target.FloatingIPID != "" {
+		hop.ResourceType, hop.ResourceID, hop.ResourceName = "floating_ip", source.Target.FloatingIPID, source.Target.FloatingIPAddress
+		return hop.withVerdict(isNetworkPathVerdictAllow, "The traffic reaches the internet through the floating IP of the source.")
+	}
+	if source.Subnet.PublicGateway != nil {
+		hop.ResourceType = "public_gateway"
+		hop.ResourceID = flex.StringValue(source.Subnet.PublicGateway.ID)
+		hop.ResourceName = flex.StringValue(source.Subnet.PublicGateway.Name)
+		return hop.withVerdict(isNetworkPathVerdictAllow, "The traffic reaches the internet through the public gateway of the source subnet.")
+	}
+	return hop.withVerdict(isNetworkPathVerdictDeny, "The destination is on the internet, but the source subnet has no public gateway and the source has no floating IP.")
+}
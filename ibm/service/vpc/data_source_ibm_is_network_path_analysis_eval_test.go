@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetworkPathTCPFlow(source, destination string, port int64) networkPathFlow {
+	return networkPathFlow{
+		Protocol:         "tcp",
+		Source:           netip.MustParsePrefix(source),
+		Destination:      netip.MustParsePrefix(destination),
+		SourcePorts:      networkPathAllPorts,
+		DestinationPorts: networkPathPorts{port, port},
+	}
+}
+
+func TestNetworkPathRuleMatch(t *testing.T) {
+	flow := testNetworkPathTCPFlow("10.240.0.0/24", "10.240.64.4/32", 443)
+	icmpFlow := networkPathFlow{
+		Protocol:    "icmp",
+		Source:      netip.MustParsePrefix("10.240.0.4/32"),
+		Destination: netip.MustParsePrefix("10.240.64.4/32"),
+	}
+	sgFlow := flow
+	sgFlow.SourceSecurityGroups = map[string]bool{"sg-1": true}
+
+	testcases := []struct {
+		description string
+		rule        networkPathRule
+		flow        networkPathFlow
+		all         bool
+		any         bool
+	}{
+		{
+			description: "When the rule is for all protocols and addresses, Expect all of the traffic to match",
+			rule:        networkPathRule{Protocol: "all", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        flow,
+			all:         true,
+			any:         true,
+		},
+		{
+			description: "When the protocol of the rule differs, Expect no traffic to match",
+			rule:        networkPathRule{Protocol: "udp", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        flow,
+		},
+		{
+			description: "When the source CIDR block of the rule contains the source subnet, Expect all of the traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", Source: "10.240.0.0/16", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        flow,
+			all:         true,
+			any:         true,
+		},
+		{
+			description: "When the source CIDR block of the rule is part of the source subnet, Expect some of the traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", Source: "10.240.0.0/25", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        flow,
+			any:         true,
+		},
+		{
+			description: "When the source address of the rule is in the source subnet, Expect some of the traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", Source: "10.240.0.4", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        flow,
+			any:         true,
+		},
+		{
+			description: "When the destination port range of the rule contains the port, Expect all of the traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathPorts{443, 8443}},
+			flow:        flow,
+			all:         true,
+			any:         true,
+		},
+		{
+			description: "When the destination port range of the rule does not contain the port, Expect no traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathPorts{22, 22}},
+			flow:        flow,
+		},
+		{
+			description: "When the remote of the rule is a security group of the source, Expect all of the traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", Source: "sg-1", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        sgFlow,
+			all:         true,
+			any:         true,
+		},
+		{
+			description: "When the remote of the rule is another security group, Expect no traffic to match",
+			rule:        networkPathRule{Protocol: "tcp", Source: "sg-2", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts},
+			flow:        sgFlow,
+		},
+		{
+			description: "When the rule has an ICMP type and the flow has none, Expect some of the traffic to match",
+			rule:        networkPathRule{Protocol: "icmp", ICMPType: core.Int64Ptr(8)},
+			flow:        icmpFlow,
+			any:         true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			all, any := tc.rule.match(tc.flow)
+			require.Equal(t, tc.all, all, "all")
+			require.Equal(t, tc.any, any, "any")
+		})
+	}
+}
+
+func TestEvaluateNetworkPathACL(t *testing.T) {
+	flow := testNetworkPathTCPFlow("10.240.0.0/24", "10.240.64.4/32", 443)
+	allowAll := networkPathRule{ID: "allow-all", Action: "allow", Direction: "inbound", Protocol: "all", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts}
+	denyAll := networkPathRule{ID: "deny-all", Action: "deny", Direction: "inbound", Protocol: "all", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts}
+	denyHost := networkPathRule{ID: "deny-host", Action: "deny", Direction: "inbound", Protocol: "all", Source: "10.240.0.4", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts}
+	allowHalf := networkPathRule{ID: "allow-half", Action: "allow", Direction: "inbound", Protocol: "all", Source: "10.240.0.0/25", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts}
+	allowOutbound := networkPathRule{ID: "allow-outbound", Action: "allow", Direction: "outbound", Protocol: "all", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathAllPorts}
+
+	testcases := []struct {
+		description string
+		rules       []networkPathRule
+		verdict     string
+		ruleID      string
+	}{
+		{
+			description: "When an allow rule comes before a deny rule, Expect the traffic to be allowed",
+			rules:       []networkPathRule{allowAll, denyAll},
+			verdict:     isNetworkPathVerdictAllow,
+			ruleID:      "allow-all",
+		},
+		{
+			description: "When a deny rule comes before an allow rule, Expect the traffic to be denied",
+			rules:       []networkPathRule{denyAll, allowAll},
+			verdict:     isNetworkPathVerdictDeny,
+			ruleID:      "deny-all",
+		},
+		{
+			description: "When a deny rule matches some of the traffic, Expect the traffic to be denied",
+			rules:       []networkPathRule{denyHost, allowAll},
+			verdict:     isNetworkPathVerdictDeny,
+			ruleID:      "deny-host",
+		},
+		{
+			description: "When an allow rule matches some of the traffic, Expect the next rules to be evaluated",
+			rules:       []networkPathRule{allowHalf, allowAll},
+			verdict:     isNetworkPathVerdictAllow,
+			ruleID:      "allow-all",
+		},
+		{
+			description: "When only rules of the other direction allow the traffic, Expect the traffic to be denied",
+			rules:       []networkPathRule{allowOutbound},
+			verdict:     isNetworkPathVerdictDeny,
+		},
+		{
+			description: "When no rule allows all of the traffic, Expect the traffic to be denied",
+			rules:       []networkPathRule{allowHalf},
+			verdict:     isNetworkPathVerdictDeny,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			hop := evaluateNetworkPathACL(networkPathHop{Type: "destination_network_acl"}, tc.rules, "inbound", flow)
+			require.Equal(t, tc.verdict, hop.Verdict)
+			require.Equal(t, tc.ruleID, hop.RuleID)
+		})
+	}
+}
+
+func TestNetworkPathFlowResponse(t *testing.T) {
+	flow := testNetworkPathTCPFlow("10.240.0.0/24", "10.240.64.4/32", 443)
+	flow.SourceSecurityGroups = map[string]bool{"sg-1": true}
+
+	response, ok := flow.response()
+	require.True(t, ok)
+	require.Equal(t, flow.Destination, response.Source)
+	require.Equal(t, flow.Source, response.Destination)
+	require.Equal(t, networkPathPorts{443, 443}, response.SourcePorts)
+	require.Equal(t, networkPathAllPorts, response.DestinationPorts)
+	require.Equal(t, map[string]bool{"sg-1": true}, response.DestinationSecurityGroups)
+
+	// Network ACLs are stateless, so a rule that only allows the request to
+	// port 443 does not allow the response from port 443.
+	requestOnly := []networkPathRule{
+		{ID: "allow-https", Action: "allow", Direction: "inbound", Protocol: "tcp", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathPorts{443, 443}},
+		{ID: "allow-https-out", Action: "allow", Direction: "outbound", Protocol: "tcp", SourcePorts: networkPathAllPorts, DestinationPorts: networkPathPorts{443, 443}},
+	}
+	hop := evaluateNetworkPathACL(networkPathHop{}, requestOnly, "inbound", flow)
+	require.Equal(t, isNetworkPathVerdictAllow, hop.Verdict)
+	hop = evaluateNetworkPathACL(networkPathHop{}, requestOnly, "outbound", response)
+	require.Equal(t, isNetworkPathVerdictDeny, hop.Verdict)
+
+	withResponse := append(requestOnly, networkPathRule{ID: "allow-https-response", Action: "allow", Direction: "outbound", Protocol: "tcp", SourcePorts: networkPathPorts{443, 443}, DestinationPorts: networkPathAllPorts})
+	hop = evaluateNetworkPathACL(networkPathHop{}, withResponse, "outbound", response)
+	require.Equal(t, isNetworkPathVerdictAllow, hop.Verdict)
+	require.Equal(t, "allow-https-response", hop.RuleID)
+
+	// The response of an ICMP echo request is an echo reply, and other ICMP
+	// traffic has no response.
+	echoRequest := networkPathFlow{Protocol: "icmp", ICMPType: core.Int64Ptr(8), ICMPCode: core.Int64Ptr(0)}
+	response, ok = echoRequest.response()
+	require.True(t, ok)
+	require.Equal(t, int64(0), *response.ICMPType)
+	require.Nil(t, response.ICMPCode)
+
+	_, ok = networkPathFlow{Protocol: "icmp", ICMPType: core.Int64Ptr(3)}.response()
+	require.False(t, ok)
+	_, ok = networkPathFlow{Protocol: "icmp"}.response()
+	require.False(t, ok)
+}
+
+func TestSelectNetworkPathRoute(t *testing.T) {
+	route := func(name, destination, zone string, priority int64) vpcv1.Route {
+		return vpcv1.Route{
+			Name:        core.StringPtr(name),
+			Destination: core.StringPtr(destination),
+			Zone:        &vpcv1.ZoneReference{Name: core.StringPtr(zone)},
+			Priority:    core.Int64Ptr(priority),
+		}
+	}
+	routes := []vpcv1.Route{
+		route("default", "0.0.0.0/0", "us-south-1", 2),
+		route("on-prem", "10.0.0.0/8", "us-south-1", 2),
+		route("firewall", "10.240.0.0/16", "us-south-1", 2),
+		route("firewall-preferred", "10.240.0.0/16", "us-south-1", 1),
+		route("other-zone", "10.240.64.0/24", "us-south-2", 0),
+	}
+
+	testcases := []struct {
+		description string
+		zone        string
+		destination string
+		expected    string
+	}{
+		{
+			description: "When several routes contain the destination, Expect the route with the longest prefix",
+			zone:        "us-south-1",
+			destination: "10.1.0.4",
+			expected:    "on-prem",
+		},
+		{
+			description: "When routes have the same prefix, Expect the route with the highest priority",
+			zone:        "us-south-1",
+			destination: "10.240.64.4",
+			expected:    "firewall-preferred",
+		},
+		{
+			description: "When only the default route contains the destination, Expect the default route",
+			zone:        "us-south-1",
+			destination: "192.168.0.1",
+			expected:    "default",
+		},
+		{
+			description: "When the routes of the zone contain the destination, Expect the routes of other zones to be ignored",
+			zone:        "us-south-2",
+			destination: "10.240.64.4",
+			expected:    "other-zone",
+		},
+		{
+			description: "When no route of the zone contains the destination, Expect no route",
+			zone:        "us-south-3",
+			destination: "10.240.64.4",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			selected := selectNetworkPathRoute(routes, tc.zone, netip.MustParseAddr(tc.destination))
+			if tc.expected == "" {
+				require.Nil(t, selected)
+				return
+			}
+			require.NotNil(t, selected)
+			require.Equal(t, tc.expected, *selected.Name)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsNetworkPathAnalysisDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfpath-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfpath-subnet-%d", acctest.RandIntRange(10, 100))
+	sgname := fmt.Sprintf("tfpath-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsNetworkPathAnalysisDataSourceConfig(vpcname, subnetname, sgname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "reason", ""),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "hops.0.type", "source_security_groups"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "hops.0.verdict", "allow"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_network_path_analysis.https", "hops.0.rule_id"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.ssh", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.ssh", "hops.0.verdict", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.internet", "allowed", "false"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_network_path_analysis.internet", "reason"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsNetworkPathAnalysisDataSourceConfig(vpcname, subnetname, sgname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule" {
+		group     = ibm_is_security_group.testacc_security_group.id
+		direction = "outbound"
+		remote    = "0.0.0.0/0"
+		protocol  = "tcp"
+		port_min  = 443
+		port_max  = 443
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni" {
+		name            = "%s-vni"
+		subnet          = ibm_is_subnet.testacc_subnet.id
+		security_groups = [ibm_is_security_group.testacc_security_group.id]
+	}
+
+	data "ibm_is_network_path_analysis" "https" {
+		vpc = ibm_is_vpc.testacc_vpc.id
+		source {
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni.id
+		}
+		destination {
+			address  = "10.10.10.10"
+			protocol = "tcp"
+			port     = 443
+		}
+		depends_on = [ibm_is_security_group_rule.testacc_security_group_rule]
+	}
+
+	data "ibm_is_network_path_analysis" "ssh" {
+		vpc = ibm_is_vpc.testacc_vpc.id
+		source {
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni.id
+		}
+		destination {
+			address  = "10.10.10.10"
+			protocol = "tcp"
+			port     = 22
+		}
+		depends_on = [ibm_is_security_group_rule.testacc_security_group_rule]
+	}
+
+	data "ibm_is_network_path_analysis" "internet" {
+		vpc = ibm_is_vpc.testacc_vpc.id
+		source {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		destination {
+			address  = "8.8.8.8"
+			protocol = "udp"
+			port     = 53
+		}
+	}`, vpcname, subnetname, acc.ISZoneName, sgname, sgname)
+}
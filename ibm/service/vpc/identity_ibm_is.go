@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// vpcResourceIdentity returns the identity of a regional VPC resource, which
+// is made of the ID of the resource and the region of the provider.
+func vpcResourceIdentity(description string) flex.ResourceIdentity {
+	return flex.ResourceIdentity{
+		Attributes: []flex.IdentityAttribute{
+			{Name: "id", Description: description},
+		},
+		Regional: true,
+	}
+}
+
+// vpcChildResourceIdentity returns the identity of a regional VPC resource
+// that belongs to a parent resource, such as a load balancer pool. Its ID is
+// made of the ID of the parent and the ID of the resource.
+func vpcChildResourceIdentity(parent, parentDescription, description string) flex.ResourceIdentity {
+	return flex.ResourceIdentity{
+		Attributes: []flex.IdentityAttribute{
+			{Name: parent, Description: parentDescription},
+			{Name: "id", Description: description},
+		},
+		Regional: true,
+	}
+}
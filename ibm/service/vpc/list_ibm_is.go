@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vpcListItem is a VPC resource returned by a List API.
+type vpcListItem struct {
+	ID              string
+	Name            string
+	CRN             string
+	ResourceGroupID string
+}
+
+func newVPCListItem(id, name, crn *string, resourceGroup *vpcv1.ResourceGroupReference) vpcListItem {
+	return vpcListItem{
+		ID:              *id,
+		Name:            *name,
+		CRN:             *crn,
+		ResourceGroupID: vpcResourceGroupID(resourceGroup),
+	}
+}
+
+// vpcListPageFunc calls a VPC List API for the page that begins at start,
+// and returns the items of the page and the start of the next page. The
+// resource group is passed to the API when it supports filtering by resource
+// group, and is also filtered on afterwards.
+type vpcListPageFunc func(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error)
+
+var (
+	_ list.ListResource                 = &vpcListResource{}
+	_ list.ListResourceWithConfigure    = &vpcListResource{}
+	_ list.ListResourceWithRawV6Schemas = &vpcListResource{}
+)
+
+// vpcListResource is a list resource of a VPC resource, which is implemented
+// with the SDKv2.
+type vpcListResource struct {
+	flex.SDKv2ListResource
+
+	typeName    string
+	description string
+	listPage    vpcListPageFunc
+	session     conns.ClientSession
+}
+
+// newVPCListResource returns the list resource of the VPC resource typeName,
+// which is implemented with the SDKv2 and has the identity.
+func newVPCListResource(typeName, description string, resource func() *sdkschema.Resource, identity flex.ResourceIdentity, listPage vpcListPageFunc) list.ListResource {
+	return &vpcListResource{
+		SDKv2ListResource: flex.SDKv2ListResource{
+			Resource: resource,
+			Identity: identity,
+		},
+		typeName:    typeName,
+		description: description,
+		listPage:    listPage,
+	}
+}
+
+type vpcListResourceModel struct {
+	ResourceGroup types.String `tfsdk:"resource_group"`
+	Tags          types.List   `tfsdk:"tags"`
+}
+
+func (r *vpcListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *vpcListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes: map[string]schema.Attribute{
+			"resource_group": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the resource group. Only resources in the resource group are listed.",
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only resources that have all of the user tags are listed.",
+			},
+		},
+	}
+}
+
+func (r *vpcListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+func (r *vpcListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config vpcListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	client, err := r.session.VpcV1API()
+	if err != nil {
+		diags.AddError("Unable to Create VPC Client", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Tags are not returned by the VPC List APIs, so the CRNs of the tagged
+	// resources are searched first.
+	var taggedCRNs map[string]bool
+	if !config.Tags.IsNull() {
+		var tags []string
+		diags.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		if len(tags) > 0 {
+			taggedCRNs, err = searchCRNsByTags(ctx, r.session, tags)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, err.Error(), r.typeName, "list")
+				diags.AddError("Unable to Search Tagged Resources", tfErr.GetConsoleMessage())
+				stream.Results = list.ListResultsStreamDiagnostics(diags)
+				return
+			}
+		}
+	}
+
+	resourceGroupID := config.ResourceGroup.ValueString()
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		page := func(start string) ([]vpcListItem, string, error) {
+			return r.listPage(ctx, client, resourceGroupID, start)
+		}
+		err := flex.ListPages(page, func(item vpcListItem) bool {
+			if resourceGroupID != "" && item.ResourceGroupID != resourceGroupID {
+				return true
+			}
+			if taggedCRNs != nil && !taggedCRNs[item.CRN] {
+				return true
+			}
+			if !push(r.NewListResult(ctx, req, r.session, item.ID, item.Name)) {
+				return false
+			}
+			count++
+			return req.Limit == 0 || count < req.Limit
+		})
+		if err != nil {
+			detail := err.Error()
+			var tfErr *flex.TerraformProblem
+			if errors.As(err, &tfErr) {
+				detail = tfErr.GetConsoleMessage()
+			}
+			var listDiags diag.Diagnostics
+			listDiags.AddError(fmt.Sprintf("Unable to List %s Resources", r.typeName), detail)
+			push(list.ListResult{Diagnostics: listDiags})
+		}
+	}
+}
+
+// searchCRNsByTags returns the CRNs of the resources that have all of the
+// user tags.
+func searchCRNsByTags(ctx context.Context, session conns.ClientSession, tags []string) (map[string]bool, error) {
+	gsClient, err := session.GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	terms := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		terms = append(terms, fmt.Sprintf("tags:%q", tag))
+	}
+	options := &globalsearchv2.SearchOptions{}
+	options.SetQuery(strings.Join(terms, " AND "))
+	options.SetFields([]string{"crn"})
+	options.SetLimit(1000)
+
+	crns := map[string]bool{}
+	for {
+		result, response, err := gsClient.SearchWithContext(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error searching the resources with tags %s: %s %s", strings.Join(tags, ", "), err, response)
+		}
+		if len(result.Items) == 0 {
+			return crns, nil
+		}
+		for _, item := range result.Items {
+			if item.CRN != nil {
+				crns[*item.CRN] = true
+			}
+		}
+		if result.SearchCursor == nil {
+			return crns, nil
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+}
+
+func vpcResourceGroupID(resourceGroup *vpcv1.ResourceGroupReference) string {
+	if resourceGroup == nil || resourceGroup.ID == nil {
+		return ""
+	}
+	return *resourceGroup.ID
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIBMIsFloatingIPListResource returns the list resource of ibm_is_floating_ip, which lists the floating IPs of the region.
+func NewIBMIsFloatingIPListResource() list.ListResource {
+	return newVPCListResource("ibm_is_floating_ip", "Lists the floating IPs of the region.", ResourceIBMISFloatingIP, ibmIsFloatingIPIdentity, listIBMIsFloatingIPsPage)
+}
+
+func listIBMIsFloatingIPsPage(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListFloatingIpsOptions{}
+	if resourceGroupID != "" {
+		options.ResourceGroupID = &resourceGroupID
+	}
+	if start != "" {
+		options.Start = &start
+	}
+	collection, _, err := client.ListFloatingIpsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("ListFloatingIpsWithContext failed: %s", err.Error()), "ibm_is_floating_ip", "list")
+	}
+	items := make([]vpcListItem, 0, len(collection.FloatingIps))
+	for _, floatingIP := range collection.FloatingIps {
+		items = append(items, newVPCListItem(floatingIP.ID, floatingIP.Name, floatingIP.CRN, floatingIP.ResourceGroup))
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIBMIsInstanceListResource returns the list resource of ibm_is_instance, which lists the instances of the region.
+func NewIBMIsInstanceListResource() list.ListResource {
+	return newVPCListResource("ibm_is_instance", "Lists the instances of the region.", ResourceIBMISInstance, ibmIsInstanceIdentity, listIBMIsInstancesPage)
+}
+
+func listIBMIsInstancesPage(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListInstancesOptions{}
+	if resourceGroupID != "" {
+		options.ResourceGroupID = &resourceGroupID
+	}
+	if start != "" {
+		options.Start = &start
+	}
+	collection, _, err := client.ListInstancesWithContext(ctx, options)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("ListInstancesWithContext failed: %s", err.Error()), "ibm_is_instance", "list")
+	}
+	items := make([]vpcListItem, 0, len(collection.Instances))
+	for _, instance := range collection.Instances {
+		items = append(items, newVPCListItem(instance.ID, instance.Name, instance.CRN, instance.ResourceGroup))
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
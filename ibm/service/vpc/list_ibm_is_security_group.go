@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIBMIsSecurityGroupListResource returns the list resource of ibm_is_security_group, which lists the security groups of the region.
+func NewIBMIsSecurityGroupListResource() list.ListResource {
+	return newVPCListResource("ibm_is_security_group", "Lists the security groups of the region.", ResourceIBMISSecurityGroup, ibmIsSecurityGroupIdentity, listIBMIsSecurityGroupsPage)
+}
+
+func listIBMIsSecurityGroupsPage(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListSecurityGroupsOptions{}
+	if resourceGroupID != "" {
+		options.ResourceGroupID = &resourceGroupID
+	}
+	if start != "" {
+		options.Start = &start
+	}
+	collection, _, err := client.ListSecurityGroupsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("ListSecurityGroupsWithContext failed: %s", err.Error()), "ibm_is_security_group", "list")
+	}
+	items := make([]vpcListItem, 0, len(collection.SecurityGroups))
+	for _, securityGroup := range collection.SecurityGroups {
+		items = append(items, newVPCListItem(securityGroup.ID, securityGroup.Name, securityGroup.CRN, securityGroup.ResourceGroup))
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIBMIsSubnetListResource returns the list resource of ibm_is_subnet, which lists the subnets of the region.
+func NewIBMIsSubnetListResource() list.ListResource {
+	return newVPCListResource("ibm_is_subnet", "Lists the subnets of the region.", ResourceIBMISSubnet, ibmIsSubnetIdentity, listIBMIsSubnetsPage)
+}
+
+func listIBMIsSubnetsPage(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListSubnetsOptions{}
+	if resourceGroupID != "" {
+		options.ResourceGroupID = &resourceGroupID
+	}
+	if start != "" {
+		options.Start = &start
+	}
+	collection, _, err := client.ListSubnetsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("ListSubnetsWithContext failed: %s", err.Error()), "ibm_is_subnet", "list")
+	}
+	items := make([]vpcListItem, 0, len(collection.Subnets))
+	for _, subnet := range collection.Subnets {
+		items = append(items, newVPCListItem(subnet.ID, subnet.Name, subnet.CRN, subnet.ResourceGroup))
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
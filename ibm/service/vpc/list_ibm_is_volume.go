@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIBMIsVolumeListResource returns the list resource of ibm_is_volume, which lists the volumes of the region.
+func NewIBMIsVolumeListResource() list.ListResource {
+	return newVPCListResource("ibm_is_volume", "Lists the volumes of the region.", ResourceIBMISVolume, ibmIsVolumeIdentity, listIBMIsVolumesPage)
+}
+
+func listIBMIsVolumesPage(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error) {
+	// The API does not filter volumes by resource group, so they are
+	// filtered by vpcListResource.
+	options := &vpcv1.ListVolumesOptions{}
+	if start != "" {
+		options.Start = &start
+	}
+	collection, _, err := client.ListVolumesWithContext(ctx, options)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("ListVolumesWithContext failed: %s", err.Error()), "ibm_is_volume", "list")
+	}
+	items := make([]vpcListItem, 0, len(collection.Volumes))
+	for _, volume := range collection.Volumes {
+		items = append(items, newVPCListItem(volume.ID, volume.Name, volume.CRN, volume.ResourceGroup))
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewIBMIsVPCListResource returns the list resource of ibm_is_vpc, which lists the VPCs of the region.
+func NewIBMIsVPCListResource() list.ListResource {
+	return newVPCListResource("ibm_is_vpc", "Lists the VPCs of the region.", ResourceIBMISVPC, ibmIsVPCIdentity, listIBMIsVPCsPage)
+}
+
+func listIBMIsVPCsPage(ctx context.Context, client *vpcv1.VpcV1, resourceGroupID, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListVpcsOptions{}
+	if resourceGroupID != "" {
+		options.ResourceGroupID = &resourceGroupID
+	}
+	if start != "" {
+		options.Start = &start
+	}
+	collection, _, err := client.ListVpcsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("ListVpcsWithContext failed: %s", err.Error()), "ibm_is_vpc", "list")
+	}
+	items := make([]vpcListItem, 0, len(collection.Vpcs))
+	for _, vpc := range collection.Vpcs {
+		items = append(items, newVPCListItem(vpc.ID, vpc.Name, vpc.CRN, vpc.ResourceGroup))
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -15,12 +15,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -90,8 +90,11 @@ const (
 	isBareMetalServerMetadataServiceProtocol             = "protocol"
 )
 
+// ibmIsBareMetalServerIdentity is the identity of ibm_is_bare_metal_server, which can be used to import it.
+var ibmIsBareMetalServerIdentity = vpcResourceIdentity("The unique identifier of the bare metal server.")
+
 func ResourceIBMIsBareMetalServer() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISBareMetalServerCreate,
 		ReadContext:   resourceIBMISBareMetalServerRead,
 		UpdateContext: resourceIBMISBareMetalServerUpdate,
@@ -1407,7 +1410,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsBareMetalServerIdentity)
 }
 
 func ResourceIBMIsBareMetalServerValidator() *validate.ResourceValidator {
@@ -2218,7 +2221,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	}
 	d.SetId(*bms.ID)
 	log.Printf("[INFO] Bare Metal Server : %s", *bms.ID)
-	_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForBareMetalServerAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2927,7 +2930,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			initializationPatch.DefaultTrustedProfile = defaultTrustedProfile
 		}
 
-		stopServerIfStartingForInitialization, err = resourceStopServerIfRunning(id, "hard", d, context, sess, stopServerIfStartingForInitialization)
+		stopServerIfStartingForInitialization, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, stopServerIfStartingForInitialization)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2939,14 +2942,14 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerStoppedOnReload(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerStoppedOnReload(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedOnReload failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 		if stopServerIfStartingForInitialization {
-			_, err = resourceStartServerIfStopped(id, "hard", d, context, sess, stopServerIfStartingForInitialization)
+			_, err = resourceStartServerIfStopped(id, "hard", d, meta, context, sess, stopServerIfStartingForInitialization)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStartServerIfStopped failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3135,7 +3138,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3156,7 +3159,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3178,7 +3181,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		ntsIntf := nts.([]interface{})
 
 		// out := make([]string, len(otsIntf))
-		listToRemove, listToAdd, serverToStop, listToUpdate := findNetworkAttachmentDifferences(otsIntf, ntsIntf, d.Id(), sess, d)
+		listToRemove, listToAdd, serverToStop, listToUpdate := findNetworkAttachmentDifferences(context, meta, otsIntf, ntsIntf, d.Id(), sess, d)
 
 		if listToUpdate != nil {
 			err = fmt.Errorf("[ERROR] Error while updating network attachment BareMetalServer(%s) \n%s", d.Id(), err)
@@ -3190,7 +3193,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		if serverToStop {
 			// stop the server
 			serverStopped = true
-			isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+			isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, isServerStopped)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3215,7 +3218,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 		if serverStopped && isServerStopped {
 			// retstart ther server
-			isServerStopped, err = resourceStartServerIfStopped(id, "hard", d, context, sess, isServerStopped)
+			isServerStopped, err = resourceStartServerIfStopped(id, "hard", d, meta, context, sess, isServerStopped)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStartServerIfStopped failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3450,7 +3453,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		// 						if err != nil {
 		// 							return fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], vniId, err, response)
 		// 						}
-		// 						_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+		// 						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 		// 						if err != nil {
 		// 							return err
 		// 						}
@@ -3467,7 +3470,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		// 						if err != nil {
 		// 							return fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response)
 		// 						}
-		// 						_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+		// 						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 		// 						if err != nil {
 		// 							return err
 		// 						}
@@ -3648,7 +3651,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 
 			// Stop server if needed
 			if flag {
-				isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+				isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, isServerStopped)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3693,7 +3696,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 
 			// Stop server if needed and not already stopped
 			if flag && !isServerStopped {
-				isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+				isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, isServerStopped)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4133,7 +4136,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 
 				// Wait for the server to start
 				// ctx := context.TODO()
-				_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForBareMetalServerAvailable(context, sess, meta, id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4159,7 +4162,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		newEnableSecureBoot := d.Get(isBareMetalServerEnableSecureBoot).(bool)
 		bmsPatchModel.EnableSecureBoot = &newEnableSecureBoot
 		flag = true
-		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, isServerStopped)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4181,7 +4184,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			bareMetalServerMetadataService.Protocol = &metadataServiceProtocol
 		}
 		bmsPatchModel.MetadataService = bareMetalServerMetadataService
-		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, isServerStopped)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4196,7 +4199,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		bareMetalServerTrustedPlatformModulePatch.Mode = &newModeTPM
 		bmsPatchModel.TrustedPlatformModule = bareMetalServerTrustedPlatformModulePatch
 		flag = true
-		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, meta, context, sess, isServerStopped)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4289,7 +4292,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+					_, err = isWaitForBareMetalServerAvailable(context, sess, meta, id, d.Timeout(schema.TimeoutUpdate), d)
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4311,7 +4314,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+					_, err = isWaitForBareMetalServerAvailable(context, sess, meta, id, d.Timeout(schema.TimeoutUpdate), d)
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4341,7 +4344,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+			_, err = isWaitForBareMetalServerAvailable(context, sess, meta, id, d.Timeout(schema.TimeoutUpdate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4420,16 +4423,16 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			action = actionOk.(string)
 		}
 		if action == "start" {
-			isBareMetalServerStart(sess, d.Id(), d, 10)
+			isBareMetalServerStart(context, sess, meta, d.Id(), d, 10)
 		} else if action == "stop" {
-			isBareMetalServerStop(sess, d.Id(), d, 10)
+			isBareMetalServerStop(context, sess, meta, d.Id(), d, 10)
 		} else if action == "restart" {
-			isBareMetalServerRestart(sess, d.Id(), d, 10)
+			isBareMetalServerRestart(context, sess, meta, d.Id(), d, 10)
 		}
 	}
 
 	if flag || isServerStopped {
-		_, err = resourceStartServerIfStopped(id, "hard", d, context, sess, isServerStopped)
+		_, err = resourceStartServerIfStopped(id, "hard", d, meta, context, sess, isServerStopped)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStartServerIfStopped failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4488,7 +4491,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForBareMetalServerActionStop(context, sess, meta, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", err.Error()), "ibm_is_bare_metal_server", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4504,7 +4507,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForBareMetalServerDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerDeleted failed: %s", err.Error()), "ibm_is_bare_metal_server", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4514,27 +4517,27 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func isWaitForBareMetalServerDeleted(bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerDeleted(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isBareMetalServerActionDeleting},
-		Target:     []string{"done", "", isBareMetalServerActionDeleted, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerDeleteRefreshFunc(bmsC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{"retry", isBareMetalServerActionDeleting},
+		Target:   []string{"done", "", isBareMetalServerActionDeleted, isBareMetalServerStatusFailed},
+		Refresh:  isBareMetalServerDeleteRefreshFunc(bmsC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := bmsC.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := bmsC.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return bms, isBareMetalServerActionDeleted, nil
@@ -4548,26 +4551,26 @@ func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.S
 	}
 }
 
-func isWaitForBareMetalServerAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be available.", id)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerRefreshFunc(client, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
+		Target:   []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:  isBareMetalServerRefreshFunc(client, id, d, communicator),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := client.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
 		}
@@ -4615,26 +4618,26 @@ func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.Reso
 		return bms, isBareMetalServerStatusPending, nil
 	}
 }
-func isWaitForBareMetalServerStoppedOnReload(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerStoppedOnReload(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be stopped for reload success.", id)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "reinitializing"},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed, "stopped"},
-		Refresh:    isBareMetalServerRefreshFuncForReload(client, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "reinitializing"},
+		Target:   []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed, "stopped"},
+		Refresh:  isBareMetalServerRefreshFuncForReload(client, id, d, communicator),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerRefreshFuncForReload(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerRefreshFuncForReload(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := client.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
 		}
@@ -4683,16 +4686,17 @@ func isBareMetalServerRefreshFuncForReload(client *vpcv1.VpcV1, id string, d *sc
 	}
 }
 
-func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionStop(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
-		Target:  []string{isBareMetalServerActionStatusStopped, isBareMetalServerStatusFailed, ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
+		Target:   []string{isBareMetalServerActionStatusStopped, isBareMetalServerStatusFailed, ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getbmsoptions := &vpcv1.GetBareMetalServerOptions{
 				ID: &id,
 			}
-			bms, response, err := bmsC.GetBareMetalServer(getbmsoptions)
+			bms, response, err := bmsC.GetBareMetalServerWithContext(ctx, getbmsoptions)
 			if err != nil {
 				return nil, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
 			}
@@ -4709,12 +4713,11 @@ func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration
 			}
 			return bms, *bms.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -4743,7 +4746,7 @@ func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStart(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.StartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -4754,13 +4757,13 @@ func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(ctx, bmsC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStop(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	stoppingType := "soft"
 	createbmsactoptions := &vpcv1.StopBareMetalServerOptions{
 		ID:   &id,
@@ -4773,13 +4776,13 @@ func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData,
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server Action stop: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerActionStop(bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
+	_, err = isWaitForBareMetalServerActionStop(ctx, bmsC, meta, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerRestart(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.RestartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -4790,14 +4793,14 @@ func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action restart: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(ctx, bmsC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
 
-func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData, context context.Context, sess *vpcv1.VpcV1, isServerStopped bool) (bool, error) {
+func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData, meta interface{}, context context.Context, sess *vpcv1.VpcV1, isServerStopped bool) (bool, error) {
 	getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 		ID: &id,
 	}
@@ -4820,12 +4823,12 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 			return isServerStopped, fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
 		}
 		isServerStopped = true
-		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		isWaitForBareMetalServerActionStop(context, sess, meta, d.Timeout(schema.TimeoutDelete), id, d)
 	}
 	return isServerStopped, nil
 }
 
-func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceData, context context.Context, sess *vpcv1.VpcV1, isServerStopped bool) (bool, error) {
+func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceData, meta interface{}, context context.Context, sess *vpcv1.VpcV1, isServerStopped bool) (bool, error) {
 	getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 		ID: &id,
 	}
@@ -4849,7 +4852,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 			return isServerStopped, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 		}
 		isServerStopped = true
-		_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return isServerStopped, err
 		}
@@ -5252,7 +5255,7 @@ func resourceIBMIsBareMetalServerMapToBareMetalServerNetworkAttachmentPrototypeB
 	return model, nil
 }
 
-func findNetworkAttachmentDifferences(oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) ([]vpcv1.DeleteBareMetalServerNetworkAttachmentOptions, []vpcv1.CreateBareMetalServerNetworkAttachmentOptions, bool, error) {
+func findNetworkAttachmentDifferences(ctx context.Context, meta interface{}, oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) ([]vpcv1.DeleteBareMetalServerNetworkAttachmentOptions, []vpcv1.CreateBareMetalServerNetworkAttachmentOptions, bool, error) {
 	var wg sync.WaitGroup
 	wg.Add(3)
 
@@ -5272,7 +5275,7 @@ func findNetworkAttachmentDifferences(oldList, newList []interface{}, bareMetalS
 	}()
 
 	go func() {
-		err = compareModifiedNacs(oldList, newList, bareMetalServerId, sess, d)
+		err = compareModifiedNacs(ctx, meta, oldList, newList, bareMetalServerId, sess, d)
 		wg.Done()
 	}()
 
@@ -5514,7 +5517,7 @@ func compareAddedNacs(oldList, newList []interface{}, bareMetalServerId string)
 	return added, restartNeeded
 }
 
-func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) error {
+func compareModifiedNacs(ctx context.Context, meta interface{}, oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) error {
 	list2Map := make(map[string]interface{})
 
 	for _, newListitem := range newList {
@@ -5690,7 +5693,7 @@ func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId strin
 								if err != nil {
 									return (fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], d.Id(), err, response))
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(ctx, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									return (err)
 								}
@@ -5707,7 +5710,7 @@ func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId strin
 								if err != nil {
 									return (fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response))
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(ctx, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									return (err)
 								}
@@ -10,10 +10,10 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -150,7 +150,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
+		_, waitErr := isWaitForBareMetalServerActionStop(context, sess, meta, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
 		if waitErr != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", waitErr.Error()), "ibm_is_bare_metal_server_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -168,7 +168,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionAvailable failed: %s", waitErr.Error()), "ibm_is_bare_metal_server_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -185,7 +185,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("RestartBareMetalServerWithContext failed: %s", waitErr.Error()), "ibm_is_bare_metal_server_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -290,7 +290,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
+			_, waitErr := isWaitForBareMetalServerActionStop(context, sess, meta, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
 			if waitErr != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", waitErr.Error()), "ibm_is_bare_metal_server_action", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -307,7 +307,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionAvailable failed: %s", waitErr.Error()), "ibm_is_bare_metal_server_action", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -324,7 +324,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionAvailable failed: %s", waitErr.Error()), "ibm_is_bare_metal_server_action", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -344,26 +344,26 @@ func resourceIBMISBareMetalServerActionDelete(context context.Context, d *schema
 	return nil
 }
 
-func isWaitForBareMetalServerActionAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be running.", id)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerActionRefreshFunc(client, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
+		Target:   []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:  isBareMetalServerActionRefreshFunc(client, id, d, communicator),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := client.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
 		}
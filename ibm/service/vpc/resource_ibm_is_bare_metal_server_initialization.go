@@ -11,9 +11,9 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -127,7 +127,7 @@ func resourceIBMISBareMetalServerInitializationCreate(context context.Context, d
 	options := &vpcv1.GetBareMetalServerInitializationOptions{
 		ID: &bareMetalServerId,
 	}
-	stopServerIfStartingForInitialization, err = resourceStopServerIfRunning(bareMetalServerId, "hard", d, context, sess, stopServerIfStartingForInitialization)
+	stopServerIfStartingForInitialization, err = resourceStopServerIfRunning(bareMetalServerId, "hard", d, meta, context, sess, stopServerIfStartingForInitialization)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server_initialization", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -197,14 +197,14 @@ func resourceIBMISBareMetalServerInitializationCreate(context context.Context, d
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForBareMetalServerInitializationStopped(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerInitializationStopped(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerInitializationStopped failed: %s", err.Error()), "ibm_is_bare_metal_server_initialization", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 	if stopServerIfStartingForInitialization {
-		_, err = resourceStartServerIfStopped(bareMetalServerId, "hard", d, context, sess, stopServerIfStartingForInitialization)
+		_, err = resourceStartServerIfStopped(bareMetalServerId, "hard", d, meta, context, sess, stopServerIfStartingForInitialization)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStartServerIfStopped failed: %s", err.Error()), "ibm_is_bare_metal_server_initialization", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -261,26 +261,26 @@ func resourceIBMISBareMetalServerInitializationDelete(context context.Context, d
 	return nil
 }
 
-func isWaitForBareMetalServerInitializationStopped(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerInitializationStopped(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be stopped for reload success.", id)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "reinitializing"},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed, "stopped"},
-		Refresh:    isBareMetalServerInitializationRefreshFunc(client, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "reinitializing"},
+		Target:   []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed, "stopped"},
+		Refresh:  isBareMetalServerInitializationRefreshFunc(client, id, d, communicator),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerInitializationRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerInitializationRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := client.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
 		}
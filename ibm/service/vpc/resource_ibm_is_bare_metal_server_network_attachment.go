@@ -449,7 +449,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerStoppedForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerStoppedForNIC(context, vpcClient, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -476,7 +476,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context
 			if err != nil || res.StatusCode != 204 {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error starting bare metal server (%s) after attachment creation failed err %s\n%s", bareMetalServerId, err, response))
 			}
-			_, err = isWaitForBareMetalServerAvailableForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerAvailableForNIC(context, vpcClient, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -526,7 +526,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, vpcClient, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1048,7 +1048,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentUpdate(context context.Context
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1069,7 +1069,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentUpdate(context context.Context
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1128,7 +1128,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentDelete(context context.Context
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerStoppedForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerStoppedForNIC(context, vpcClient, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1167,7 +1167,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentDelete(context context.Context
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, vpcClient, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
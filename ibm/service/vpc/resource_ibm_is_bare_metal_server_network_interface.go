@@ -12,10 +12,10 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -319,7 +319,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerStoppedForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -436,7 +436,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		}
 
 		log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
-		nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
+		nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(context, sess, meta, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -460,7 +460,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -596,7 +596,7 @@ func createVlanTypeNetworkInterface(context context.Context, d *schema.ResourceD
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_bare_metal_server_network_interface", "create", "sep-id-parts").GetDiag()
 	}
 	log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
-	nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
+	nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(context, sess, meta, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -709,7 +709,7 @@ func createHiperSocketTypeNetworkInterface(context context.Context, d *schema.Re
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_bare_metal_server_network_interface", "create", "sep-id-parts").GetDiag()
 	}
 	log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
-	_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(context, sess, meta, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1169,7 +1169,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1190,7 +1190,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1372,7 +1372,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+				_, err = isWaitForBareMetalServerStoppedForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 				if err != nil || res.StatusCode != 204 {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1401,7 +1401,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(context, sess, meta, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceDeleted failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1419,7 +1419,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1430,32 +1430,32 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 	return nil
 }
 
-func isWaitForBareMetalServerNetworkInterfaceDeleted(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerNetworkInterfaceDeleted(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for (%s) / (%s) to be deleted.", bareMetalServerId, nicId)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfaceDeleting, isBareMetalServerNetworkInterfacePending},
-		Target:     []string{isBareMetalServerNetworkInterfaceDeleted, isBareMetalServerNetworkInterfaceVlanPending, isBareMetalServerNetworkInterfaceFailed, isBareMetalServerNetworkInterfacePCIPending, ""},
-		Refresh:    isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC, bareMetalServerId, nicId, nicType, nicIntf),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s) network interface (%s)", bareMetalServerId, nicId),
+		Pending:  []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfaceDeleting, isBareMetalServerNetworkInterfacePending},
+		Target:   []string{isBareMetalServerNetworkInterfaceDeleted, isBareMetalServerNetworkInterfaceVlanPending, isBareMetalServerNetworkInterfaceFailed, isBareMetalServerNetworkInterfacePCIPending, ""},
+		Refresh:  isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC, bareMetalServerId, nicId, nicType, nicIntf),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, nicType string, nicIntf vpcv1.BareMetalServerNetworkInterfaceIntf) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getBmsNicOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
 			BareMetalServerID: &bareMetalServerId,
 			ID:                &nicId,
 		}
-		bmsNic, response, err := bmsC.GetBareMetalServerNetworkInterface(getBmsNicOptions)
+		bmsNic, response, err := bmsC.GetBareMetalServerNetworkInterfaceWithContext(ctx, getBmsNicOptions)
 		if bmsNic != nil && nicType == "vlan" {
 			getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 				ID: &bareMetalServerId,
 			}
-			bms, response, err := bmsC.GetBareMetalServer(getBmsOptions)
+			bms, response, err := bmsC.GetBareMetalServerWithContext(ctx, getBmsOptions)
 			if err != nil {
 				return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) : %s\n%s", bareMetalServerId, err, response)
 			}
@@ -1467,7 +1467,7 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 			getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 				ID: &bareMetalServerId,
 			}
-			bms, response, err := bmsC.GetBareMetalServer(getBmsOptions)
+			bms, response, err := bmsC.GetBareMetalServerWithContext(ctx, getBmsOptions)
 			if err != nil {
 				return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) : %s\n%s", bareMetalServerId, err, response)
 			}
@@ -1485,26 +1485,26 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 	}
 }
 
-func isWaitForBareMetalServerNetworkInterfaceAvailable(client *vpcv1.VpcV1, bareMetalServerId, nicId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerNetworkInterfaceAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, bareMetalServerId, nicId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) Network Interface (%s) to be available.", bareMetalServerId, nicId)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerNetworkInterfacePending},
-		Target:     []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfacePCIPending, isBareMetalServerNetworkInterfaceFailed},
-		Refresh:    isBareMetalServerNetworkInterfaceRefreshFunc(client, bareMetalServerId, nicId, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s) network interface (%s)", bareMetalServerId, nicId),
+		Pending:  []string{isBareMetalServerNetworkInterfacePending},
+		Target:   []string{isBareMetalServerNetworkInterfaceAvailable, isBareMetalServerNetworkInterfacePCIPending, isBareMetalServerNetworkInterfaceFailed},
+		Refresh:  isBareMetalServerNetworkInterfaceRefreshFunc(client, bareMetalServerId, nicId, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetalServerId, nicId string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetalServerId, nicId string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getBmsNicOptions := &vpcv1.GetBareMetalServerNetworkInterfaceOptions{
 			BareMetalServerID: &bareMetalServerId,
 			ID:                &nicId,
 		}
-		bmsNic, response, err := client.GetBareMetalServerNetworkInterface(getBmsNicOptions)
+		bmsNic, response, err := client.GetBareMetalServerNetworkInterfaceWithContext(ctx, getBmsNicOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) Network Interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
 		}
@@ -1519,7 +1519,7 @@ func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetal
 				getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 					ID: &bareMetalServerId,
 				}
-				bms, response, err := client.GetBareMetalServer(getBmsOptions)
+				bms, response, err := client.GetBareMetalServerWithContext(ctx, getBmsOptions)
 				if err != nil {
 					return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s)  : %s\n%s", bareMetalServerId, err, response)
 				}
@@ -1566,25 +1566,25 @@ func ParseNICTerraformID(s string) (string, string, error) {
 	return segments[0], segments[1], nil
 }
 
-func isWaitForBareMetalServerAvailableForNIC(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerAvailableForNIC(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be available.", id)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "running"},
-		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
-		Refresh:    isBareMetalServerForNICRefreshFunc(client, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting, "running"},
+		Target:   []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:  isBareMetalServerForNICRefreshFunc(client, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := client.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			return nil, "failed", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
 		}
@@ -1596,25 +1596,25 @@ func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schem
 	}
 }
 
-func isWaitForBareMetalServerStoppedForNIC(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerStoppedForNIC(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be stopped.", id)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
-		Target:     []string{isBareMetalServerActionStatusStopped},
-		Refresh:    isBareMetalServerForNICStoppedRefreshFunc(client, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s)", id),
+		Pending:  []string{isBareMetalServerStatusPending, isBareMetalServerActionStatusStarting},
+		Target:   []string{isBareMetalServerActionStatusStopped},
+		Refresh:  isBareMetalServerForNICStoppedRefreshFunc(client, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerForNICStoppedRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerForNICStoppedRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bmsgetoptions := &vpcv1.GetBareMetalServerOptions{
 			ID: &id,
 		}
-		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
+		bms, response, err := client.GetBareMetalServerWithContext(ctx, bmsgetoptions)
 		if err != nil {
 			return nil, "failed", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
 		}
@@ -333,7 +333,7 @@ func createVlanTypeNetworkInterfaceAllowFloat(context context.Context, d *schema
 	}
 
 	log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
-	nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
+	nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(context, sess, meta, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface_allow_float", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -712,7 +712,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface_allow_float", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -733,7 +733,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForBareMetalServerAvailableForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface_allow_float", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -901,7 +901,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForBareMetalServerStoppedForNIC(context, sess, meta, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil || res.StatusCode != 204 {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface_allow_float", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -930,7 +930,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(context, sess, meta, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceDeleted failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface_allow_float", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
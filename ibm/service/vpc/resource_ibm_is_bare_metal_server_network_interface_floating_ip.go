@@ -11,9 +11,9 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -301,7 +301,7 @@ func bareMetalServerNetworkInterfaceFipDelete(context context.Context, d *schema
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted(sess, bareMetalServerId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted(context, sess, meta, bareMetalServerId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted failed: %s", err.Error()), "ibm_is_bare_metal_server_network_interface_floating_ip", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -311,29 +311,29 @@ func bareMetalServerNetworkInterfaceFipDelete(context context.Context, d *schema
 	return nil
 }
 
-func isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, fipId string, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted(ctx context.Context, bmsC *vpcv1.VpcV1, meta interface{}, bareMetalServerId, nicId, fipId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for (%s) / (%s) / (%s) to be deleted.", bareMetalServerId, nicId, fipId)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerNetworkInterfaceFloatingIpAvailable, isBareMetalServerNetworkInterfaceFloatingIpDeleting, isBareMetalServerNetworkInterfaceFloatingIpPending},
-		Target:     []string{isBareMetalServerNetworkInterfaceFloatingIpDeleted, isBareMetalServerNetworkInterfaceFailed, ""},
-		Refresh:    isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC, bareMetalServerId, nicId, fipId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s) network interface (%s) floating IP (%s)", bareMetalServerId, nicId, fipId),
+		Pending:  []string{isBareMetalServerNetworkInterfaceFloatingIpAvailable, isBareMetalServerNetworkInterfaceFloatingIpDeleting, isBareMetalServerNetworkInterfaceFloatingIpPending},
+		Target:   []string{isBareMetalServerNetworkInterfaceFloatingIpDeleted, isBareMetalServerNetworkInterfaceFailed, ""},
+		Refresh:  isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC, bareMetalServerId, nicId, fipId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, fipId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareMetalServerId, nicId, fipId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getBmsNicFloatingIpOptions := &vpcv1.GetBareMetalServerNetworkInterfaceFloatingIPOptions{
 			BareMetalServerID:  &bareMetalServerId,
 			NetworkInterfaceID: &nicId,
 			ID:                 &fipId,
 		}
-		fip, response, err := bmsC.GetBareMetalServerNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
+		fip, response, err := bmsC.GetBareMetalServerNetworkInterfaceFloatingIPWithContext(ctx, getBmsNicFloatingIpOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -345,28 +345,28 @@ func isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC *vpcv1.Vp
 	}
 }
 
-func isWaitForBareMetalServerNetworkInterfaceFloatingIpAvailable(client *vpcv1.VpcV1, bareMetalServerId, nicId, fipId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerNetworkInterfaceFloatingIpAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, bareMetalServerId, nicId, fipId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) Network Interface (%s) to be available.", bareMetalServerId, nicId)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isBareMetalServerNetworkInterfaceFloatingIpPending},
-		Target:     []string{isBareMetalServerNetworkInterfaceFloatingIpAvailable, isBareMetalServerNetworkInterfaceFloatingIpFailed},
-		Refresh:    isBareMetalServerNetworkInterfaceFloatingIpRefreshFunc(client, bareMetalServerId, nicId, fipId, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("bare metal server (%s) network interface (%s) floating IP (%s)", bareMetalServerId, nicId, fipId),
+		Pending:  []string{isBareMetalServerNetworkInterfaceFloatingIpPending},
+		Target:   []string{isBareMetalServerNetworkInterfaceFloatingIpAvailable, isBareMetalServerNetworkInterfaceFloatingIpFailed},
+		Refresh:  isBareMetalServerNetworkInterfaceFloatingIpRefreshFunc(client, bareMetalServerId, nicId, fipId, d, communicator),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	return stateWaiter.Wait(ctx)
 }
 
-func isBareMetalServerNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1, bareMetalServerId, nicId, fipId string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isBareMetalServerNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1, bareMetalServerId, nicId, fipId string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getBmsNicFloatingIpOptions := &vpcv1.GetBareMetalServerNetworkInterfaceFloatingIPOptions{
 			BareMetalServerID:  &bareMetalServerId,
 			NetworkInterfaceID: &nicId,
 			ID:                 &fipId,
 		}
-		fip, response, err := client.GetBareMetalServerNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
+		fip, response, err := client.GetBareMetalServerNetworkInterfaceFloatingIPWithContext(ctx, getBmsNicFloatingIpOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
 		}
@@ -11,10 +11,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -42,8 +42,11 @@ const (
 	isDedicatedHostAccessTagType = "access"
 )
 
+// ibmIsDedicatedHostIdentity is the identity of ibm_is_dedicated_host, which can be used to import it.
+var ibmIsDedicatedHostIdentity = vpcResourceIdentity("The unique identifier of the dedicated host.")
+
 func ResourceIbmIsDedicatedHost() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmIsDedicatedHostCreate,
 		ReadContext:   resourceIbmIsDedicatedHostRead,
 		UpdateContext: resourceIbmIsDedicatedHostUpdate,
@@ -400,7 +403,7 @@ func ResourceIbmIsDedicatedHost() *schema.Resource {
 				Description: "List of access management tags",
 			},
 		},
-	}
+	}, ibmIsDedicatedHostIdentity)
 }
 
 func ResourceIbmIsDedicatedHostValidator() *validate.ResourceValidator {
@@ -491,7 +494,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 				"Error on create of resource dedicated host (%s) access tags: %s", d.Id(), err)
 		}
 	}
-	_, err = isWaitForDedicatedHostAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForDedicatedHostAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -782,7 +785,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForDedicatedHostDelete(vpcClient, d, d.Id())
+	_, err = isWaitForDedicatedHostDelete(context, vpcClient, meta, d, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -792,16 +795,17 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
-func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForDedicatedHostDelete(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isDedicatedHostDeleting, isDedicatedHostStable},
-		Target:  []string{isDedicatedHostDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("dedicated host (%s)", id),
+		Pending:  []string{isDedicatedHostDeleting, isDedicatedHostStable},
+		Target:   []string{isDedicatedHostDeleteDone, ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getdhoptions := &vpcv1.GetDedicatedHostOptions{
 				ID: &id,
 			}
-			dedicatedhost, response, err := instanceC.GetDedicatedHost(getdhoptions)
+			dedicatedhost, response, err := instanceC.GetDedicatedHostWithContext(ctx, getdhoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return dedicatedhost, isDedicatedHostDeleteDone, nil
@@ -813,35 +817,34 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 			}
 			return dedicatedhost, isDedicatedHostDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForDedicatedHostAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isDedicatedHostStatusPending, isDedicatedHostUpdating, isDedicatedHostWaiting},
-		Target:     []string{isDedicatedHostFailed, isDedicatedHostStable, isDedicatedHostSuspended},
-		Refresh:    isDedicatedHostRefreshFunc(instanceC, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("dedicated host (%s)", id),
+		Pending:  []string{isDedicatedHostStatusPending, isDedicatedHostUpdating, isDedicatedHostWaiting},
+		Target:   []string{isDedicatedHostFailed, isDedicatedHostStable, isDedicatedHostSuspended},
+		Refresh:  isDedicatedHostRefreshFunc(instanceC, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getinsOptions := &vpcv1.GetDedicatedHostOptions{
 			ID: &id,
 		}
-		dhost, response, err := instanceC.GetDedicatedHost(getinsOptions)
+		dhost, response, err := instanceC.GetDedicatedHostWithContext(ctx, getinsOptions)
 		if dhost == nil || err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting dedicated host : %s\n%s", err, response)
 		}
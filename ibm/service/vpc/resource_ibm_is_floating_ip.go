@@ -13,11 +13,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,8 +39,11 @@ const (
 	isFloatingIPAccessTags = "access_tags"
 )
 
+// ibmIsFloatingIPIdentity is the identity of ibm_is_floating_ip, which can be used to import it.
+var ibmIsFloatingIPIdentity = vpcResourceIdentity("The unique identifier of the floating IP.")
+
 func ResourceIBMISFloatingIP() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISFloatingIPCreate,
 		ReadContext:   resourceIBMISFloatingIPRead,
 		UpdateContext: resourceIBMISFloatingIPUpdate,
@@ -272,7 +275,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, ibmIsFloatingIPIdentity)
 }
 
 func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
@@ -376,7 +379,7 @@ func fipCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForInstanceFloatingIP(vpcClient, d.Id(), d)
+	_, err = isWaitForInstanceFloatingIP(context, vpcClient, meta, d.Id(), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -660,7 +663,7 @@ func fipDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForFloatingIPDeleted(vpcClient, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForFloatingIPDeleted(context, vpcClient, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -692,28 +695,28 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForFloatingIPDeleted(ctx context.Context, fip *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending, isFloatingIPDeleting},
-		Target:     []string{"", isFloatingIPDeleted},
-		Refresh:    isFloatingIPDeleteRefreshFunc(fip, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("floating IP (%s)", id),
+		Pending:  []string{isFloatingIPPending, isFloatingIPDeleting},
+		Target:   []string{"", isFloatingIPDeleted},
+		Refresh:  isFloatingIPDeleteRefreshFunc(fip, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] floating ip delete function here")
 		getfipoptions := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		FloatingIP, response, err := fip.GetFloatingIP(getfipoptions)
+		FloatingIP, response, err := fip.GetFloatingIPWithContext(ctx, getfipoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
@@ -724,27 +727,27 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceFloatingIP(ctx context.Context, floatingipC *vpcv1.VpcV1, meta interface{}, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending},
-		Target:     []string{isFloatingIPAvailable, ""},
-		Refresh:    isInstanceFloatingIPRefreshFunc(floatingipC, id),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("floating IP (%s)", id),
+		Pending:  []string{isFloatingIPPending},
+		Target:   []string{isFloatingIPAvailable, ""},
+		Refresh:  isInstanceFloatingIPRefreshFunc(floatingipC, id),
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getfipoptions := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
-		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
+		instance, response, err := floatingipC.GetFloatingIPWithContext(ctx, getfipoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Floating IP for the instance: %s\n%s", err, response)
 		}
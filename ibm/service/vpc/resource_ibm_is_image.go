@@ -11,13 +11,13 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,8 +55,11 @@ const (
 	isImageRemote = "remote"
 )
 
+// ibmIsImageIdentity is the identity of ibm_is_image, which can be used to import it.
+var ibmIsImageIdentity = vpcResourceIdentity("The unique identifier of the image.")
+
 func ResourceIBMISImage() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISImageCreate,
 		ReadContext:   resourceIBMISImageRead,
 		UpdateContext: resourceIBMISImageUpdate,
@@ -292,7 +295,7 @@ func ResourceIBMISImage() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsImageIdentity)
 }
 
 func ResourceIBMISImageValidator() *validate.ResourceValidator {
@@ -438,7 +441,7 @@ func imgCreateByFile(context context.Context, d *schema.ResourceData, meta inter
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageAvailable failed: %s", err.Error()), "ibm_is_image", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -526,14 +529,14 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(context, sess, meta, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_image", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 	} else if *instance.Status != "stopped" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(context, sess, meta, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_image", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -580,7 +583,7 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageAvailable failed: %s", err.Error()), "ibm_is_image", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -606,26 +609,26 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageAvailable(ctx context.Context, imageC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageProvisioning},
-		Target:     []string{isImageProvisioningDone, ""},
-		Refresh:    isImageRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("image (%s)", id),
+		Pending:  []string{"retry", isImageProvisioning},
+		Target:   []string{isImageProvisioningDone, ""},
+		Refresh:  isImageRefreshFunc(imageC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
-func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
 		}
@@ -675,7 +678,7 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForImageDeprecate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForImageDeprecate(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageDeprecate failed: %s", err.Error()), "ibm_is_image", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -695,7 +698,7 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForImageObsolete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForImageObsolete(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageObsolete failed: %s", err.Error()), "ibm_is_image", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1041,7 +1044,7 @@ func imgDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForImageDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageDeleted failed: %s", err.Error()), "ibm_is_image", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1051,28 +1054,28 @@ func imgDelete(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeleted(ctx context.Context, imageC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageDeleting},
-		Target:     []string{"", isImageDeleted},
-		Refresh:    isImageDeleteRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("image (%s)", id),
+		Pending:  []string{"retry", isImageDeleting},
+		Target:   []string{"", isImageDeleted},
+		Refresh:  isImageDeleteRefreshFunc(imageC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is image delete function here")
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
@@ -10,10 +10,10 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -206,7 +206,7 @@ func imgDeprecateCreate(context context.Context, d *schema.ResourceData, meta in
 	}
 	d.SetId(id)
 	log.Printf("[INFO] Image ID : %s", id)
-	_, err = isWaitForImageDeprecate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageDeprecate(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -214,27 +214,27 @@ func imgDeprecateCreate(context context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func isWaitForImageDeprecate(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeprecate(ctx context.Context, imageC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deprecate.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageProvisioning},
-		Target:     []string{isImageProvisioningDone, ""},
-		Refresh:    isImageDeprecateRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("image (%s)", id),
+		Pending:  []string{"retry", isImageProvisioning},
+		Target:   []string{isImageProvisioningDone, ""},
+		Refresh:  isImageDeprecateRefreshFunc(imageC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isImageDeprecateRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isImageDeprecateRefreshFunc(imageC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getimgoptions := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
-		image, response, err := imageC.GetImage(getimgoptions)
+		image, response, err := imageC.GetImageWithContext(ctx, getimgoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
 		}
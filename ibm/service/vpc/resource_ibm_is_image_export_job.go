@@ -11,11 +11,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -500,23 +500,23 @@ func ResourceIBMIsImageExportCloudObjectStorageObjectReferenceToMap(model *vpcv1
 	return modelMap, nil
 }
 
-func isWaitForImageExportJobDeleted(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageExportJobDeleted(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image export job (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", "deleting"},
-		Target:     []string{"", "done"},
-		Refresh:    isImageExportJobDeleteRefreshFunc(context, d, meta, vpcClient, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("image export job (%s)", id),
+		Pending:  []string{"retry", "deleting"},
+		Target:   []string{"", "done"},
+		Refresh:  isImageExportJobDeleteRefreshFunc(d, meta, vpcClient, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isImageExportJobDeleteRefreshFunc(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isImageExportJobDeleteRefreshFunc(d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is image export job delete refresh here")
 		parts, err := flex.SepIdParts(d.Id(), "/")
 		if err != nil {
@@ -527,7 +527,7 @@ func isImageExportJobDeleteRefreshFunc(context context.Context, d *schema.Resour
 		getImgExpJobOptions.SetImageID(parts[0])
 		getImgExpJobOptions.SetID(parts[1])
 
-		imageExportJob, response, err := vpcClient.GetImageExportJob(getImgExpJobOptions)
+		imageExportJob, response, err := vpcClient.GetImageExportJobWithContext(ctx, getImgExpJobOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return imageExportJob, "done", nil
@@ -0,0 +1,799 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isImageUploadSource              = "source"
+	isImageUploadSourceSha256        = "source_sha256"
+	isImageUploadBucketCRN           = "cos_bucket_crn"
+	isImageUploadBucketLocation      = "cos_bucket_location"
+	isImageUploadEndpointType        = "cos_endpoint_type"
+	isImageUploadKey                 = "cos_key"
+	isImageUploadPartSize            = "part_size"
+	isImageUploadDeleteStagingObject = "delete_staging_object"
+	isImageUploadFileHref            = "file_href"
+	isImageUploadStagingUploaded     = "staging_object_uploaded"
+
+	isImageUploadStatusAvailable = "available"
+	isImageUploadStatusPending   = "pending"
+	isImageUploadStatusFailed    = "failed"
+	isImageUploadStatusDeleting  = "deleting"
+
+	// isImageUploadMaxParts is the maximum number of parts of a COS multipart upload.
+	isImageUploadMaxParts = 10000
+	isImageUploadMebibyte = 1024 * 1024
+)
+
+func ResourceIBMIsImageUpload() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsImageUploadCreate,
+		ReadContext:   resourceIBMIsImageUploadRead,
+		UpdateContext: resourceIBMIsImageUploadUpdate,
+		DeleteContext: resourceIBMIsImageUploadDelete,
+
+		CustomizeDiff: resourceIBMIsImageUploadSourceSha256CustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isImageName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_image", isImageName),
+				Description:  "The name of the image.",
+			},
+			isImageOperatingSystem: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the operating system of the image.",
+			},
+			isImageUploadSource: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the local image file to upload. The file format is taken from the extension of the object key, which must be `qcow2` or `vhd`.",
+			},
+			isImageUploadSourceSha256: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The expected SHA-256 of the local image file, in hexadecimal. The upload fails when the file does not match, and a change replaces the image. Defaults to the SHA-256 of the file when the plan is made.",
+			},
+			isImageUploadBucketCRN: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the COS bucket to stage the image file in.",
+			},
+			isImageUploadBucketLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location of the COS bucket, for example `us-south`.",
+			},
+			isImageUploadEndpointType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "The COS endpoint type to upload with: public, private or direct.",
+			},
+			isImageUploadKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The key of the staging object. Defaults to the file name of the source.",
+			},
+			isImageUploadPartSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "The size in MiB of the parts of the multipart upload. It is raised when the file would have more than 10000 parts.",
+			},
+			isImageUploadDeleteStagingObject: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete the staging object once the image is available. Only a staging object that this resource uploaded is deleted.",
+			},
+			isImageResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group of the image.",
+			},
+			isImageEncryptionKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{isImageEncryptedDataKey},
+				Description:  "The CRN of the Key Protect Root Key or Hyper Protect Crypto Service Root Key that wraps the data key of an encrypted image file.",
+			},
+			isImageEncryptedDataKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{isImageEncryptionKey},
+				Description:  "The data key that was used to encrypt the image file, encrypted with the encryption key.",
+			},
+			isImageUploadFileHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The COS location of the staging object the image was created from.",
+			},
+			isImageUploadStagingUploaded: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether this resource uploaded the staging object. A staging object that already had the content of the file is not uploaded, and is never deleted by this resource.",
+			},
+			isImageCheckSum: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 of the image file, as computed by the VPC service.",
+			},
+			isImageEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption used on the image.",
+			},
+			isImageStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the image.",
+			},
+			isImageVisibility: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the image is public or private.",
+			},
+			IsImageCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the image.",
+			},
+		},
+	}
+}
+
+// imageUploadTarget is the staging object of an image upload.
+type imageUploadTarget struct {
+	client   *s3.S3
+	bucket   string
+	location string
+	key      string
+}
+
+func (t *imageUploadTarget) href() string {
+	return fmt.Sprintf("cos://%s/%s/%s", t.location, t.bucket, t.key)
+}
+
+// imageUploadFile is the local image file with the digests of its upload parts.
+type imageUploadFile struct {
+	file     *os.File
+	size     int64
+	partSize int64
+	sha256   string
+	// partMD5s are the MD5 digests of the parts, which COS returns as the ETags of the parts.
+	partMD5s [][]byte
+}
+
+// etag returns the ETag of the object of a completed multipart upload of the file.
+func (f *imageUploadFile) etag() string {
+	digests := md5.New()
+	for _, partMD5 := range f.partMD5s {
+		digests.Write(partMD5)
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digests.Sum(nil)), len(f.partMD5s))
+}
+
+func (f *imageUploadFile) part(number int64) *io.SectionReader {
+	offset := (number - 1) * f.partSize
+	return io.NewSectionReader(f.file, offset, min(f.partSize, f.size-offset))
+}
+
+// openImageUploadFile opens the file and computes its SHA-256 and the MD5 digest of every part in a
+// single read.
+func openImageUploadFile(path string, partSize int64) (*imageUploadFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	size := info.Size()
+	if minPartSize := (size + isImageUploadMaxParts - 1) / isImageUploadMaxParts; partSize < minPartSize {
+		partSize = minPartSize
+	}
+
+	// An empty file is uploaded as a single empty part
+	parts := max(1, (size+partSize-1)/partSize)
+	f := &imageUploadFile{file: file, size: size, partSize: partSize}
+	fileHash := sha256.New()
+	for number := int64(1); number <= parts; number++ {
+		partHash := md5.New()
+		if _, err := io.Copy(io.MultiWriter(fileHash, partHash), f.part(number)); err != nil {
+			file.Close()
+			return nil, err
+		}
+		f.partMD5s = append(f.partMD5s, partHash.Sum(nil))
+	}
+	f.sha256 = hex.EncodeToString(fileHash.Sum(nil))
+	return f, nil
+}
+
+// imageUploadSha256 returns the SHA-256 of the file, in hexadecimal.
+func imageUploadSha256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	fileHash := sha256.New()
+	if _, err := io.Copy(fileHash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(fileHash.Sum(nil)), nil
+}
+
+// resourceIBMIsImageUploadSourceSha256CustomizeDiff plans the SHA-256 of the source file when
+// source_sha256 is not configured, so that a changed file replaces the image. A file that does not
+// exist when the plan is made, for example one that is built during the apply or removed after the
+// upload, keeps the SHA-256 of the state.
+func resourceIBMIsImageUploadSourceSha256CustomizeDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.GetRawConfig().GetAttr(isImageUploadSourceSha256).IsNull() || !d.NewValueKnown(isImageUploadSource) {
+		return nil
+	}
+	source := d.Get(isImageUploadSource).(string)
+	sum, err := imageUploadSha256(source)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Printf("[DEBUG] Source %s of the image upload does not exist, the SHA-256 is not planned", source)
+			return nil
+		}
+		return fmt.Errorf("reading %s: %w", source, err)
+	}
+	if sum != d.Get(isImageUploadSourceSha256).(string) {
+		return d.SetNew(isImageUploadSourceSha256, sum)
+	}
+	return nil
+}
+
+// parseImageUploadBucketCRN returns the CRN of the COS instance and the name of the bucket of a
+// bucket CRN.
+func parseImageUploadBucketCRN(bucketCRN string) (string, string, error) {
+	crnParts := strings.Split(bucketCRN, ":bucket:")
+	if len(crnParts) != 2 || crnParts[1] == "" {
+		return "", "", fmt.Errorf("%s %q is not the CRN of a COS bucket", isImageUploadBucketCRN, bucketCRN)
+	}
+	return crnParts[0] + "::", crnParts[1], nil
+}
+
+func imageUploadTargetFromResourceData(d *schema.ResourceData, meta interface{}) (*imageUploadTarget, error) {
+	instanceCRN, bucket, err := parseImageUploadBucketCRN(d.Get(isImageUploadBucketCRN).(string))
+	if err != nil {
+		return nil, err
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	location := d.Get(isImageUploadBucketLocation).(string)
+	client, err := cos.GetS3Client(bxSession, location, d.Get(isImageUploadEndpointType).(string), instanceCRN)
+	if err != nil {
+		return nil, err
+	}
+	return &imageUploadTarget{
+		client:   client,
+		bucket:   bucket,
+		location: location,
+		key:      d.Get(isImageUploadKey).(string),
+	}, nil
+}
+
+func resourceIBMIsImageUploadCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	source := d.Get(isImageUploadSource).(string)
+	if _, ok := d.GetOk(isImageUploadKey); !ok {
+		d.Set(isImageUploadKey, filepath.Base(source))
+	}
+	key := d.Get(isImageUploadKey).(string)
+	if ext := strings.ToLower(filepath.Ext(key)); ext != ".qcow2" && ext != ".vhd" {
+		err = fmt.Errorf("the key %q of the staging object must have the extension qcow2 or vhd", key)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "create", "validate-cos_key").
+			WithAttribute(isImageUploadKey).
+			WithRemediation("Set cos_key to a key with the extension .qcow2 or .vhd.").
+			GetDiag()
+	}
+
+	target, err := imageUploadTargetFromResourceData(d, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "create", "initialize-cos-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	file, err := openImageUploadFile(source, int64(d.Get(isImageUploadPartSize).(int))*isImageUploadMebibyte)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error reading %s: %s", source, err), "ibm_is_image_upload", "create", "read-source").
+			WithAttribute(isImageUploadSource)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer file.file.Close()
+	if expected, ok := d.GetOk(isImageUploadSourceSha256); ok && !strings.EqualFold(expected.(string), file.sha256) {
+		err = fmt.Errorf("the SHA-256 of %s is %s, expected %s", source, file.sha256, expected)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "create", "verify-source_sha256").
+			WithAttribute(isImageUploadSourceSha256).
+			WithRemediation("Check that the source file is complete, or update source_sha256.").
+			GetDiag()
+	}
+	d.Set(isImageUploadSourceSha256, file.sha256)
+
+	uploaded, err := imageUploadObject(context, target, file)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error uploading %s to %s: %s", source, target.href(), err), "ibm_is_image_upload", "create", "upload-object")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	name := d.Get(isImageName).(string)
+	operatingSystem := d.Get(isImageOperatingSystem).(string)
+	href := target.href()
+	imagePrototype := &vpcv1.ImagePrototypeImageByFile{
+		Name: &name,
+		File: &vpcv1.ImageFilePrototype{
+			Href: &href,
+		},
+		OperatingSystem: &vpcv1.OperatingSystemIdentity{
+			Name: &operatingSystem,
+		},
+	}
+	if encryptionKey, ok := d.GetOk(isImageEncryptionKey); ok {
+		encryptionKeyStr := encryptionKey.(string)
+		imagePrototype.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: &encryptionKeyStr,
+		}
+	}
+	if encDataKey, ok := d.GetOk(isImageEncryptedDataKey); ok {
+		encDataKeyStr := encDataKey.(string)
+		imagePrototype.EncryptedDataKey = &encDataKeyStr
+	}
+	if rgrp, ok := d.GetOk(isImageResourceGroup); ok {
+		rg := rgrp.(string)
+		imagePrototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+	image, _, err := sess.CreateImageWithContext(context, &vpcv1.CreateImageOptions{
+		ImagePrototype: imagePrototype,
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateImageWithContext failed: %s", err.Error()), "ibm_is_image_upload", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(*image.ID)
+	log.Printf("[INFO] Image ID : %s", *image.ID)
+	d.Set(isImageUploadStagingUploaded, uploaded)
+
+	image, err = isWaitForImageUploadAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_image_upload", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if image.File != nil && image.File.Checksums != nil && image.File.Checksums.Sha256 != nil &&
+		!strings.EqualFold(*image.File.Checksums.Sha256, file.sha256) {
+		err = fmt.Errorf("the SHA-256 of image %s is %s, but the SHA-256 of %s is %s", d.Id(), *image.File.Checksums.Sha256, source, file.sha256)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "create", "verify-checksum").GetDiag()
+	}
+
+	// The image is already created, so a staging object that cannot be deleted is only reported
+	var diags diag.Diagnostics
+	if uploaded && d.Get(isImageUploadDeleteStagingObject).(bool) {
+		if err = imageUploadDeleteObject(context, target); err != nil {
+			tfWarning := flex.TerraformWarningf(err, fmt.Sprintf("Error deleting staging object %s: %s", target.href(), err), "ibm_is_image_upload", "create").
+				WithRemediation("Delete the staging object from the bucket.")
+			log.Printf("[DEBUG]\n%s", tfWarning.GetDebugMessage())
+			diags = tfWarning.GetDiag()
+		}
+	}
+
+	return append(diags, resourceIBMIsImageUploadRead(context, d, meta)...)
+}
+
+// imageUploadObject uploads the file to the staging object with a multipart upload, and reports
+// whether it uploaded the object. An object that already has the content of the file is kept. An
+// incomplete multipart upload of the object, for example of an interrupted apply, is resumed, and
+// only its missing or different parts are uploaded.
+func imageUploadObject(ctx context.Context, target *imageUploadTarget, file *imageUploadFile) (bool, error) {
+	etag := file.etag()
+	head, err := target.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(target.bucket),
+		Key:    aws.String(target.key),
+	})
+	if err == nil && strings.Trim(aws.StringValue(head.ETag), `"`) == etag && aws.Int64Value(head.ContentLength) == file.size {
+		log.Printf("[INFO] Staging object %s is already uploaded", target.href())
+		return false, nil
+	}
+	if err != nil && !isImageUploadNotFound(err) {
+		return false, err
+	}
+
+	uploadID, uploaded, err := imageUploadFindMultipartUpload(ctx, target)
+	if err != nil {
+		return false, err
+	}
+	if uploadID == "" {
+		upload, err := target.client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(target.bucket),
+			Key:    aws.String(target.key),
+		})
+		if err != nil {
+			return false, err
+		}
+		uploadID = aws.StringValue(upload.UploadId)
+	} else {
+		log.Printf("[INFO] Resuming multipart upload %s of %s with %d uploaded parts", uploadID, target.href(), len(uploaded))
+	}
+
+	completed := make([]*s3.CompletedPart, len(file.partMD5s))
+	for i, partMD5 := range file.partMD5s {
+		number := int64(i + 1)
+		body := file.part(number)
+		if part, ok := uploaded[number]; ok && aws.Int64Value(part.Size) == body.Size() &&
+			strings.Trim(aws.StringValue(part.ETag), `"`) == hex.EncodeToString(partMD5) {
+			completed[i] = &s3.CompletedPart{ETag: part.ETag, PartNumber: aws.Int64(number)}
+			continue
+		}
+		log.Printf("[DEBUG] Uploading part %d of %d of %s", number, len(file.partMD5s), target.href())
+		part, err := target.client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(target.bucket),
+			Key:           aws.String(target.key),
+			UploadId:      aws.String(uploadID),
+			PartNumber:    aws.Int64(number),
+			Body:          body,
+			ContentLength: aws.Int64(body.Size()),
+			ContentMD5:    aws.String(base64.StdEncoding.EncodeToString(partMD5)),
+		})
+		if err != nil {
+			return false, fmt.Errorf("uploading part %d of multipart upload %s: %w", number, uploadID, err)
+		}
+		completed[i] = &s3.CompletedPart{ETag: part.ETag, PartNumber: aws.Int64(number)}
+	}
+
+	_, err = target.client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(target.bucket),
+		Key:             aws.String(target.key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return false, fmt.Errorf("completing multipart upload %s: %w", uploadID, err)
+	}
+
+	head, err = target.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(target.bucket),
+		Key:    aws.String(target.key),
+	})
+	if err != nil {
+		return false, err
+	}
+	if got := strings.Trim(aws.StringValue(head.ETag), `"`); got != etag || aws.Int64Value(head.ContentLength) != file.size {
+		return false, fmt.Errorf("the uploaded object has the ETag %s and %d bytes, expected the ETag %s and %d bytes", got, aws.Int64Value(head.ContentLength), etag, file.size)
+	}
+	return true, nil
+}
+
+// imageUploadFindMultipartUpload returns the most recent incomplete multipart upload of the staging
+// object and its uploaded parts by part number, or an empty upload ID when there is none.
+func imageUploadFindMultipartUpload(ctx context.Context, target *imageUploadTarget) (string, map[int64]*s3.Part, error) {
+	var latest *s3.MultipartUpload
+	err := target.client.ListMultipartUploadsPagesWithContext(ctx, &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(target.bucket),
+		Prefix: aws.String(target.key),
+	}, func(page *s3.ListMultipartUploadsOutput, lastPage bool) bool {
+		for _, upload := range page.Uploads {
+			if aws.StringValue(upload.Key) != target.key {
+				continue
+			}
+			if latest == nil || aws.TimeValue(upload.Initiated).After(aws.TimeValue(latest.Initiated)) {
+				latest = upload
+			}
+		}
+		return true
+	})
+	if err != nil || latest == nil {
+		return "", nil, err
+	}
+
+	uploadID := aws.StringValue(latest.UploadId)
+	parts := map[int64]*s3.Part{}
+	err = target.client.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:   aws.String(target.bucket),
+		Key:      aws.String(target.key),
+		UploadId: aws.String(uploadID),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			parts[aws.Int64Value(part.PartNumber)] = part
+		}
+		return true
+	})
+	if err != nil {
+		if isImageUploadNotFound(err) {
+			// The upload was completed or aborted in the meantime
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	return uploadID, parts, nil
+}
+
+func imageUploadDeleteObject(ctx context.Context, target *imageUploadTarget) error {
+	_, err := target.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(target.bucket),
+		Key:    aws.String(target.key),
+	})
+	if err != nil && !isImageUploadNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isImageUploadNotFound(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	switch aerr.Code() {
+	case "NotFound", s3.ErrCodeNoSuchKey, s3.ErrCodeNoSuchUpload:
+		return true
+	}
+	return false
+}
+
+func isWaitForImageUploadAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (*vpcv1.Image, error) {
+	log.Printf("Waiting for image (%s) to be available.", id)
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("image (%s)", id),
+		Pending:  []string{isImageUploadStatusPending},
+		Target:   []string{isImageUploadStatusAvailable},
+		Failed:   []string{isImageUploadStatusFailed},
+		Refresh:  isImageUploadRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	image, err := stateWaiter.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return image.(*vpcv1.Image), nil
+}
+
+func isImageUploadRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return waiter.StatusRefresh(func(ctx context.Context) (*vpcv1.Image, *core.DetailedResponse, error) {
+		return sess.GetImageWithContext(ctx, &vpcv1.GetImageOptions{ID: &id})
+	})
+}
+
+func resourceIBMIsImageUploadRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	image, response, err := sess.GetImageWithContext(context, &vpcv1.GetImageOptions{
+		ID: core.StringPtr(d.Id()),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetImageWithContext failed: %s", err.Error()), "ibm_is_image_upload", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set(isImageName, image.Name); err != nil {
+		err = fmt.Errorf("Error setting name: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-name").GetDiag()
+	}
+	if image.OperatingSystem != nil {
+		if err = d.Set(isImageOperatingSystem, image.OperatingSystem.Name); err != nil {
+			err = fmt.Errorf("Error setting operating_system: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-operating_system").GetDiag()
+		}
+	}
+	if image.ResourceGroup != nil {
+		if err = d.Set(isImageResourceGroup, image.ResourceGroup.ID); err != nil {
+			err = fmt.Errorf("Error setting resource_group: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-resource_group").GetDiag()
+		}
+	}
+	if image.EncryptionKey != nil {
+		if err = d.Set(isImageEncryptionKey, image.EncryptionKey.CRN); err != nil {
+			err = fmt.Errorf("Error setting encryption_key: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-encryption_key").GetDiag()
+		}
+	}
+	if image.File != nil && image.File.Checksums != nil {
+		if err = d.Set(isImageCheckSum, image.File.Checksums.Sha256); err != nil {
+			err = fmt.Errorf("Error setting checksum: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-checksum").GetDiag()
+		}
+	}
+	if err = d.Set(isImageEncryption, image.Encryption); err != nil {
+		err = fmt.Errorf("Error setting encryption: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-encryption").GetDiag()
+	}
+	if err = d.Set(isImageStatus, image.Status); err != nil {
+		err = fmt.Errorf("Error setting status: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-status").GetDiag()
+	}
+	if err = d.Set(isImageVisibility, image.Visibility); err != nil {
+		err = fmt.Errorf("Error setting visibility: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-visibility").GetDiag()
+	}
+	if err = d.Set(IsImageCRN, image.CRN); err != nil {
+		err = fmt.Errorf("Error setting crn: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-crn").GetDiag()
+	}
+	_, bucket, err := parseImageUploadBucketCRN(d.Get(isImageUploadBucketCRN).(string))
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "parse-cos_bucket_crn").GetDiag()
+	}
+	target := &imageUploadTarget{bucket: bucket, location: d.Get(isImageUploadBucketLocation).(string), key: d.Get(isImageUploadKey).(string)}
+	if err = d.Set(isImageUploadFileHref, target.href()); err != nil {
+		err = fmt.Errorf("Error setting file_href: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "read", "set-file_href").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMIsImageUploadUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isImageName) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "update", "initialize-client")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		imagePatch, err := (&vpcv1.ImagePatch{
+			Name: core.StringPtr(d.Get(isImageName).(string)),
+		}).AsPatch()
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "update", "parse-image-patch")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		_, _, err = sess.UpdateImageWithContext(context, &vpcv1.UpdateImageOptions{
+			ID:         core.StringPtr(d.Id()),
+			ImagePatch: imagePatch,
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateImageWithContext failed: %s", err.Error()), "ibm_is_image_upload", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	if d.HasChange(isImageUploadDeleteStagingObject) && d.Get(isImageUploadDeleteStagingObject).(bool) && d.Get(isImageUploadStagingUploaded).(bool) {
+		target, err := imageUploadTargetFromResourceData(d, meta)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "update", "initialize-cos-client")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		if err = imageUploadDeleteObject(context, target); err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error deleting staging object %s: %s", target.href(), err), "ibm_is_image_upload", "update", "delete-object")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMIsImageUploadRead(context, d, meta)
+}
+
+func resourceIBMIsImageUploadDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	id := d.Id()
+	response, err := sess.DeleteImageWithContext(context, &vpcv1.DeleteImageOptions{
+		ID: &id,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteImageWithContext failed: %s", err.Error()), "ibm_is_image_upload", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("image (%s)", id),
+		Pending:  []string{isImageUploadStatusDeleting, isImageUploadStatusAvailable},
+		Target:   []string{waiter.NotFound},
+		Failed:   []string{isImageUploadStatusFailed},
+		Refresh:  isImageUploadRefreshFunc(sess, id),
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+	if _, err = stateWaiter.Wait(context); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_image_upload", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// Only a staging object that this resource uploaded is deleted, and only when it is asked for
+	if !d.Get(isImageUploadStagingUploaded).(bool) || !d.Get(isImageUploadDeleteStagingObject).(bool) {
+		d.SetId("")
+		return nil
+	}
+	target, err := imageUploadTargetFromResourceData(d, meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_image_upload", "delete", "initialize-cos-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if err = imageUploadDeleteObject(context, target); err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error deleting staging object %s: %s", target.href(), err), "ibm_is_image_upload", "delete", "delete-object")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
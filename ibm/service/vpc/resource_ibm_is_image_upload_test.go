@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISImageUpload_basic(t *testing.T) {
+	name := fmt.Sprintf("tfimg-upload-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tfimg-upload-update-%d", acctest.RandIntRange(10, 100))
+	key := fmt.Sprintf("tfimg-upload-%d.qcow2", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckImageUpload(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkImageUploadDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImageUploadConfig(name, key, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "status", "available"),
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "operating_system", acc.Image_operating_system),
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "cos_key", key),
+					resource.TestCheckResourceAttrPair("ibm_is_image_upload.testacc_upload", "checksum", "ibm_is_image_upload.testacc_upload", "source_sha256"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_upload.testacc_upload", "file_href"),
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "staging_object_uploaded", "true"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_upload.testacc_upload", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISImageUploadConfig(nameUpdate, key, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_image_upload.testacc_upload", "delete_staging_object", "true"),
+				),
+			},
+		},
+	})
+}
+
+func checkImageUploadDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_image_upload" {
+			continue
+		}
+
+		getimgoptions := &vpcv1.GetImageOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetImage(getimgoptions)
+		if err == nil {
+			return fmt.Errorf("Image still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMISImageUploadConfig(name, key string, deleteStagingObject bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_image_upload" "testacc_upload" {
+		name                  = "%s"
+		operating_system      = "%s"
+		source                = "%s"
+		cos_bucket_crn        = "%s"
+		cos_bucket_location   = "%s"
+		cos_key               = "%s"
+		part_size             = 5
+		delete_staging_object = %t
+	}`, name, acc.Image_operating_system, acc.IsImageUploadFile, acc.IsCosBucketCRN, acc.IsCosBucketLocation, key, deleteStagingObject)
+}
@@ -6,6 +6,7 @@ package vpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -14,12 +15,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -89,6 +90,9 @@ const (
 	isInstanceStatusPending              = "pending"
 	isInstanceStatusRunning              = "running"
 	isInstanceStatusFailed               = "failed"
+	isInstanceStatusSuspending           = "suspending"
+	isInstanceStatusSuspended            = "suspended"
+	isInstanceStatusResuming             = "resuming"
 	isInstanceAvailablePolicyHostFailure = "availability_policy_host_failure"
 
 	isInstanceBootAttachmentName       = "name"
@@ -133,8 +137,11 @@ const (
 	isInstanceVolumeBandwidthQoSMode      = "volume_bandwidth_qos_mode"
 )
 
+// ibmIsInstanceIdentity is the identity of ibm_is_instance, which can be used to import it.
+var ibmIsInstanceIdentity = vpcResourceIdentity("The unique identifier of the instance.")
+
 func ResourceIBMISInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMisInstanceCreate,
 		ReadContext:   resourceIBMisInstanceRead,
 		UpdateContext: resourceIBMisInstanceUpdate,
@@ -2222,7 +2229,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsInstanceIdentity)
 }
 
 func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
@@ -2919,7 +2926,7 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3545,7 +3552,7 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4148,7 +4155,7 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4765,7 +4772,7 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -5318,7 +5325,7 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -5392,86 +5399,77 @@ func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData
 	return resourceIBMisInstanceUpdate(context, d, meta)
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
-	communicator := make(chan interface{})
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isInstanceProvisioning},
-		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isInstanceRefreshFunc(instanceC, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
+	// Stops the force recovery when the wait ends
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
+	recoveryErrs := make(chan error, 1)
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(ctx, instanceC, meta, id, d, forceTimeout, recoveryErrs)
+	}
+
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s)", id),
+		Pending:  []string{isInstanceStatusPending, isInstanceStatusStarting, isInstanceStatusRestarting, isInstanceActionStatusStopping, isInstanceActionStatusStopped, isInstanceStatusSuspending, isInstanceStatusSuspended, isInstanceStatusResuming},
+		Target:   []string{isInstanceStatusRunning, isInstanceAvailable},
+		Failed:   []string{isInstanceStatusFailed},
+		Refresh:  isInstanceRefreshFunc(instanceC, id, d, recoveryErrs),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	instance, err := stateWaiter.Wait(ctx)
+	var stateErr *waiter.StateError
+	if errors.As(err, &stateErr) && stateErr.Failed {
+		return instance, fmt.Errorf("[ERROR] %w \n [WARNING] Running terraform apply again will remove the tainted instance and attempt to create the instance again replacing the previous configuration", err)
+	}
+	return instance, err
 }
 
-func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, recoveryErrs <-chan error) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
+		select {
+		case err := <-recoveryErrs:
+			return nil, "", err
+		default:
+		}
+
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
 		}
 		d.Set(isInstanceStatus, *instance.Status)
 
-		select {
-		case data := <-communicator:
-			return nil, "", data.(error)
-		default:
-			fmt.Println("no message sent")
-		}
-
-		if *instance.Status == "available" || *instance.Status == "failed" || *instance.Status == "running" {
-			// let know the isRestartStartAction() to stop
-			close(communicator)
-			// taint the instance if status is failed
-			if *instance.Status == "failed" {
-				instanceStatusReason := instance.StatusReasons
-
-				//set the status reasons
-				if instance.StatusReasons != nil {
-					statusReasonsList := make([]map[string]interface{}, 0)
-					for _, sr := range instance.StatusReasons {
-						currentSR := map[string]interface{}{}
-						if sr.Code != nil && sr.Message != nil {
-							currentSR[isInstanceStatusReasonsCode] = *sr.Code
-							currentSR[isInstanceStatusReasonsMessage] = *sr.Message
-							if sr.MoreInfo != nil {
-								currentSR[isInstanceStatusReasonsMoreInfo] = *sr.MoreInfo
-							}
-							statusReasonsList = append(statusReasonsList, currentSR)
-						}
+		// set the status reasons of a failed instance, it is tainted
+		if *instance.Status == isInstanceStatusFailed && instance.StatusReasons != nil {
+			statusReasonsList := make([]map[string]interface{}, 0)
+			for _, sr := range instance.StatusReasons {
+				currentSR := map[string]interface{}{}
+				if sr.Code != nil && sr.Message != nil {
+					currentSR[isInstanceStatusReasonsCode] = *sr.Code
+					currentSR[isInstanceStatusReasonsMessage] = *sr.Message
+					if sr.MoreInfo != nil {
+						currentSR[isInstanceStatusReasonsMoreInfo] = *sr.MoreInfo
 					}
-					d.Set(isInstanceStatusReasons, statusReasonsList)
+					statusReasonsList = append(statusReasonsList, currentSR)
 				}
-
-				out, err := json.MarshalIndent(instanceStatusReason, "", "    ")
-				if err != nil {
-					return instance, *instance.Status, fmt.Errorf("[ERROR] Instance (%s) went into failed state during the operation \n [WARNING] Running terraform apply again will remove the tainted instance and attempt to create the instance again replacing the previous configuration", *instance.ID)
-				}
-				return instance, *instance.Status, fmt.Errorf("[ERROR] Instance (%s) went into failed state during the operation \n (%+v) \n [WARNING] Running terraform apply again will remove the tainted instance and attempt to create the instance again replacing the previous configuration", *instance.ID, string(out))
 			}
-			return instance, *instance.Status, nil
-
+			d.Set(isInstanceStatusReasons, statusReasonsList)
 		}
-		return instance, isInstanceProvisioning, nil
+		return instance, *instance.Status, nil
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, id string, d *schema.ResourceData, forceTimeout int, recoveryErrs chan<- error) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
-	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
+	defer subticker.Stop()
 	for {
 		select {
 
@@ -5481,26 +5479,25 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
+				recoveryErrs <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(ctx, instanceC, meta, waitTimeout, id, d)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
+				recoveryErrs <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
 				return
 			}
-		case <-communicator:
-			// indicates refresh func is reached target and not proceed with the thread
-			subticker.Stop()
+		case <-ctx.Done():
+			// indicates the wait for the instance has ended
 			return
 
 		}
@@ -6243,7 +6240,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 	id := d.Id()
 	// network attachments
 
-	err = handleVolumePrototypesUpdate(d, instanceC)
+	err = handleVolumePrototypesUpdate(context, d, meta, instanceC)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("handleVolumePrototypesUpdate failed: %s", err.Error()), "ibm_is_instance", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6512,7 +6509,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 									return tfErr.GetDiag()
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6534,7 +6531,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 									return tfErr.GetDiag()
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6735,7 +6732,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6756,7 +6753,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6874,7 +6871,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6916,7 +6913,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6952,7 +6949,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6995,7 +6992,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7045,7 +7042,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7147,7 +7144,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStop(context, instanceC, meta, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7202,7 +7199,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStart(context, instanceC, meta, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStart failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7251,14 +7248,14 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				return tfErr.GetDiag()
 			}
 			if actiontype == "stop" {
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(context, instanceC, meta, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
 			} else if actiontype == "start" || actiontype == "reboot" {
-				_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStart(context, instanceC, meta, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStart failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7303,7 +7300,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(context, instanceC, meta, d, id, *vol.ID)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeAttached failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7335,7 +7332,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(context, instanceC, meta, d, d.Id(), *vol.ID)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7367,7 +7364,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7389,7 +7386,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7457,7 +7454,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7526,7 +7523,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7549,7 +7546,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7676,7 +7673,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(context, instanceC, meta, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7706,7 +7703,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+			_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7853,7 +7850,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			_, err = isWaitForInstanceActionStop(context, instanceC, meta, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7901,7 +7898,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(context, instanceC, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -8012,7 +8009,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(context, instanceC, meta, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -8039,7 +8036,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(context, instanceC, meta, d, d.Id(), *vol.ID)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_instance", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -8060,7 +8057,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+	_, err = isWaitForInstanceDelete(context, instanceC, meta, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceDelete failed: %s", err.Error()), "ibm_is_instance", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -8070,7 +8067,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 		if _, ok := d.GetOk(isInstanceBootVolume); ok {
 			autoDel := d.Get("boot_volume.0.auto_delete_volume").(bool)
 			if autoDel {
-				_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+				_, err = isWaitForVolumeDeleted(context, instanceC, meta, bootvolid, d.Timeout(schema.TimeoutDelete))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed: %s", err.Error()), "ibm_is_instance", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -8121,116 +8118,92 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceDeleting, isInstanceAvailable},
-		Target:  []string{isInstanceDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
-			getinsoptions := &vpcv1.GetInstanceOptions{
-				ID: &id,
-			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
-			if err != nil {
-				if response != nil && response.StatusCode == 404 {
-					return instance, isInstanceDeleteDone, nil
-				}
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
-			}
-			if *instance.Status == isInstanceFailed {
-				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to delete: %v", d.Id(), err)
-			}
-			return instance, isInstanceDeleting, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+func isWaitForInstanceDelete(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id string) (interface{}, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s)", id),
+		Pending:  []string{isInstanceDeleting, isInstanceStatusRunning, isInstanceStatusPending, isInstanceStatusStarting, isInstanceStatusRestarting, isInstanceActionStatusStopping, isInstanceActionStatusStopped, isInstanceStatusSuspending, isInstanceStatusSuspended, isInstanceStatusResuming},
+		Target:   []string{waiter.NotFound},
+		Failed:   []string{isInstanceFailed},
+		Refresh: waiter.StatusRefresh(func(ctx context.Context) (*vpcv1.Instance, *core.DetailedResponse, error) {
+			return instanceC.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{ID: &id})
+		}),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
-	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
-		Target:  []string{isInstanceActionStatusStopped, isInstanceStatusFailed, ""},
-		Refresh: func() (interface{}, string, error) {
-			getinsoptions := &vpcv1.GetInstanceOptions{
-				ID: &id,
-			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
-			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
-			}
-			select {
-			case data := <-communicator:
-				return nil, "", data.(error)
-			default:
-				fmt.Println("no message sent")
-			}
-			if *instance.Status == isInstanceStatusFailed {
-				// let know the isRestartStopAction() to stop
-				close(communicator)
-				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to stop: %v", id, err)
-			}
-			return instance, *instance.Status, nil
-		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
+func isWaitForInstanceActionStop(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+	// Stops the force recovery when the wait ends
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
+	recoveryErrs := make(chan error, 1)
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStopAction(ctx, instanceC, id, forceTimeout, recoveryErrs)
+	}
+
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s)", id),
+		Pending:  []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusSuspending, isInstanceStatusSuspended, isInstanceStatusResuming},
+		Target:   []string{isInstanceActionStatusStopped},
+		Failed:   []string{isInstanceStatusFailed},
+		Refresh:  isInstanceActionRefreshFunc(instanceC, id, recoveryErrs),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
-	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceActionStatusStopped, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting},
-		Target:  []string{isInstanceStatusRunning, isInstanceStatusFailed, ""},
-		Refresh: func() (interface{}, string, error) {
-			getinsoptions := &vpcv1.GetInstanceOptions{
-				ID: &id,
-			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
-			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
-			}
-			select {
-			case data := <-communicator:
-				return nil, "", data.(error)
-			default:
-				fmt.Println("no message sent")
-			}
-			if *instance.Status == isInstanceStatusFailed {
-				// let know the isRestartStopAction() to stop
-				close(communicator)
-				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to start: %v", id, err)
-			}
-			return instance, *instance.Status, nil
-		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
+func isWaitForInstanceActionStart(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+	// Stops the force recovery when the wait ends
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
+	recoveryErrs := make(chan error, 1)
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStopAction(ctx, instanceC, id, forceTimeout, recoveryErrs)
 	}
 
-	return stateConf.WaitForState()
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s)", id),
+		Pending:  []string{isInstanceActionStatusStopped, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting, isInstanceStatusSuspending, isInstanceStatusSuspended, isInstanceStatusResuming},
+		Target:   []string{isInstanceStatusRunning},
+		Failed:   []string{isInstanceStatusFailed},
+		Refresh:  isInstanceActionRefreshFunc(instanceC, id, recoveryErrs),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
+	}
+
+	return stateWaiter.Wait(ctx)
+}
+
+func isInstanceActionRefreshFunc(instanceC *vpcv1.VpcV1, id string, recoveryErrs <-chan error) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
+		select {
+		case err := <-recoveryErrs:
+			return nil, "", err
+		default:
+		}
+
+		getinsoptions := &vpcv1.GetInstanceOptions{
+			ID: &id,
+		}
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		}
+		return instance, *instance.Status, nil
+	}
 }
 
-func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStopAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, forceTimeout int, recoveryErrs chan<- error) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
-	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
+	defer subticker.Stop()
 	for {
 		select {
 
@@ -8240,82 +8213,56 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action stop: %s\n%s", err, response)
+				recoveryErrs <- fmt.Errorf("[ERROR] Error retrying instance action stop: %s\n%s", err, response)
 				return
 			}
-		case <-communicator:
-			// indicates refresh func is reached target and not proceed with the thread)
-			subticker.Stop()
+		case <-ctx.Done():
+			// indicates the wait for the instance has ended
 			return
 
 		}
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) volume (%s) to be attached.", id, volID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceVolumeAttaching},
-		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("volume attachment (%s) of instance (%s)", volID, id),
+		Pending:  []string{isInstanceVolumeAttaching},
+		Target:   []string{isInstanceVolumeAttached},
+		Refresh:  isInstanceVolumeRefreshFunc(instanceC, id, volID),
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) waiter.RefreshFunc {
+	return waiter.StatusRefresh(func(ctx context.Context) (*vpcv1.VolumeAttachment, *core.DetailedResponse, error) {
 		getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
 			InstanceID: &id,
 			ID:         &volID,
 		}
-		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
-		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Attaching volume: %s\n%s", err, response)
-		}
-
-		if *vol.Status == isInstanceVolumeAttached {
-			return vol, isInstanceVolumeAttached, nil
-		}
-
-		return vol, isInstanceVolumeAttaching, nil
-	}
+		return instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
+	})
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
-		Target:  []string{isInstanceDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
-			getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
-				InstanceID: &id,
-				ID:         &volID,
-			}
-			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
-			if err != nil {
-				if response != nil && response.StatusCode == 404 {
-					return vol, isInstanceDeleteDone, nil
-				}
-				return nil, "", fmt.Errorf("[ERROR] Error Detaching: %s\n%s", err, response)
-			}
-			if *vol.Status == isInstanceFailed {
-				return vol, *vol.Status, fmt.Errorf("[ERROR] The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
-			}
-			return vol, isInstanceVolumeDetaching, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+func isWaitForInstanceVolumeDetached(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id, volID string) (interface{}, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("volume attachment (%s) of instance (%s)", volID, id),
+		Pending:  []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
+		Target:   []string{waiter.NotFound},
+		Failed:   []string{isInstanceFailed},
+		Refresh:  isInstanceVolumeRefreshFunc(instanceC, id, volID),
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceIbmIsInstanceInstanceDiskToMap(instanceDisk vpcv1.InstanceDisk) map[string]interface{} {
@@ -8987,7 +8934,7 @@ func ResourceValidateInstanceVolumePrototypes(diff *schema.ResourceDiff, meta in
 	return nil
 }
 
-func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1) error {
+func handleVolumePrototypesUpdate(context context.Context, d *schema.ResourceData, meta interface{}, instanceC *vpcv1.VpcV1) error {
 	if !d.HasChange("volume_prototypes") || d.IsNewResource() {
 		return nil
 	}
@@ -9197,7 +9144,7 @@ func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1
 				return fmt.Errorf("error attaching volume %s: %w", name, err)
 			}
 
-			_, err = isWaitForInstanceVolumeAttached(instanceC, d, instanceID, *newVolume.ID)
+			_, err = isWaitForInstanceVolumeAttached(context, instanceC, meta, d, instanceID, *newVolume.ID)
 			if err != nil {
 				return err
 			}
@@ -9223,7 +9170,7 @@ func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1
 				return fmt.Errorf("error removing volume %s: %w", name, err)
 			}
 
-			_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceID, volID)
+			_, err = isWaitForInstanceVolumeDetached(context, instanceC, meta, d, instanceID, volID)
 			if err != nil {
 				return err
 			}
@@ -8,16 +8,17 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,10 +32,18 @@ const (
 	isInstanceGroupAccessTags    = "access_tags"
 	isInstanceGroupUserTagType   = "user"
 	isInstanceGroupAccessTagType = "access"
+
+	isInstanceGroupUpdatePolicy        = "update_policy"
+	isInstanceGroupUpdateStrategyRoll  = "rolling"
+	isInstanceGroupUpdateStrategySurge = "surge"
+	isInstanceGroupOutdatedMembers     = "outdated_members"
 )
 
+// ibmIsInstanceGroupIdentity is the identity of ibm_is_instance_group, which can be used to import it.
+var ibmIsInstanceGroupIdentity = vpcResourceIdentity("The unique identifier of the instance group.")
+
 func ResourceIBMISInstanceGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISInstanceGroupCreate,
 		ReadContext:   resourceIBMISInstanceGroupRead,
 		UpdateContext: resourceIBMISInstanceGroupUpdate,
@@ -53,6 +62,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			resourceIBMISInstanceGroupOutdatedMembersCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -167,8 +177,53 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of access management tags",
 			},
+
+			isInstanceGroupUpdatePolicy: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "How the members of the instance group are replaced when the instance template changes. If unspecified, existing members keep running the previous instance template.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"strategy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isInstanceGroupUpdateStrategyRoll,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{isInstanceGroupUpdateStrategyRoll, isInstanceGroupUpdateStrategySurge}),
+							Description:  "rolling deletes old members before the instance group creates their replacements, surge creates the replacements first and then deletes the old members.",
+						},
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validate.ValidateAllowedRangeInt(1, 1000),
+							Description:  "The number of members that are replaced at a time.",
+						},
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validate.ValidateAllowedRangeInt(1, 1000),
+							Description:  "The maximum number of members that may be unhealthy or replaced at a time with the rolling strategy.",
+						},
+						"drain_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 3600),
+							Description:  "The number of seconds that the open connections of a member are given to complete, once its load balancer pool member no longer receives new connections, before the member is deleted.",
+						},
+					},
+				},
+			},
+
+			isInstanceGroupOutdatedMembers: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of members that run another instance template than instance_template. With an update_policy, the next apply replaces them.",
+			},
 		},
-	}
+	}, ibmIsInstanceGroupIdentity)
 }
 
 func ResourceIBMISInstanceGroupValidator() *validate.ResourceValidator {
@@ -283,7 +338,7 @@ func resourceIBMISInstanceGroupCreate(context context.Context, d *schema.Resourc
 	}
 	d.SetId(*instanceGroup.ID)
 
-	_, healthError := waitForHealthyInstanceGroup(d.Id(), meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(context, d.Id(), meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -321,7 +376,7 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
-	var changed bool
+	var changed, rollMembers bool
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
@@ -355,6 +410,11 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		}
 		changed = true
 	}
+	// Members that still run another instance template, for example after
+	// a failed apply, are replaced as well.
+	if d.HasChange("instance_template") || d.HasChange(isInstanceGroupOutdatedMembers) {
+		rollMembers = len(d.Get(isInstanceGroupUpdatePolicy).([]interface{})) > 0
+	}
 
 	if d.HasChange("instance_count") {
 		membershipCount := d.Get("instance_count").(int)
@@ -402,16 +462,380 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		}
 
 		// wait for instance group health update with update timeout configured.
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 	}
+
+	if rollMembers {
+		err = resourceIBMISInstanceGroupRollMembers(context, sess, d, meta, d.Id(), d.Get("instance_template").(string))
+		if err != nil {
+			// The remaining members are counted in outdated_members by the
+			// next refresh, so that the next apply replaces them.
+			d.Partial(true)
+			tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error replacing the members of the instance group: %s", err.Error()), "ibm_is_instance_group", "update", "roll-members")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMISInstanceGroupRead(context, d, meta)
 }
 
+// resourceIBMISInstanceGroupRollMembers replaces the members of the instance
+// group that run another instance template, in batches of the update policy.
+// With the rolling strategy, old members are deleted first and the instance
+// group creates their replacements from the new instance template. With the
+// surge strategy, the instance group is scaled up first, and old members are
+// only deleted once their replacements are healthy. Old members are drained
+// from the load balancer pool before they are deleted. An enabled autoscale
+// manager owns the membership count, so the rolling strategy then leaves the
+// count to the manager, and the surge strategy fails.
+func resourceIBMISInstanceGroupRollMembers(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, meta interface{}, instanceGroupID, instanceTemplateID string) error {
+	strategy := d.Get("update_policy.0.strategy").(string)
+	batchSize := d.Get("update_policy.0.batch_size").(int)
+	maxUnavailable := d.Get("update_policy.0.max_unavailable").(int)
+	drainTimeout := time.Duration(d.Get("update_policy.0.drain_timeout").(int)) * time.Second
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
+	instanceGroup, response, err := sess.GetInstanceGroupWithContext(context, &getInstanceGroupOptions)
+	if err != nil || instanceGroup == nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+	}
+	membershipCount := *instanceGroup.MembershipCount
+	autoScale, err := hasEnabledInstanceGroupAutoScaleManager(context, sess, instanceGroupID)
+	if err != nil {
+		return err
+	}
+	if autoScale && strategy == isInstanceGroupUpdateStrategySurge {
+		return fmt.Errorf("[ERROR] The surge strategy can't scale up instance group %s, because an enabled autoscale manager sets its membership count. Use the rolling strategy, or disable the autoscale manager during the update", instanceGroupID)
+	}
+
+	for {
+		if autoScale {
+			instanceGroup, response, err = sess.GetInstanceGroupWithContext(context, &getInstanceGroupOptions)
+			if err != nil || instanceGroup == nil {
+				return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+			}
+			membershipCount = *instanceGroup.MembershipCount
+		}
+		memberships, err := listInstanceGroupMemberships(context, sess, instanceGroupID)
+		if err != nil {
+			return err
+		}
+		old := outdatedInstanceGroupMemberships(memberships, instanceTemplateID)
+		unavailable := 0
+		for _, membership := range memberships {
+			if *membership.Status == "deleting" {
+				continue
+			}
+			healthy, err := isInstanceGroupMembershipHealthy(context, sess, membership)
+			if err != nil {
+				return err
+			}
+			if !healthy {
+				unavailable++
+			}
+		}
+		if len(old) == 0 {
+			return nil
+		}
+		sort.Slice(old, func(i, j int) bool {
+			return time.Time(*old[i].CreatedAt).Before(time.Time(*old[j].CreatedAt))
+		})
+
+		batch := batchSize
+		if batch > len(old) {
+			batch = len(old)
+		}
+		if strategy == isInstanceGroupUpdateStrategySurge {
+			log.Printf("[INFO] Scaling instance group (%s) up by %d members", instanceGroupID, batch)
+			if err = setInstanceGroupMembershipCount(context, sess, instanceGroupID, membershipCount+int64(batch)); err != nil {
+				return err
+			}
+			if _, err = waitForInstanceGroupMembers(context, sess, meta, instanceGroupID, membershipCount+int64(batch), timeout); err != nil {
+				return err
+			}
+		} else {
+			if batch > maxUnavailable-unavailable {
+				batch = maxUnavailable - unavailable
+			}
+			if batch < 1 {
+				log.Printf("[INFO] %d members of instance group (%s) are unavailable, waiting for them to be healthy", unavailable, instanceGroupID)
+				if _, err = waitForInstanceGroupMembers(context, sess, meta, instanceGroupID, membershipCount, timeout); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if err = drainInstanceGroupMemberships(context, sess, meta, old[:batch], drainTimeout, timeout); err != nil {
+			return err
+		}
+		for _, membership := range old[:batch] {
+			log.Printf("[INFO] Deleting member (%s) of instance group (%s) that runs instance template (%s)", *membership.ID, instanceGroupID, *membership.InstanceTemplate.ID)
+			deleteInstanceGroupMembershipOptions := vpcv1.DeleteInstanceGroupMembershipOptions{
+				ID:              membership.ID,
+				InstanceGroupID: &instanceGroupID,
+			}
+			response, err := sess.DeleteInstanceGroupMembershipWithContext(context, &deleteInstanceGroupMembershipOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error deleting instance group membership %s: %s\n%s", *membership.ID, err, response)
+			}
+		}
+
+		// The instance group may reduce its membership count when a member
+		// is deleted, so it is restored for the replacements to be created,
+		// unless the autoscale manager does that.
+		if !autoScale {
+			if err = setInstanceGroupMembershipCount(context, sess, instanceGroupID, membershipCount); err != nil {
+				return err
+			}
+		}
+		if _, err = waitForInstanceGroupMembers(context, sess, meta, instanceGroupID, membershipCount, timeout); err != nil {
+			return err
+		}
+	}
+}
+
+// outdatedInstanceGroupMemberships returns the memberships that run another
+// instance template, and are not being deleted.
+func outdatedInstanceGroupMemberships(memberships []vpcv1.InstanceGroupMembership, instanceTemplateID string) []vpcv1.InstanceGroupMembership {
+	old := []vpcv1.InstanceGroupMembership{}
+	for _, membership := range memberships {
+		if *membership.Status == "deleting" {
+			continue
+		}
+		if membership.InstanceTemplate != nil && *membership.InstanceTemplate.ID != instanceTemplateID {
+			old = append(old, membership)
+		}
+	}
+	return old
+}
+
+// drainInstanceGroupMemberships sets the weight of the load balancer pool
+// members of the memberships to 0, so that the load balancer no longer sends
+// them new connections, and then gives their open connections the drain
+// timeout to complete.
+func drainInstanceGroupMemberships(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, memberships []vpcv1.InstanceGroupMembership, drainTimeout, timeout time.Duration) error {
+	drained := false
+	for _, membership := range memberships {
+		if membership.PoolMember == nil || membership.PoolMember.Href == nil {
+			continue
+		}
+		lbID, poolID, err := instanceGroupPoolMemberPath(membership)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Draining load balancer pool member (%s) of member (%s)", *membership.PoolMember.ID, *membership.ID)
+		loadBalancerPoolMemberPatch, err := (&vpcv1.LoadBalancerPoolMemberPatch{Weight: core.Int64Ptr(0)}).AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for LoadBalancerPoolMemberPatch: %s", err)
+		}
+		updateLoadBalancerPoolMemberOptions := &vpcv1.UpdateLoadBalancerPoolMemberOptions{
+			LoadBalancerID:              &lbID,
+			PoolID:                      &poolID,
+			ID:                          membership.PoolMember.ID,
+			LoadBalancerPoolMemberPatch: loadBalancerPoolMemberPatch,
+		}
+		_, response, err := sess.UpdateLoadBalancerPoolMemberWithContext(ctx, updateLoadBalancerPoolMemberOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error draining load balancer pool member %s: %s\n%s", *membership.PoolMember.ID, err, response)
+		}
+
+		// The load balancer accepts no other change until it is active again.
+		stateWaiter := &waiter.StateWaiter{
+			Resource: fmt.Sprintf("load balancer (%s)", lbID),
+			Pending:  []string{"create_pending", "update_pending", "maintenance_pending"},
+			Target:   []string{"active"},
+			Failed:   []string{"failed"},
+			Refresh: func(refreshCtx context.Context) (interface{}, string, error) {
+				lb, response, err := sess.GetLoadBalancerWithContext(refreshCtx, &vpcv1.GetLoadBalancerOptions{ID: &lbID})
+				if err != nil || lb == nil {
+					return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+				}
+				return lb, *lb.ProvisioningStatus, nil
+			},
+			Timeout: timeout,
+			Policy:  waiter.PolicyFromMeta(meta),
+		}
+		if _, err = stateWaiter.Wait(ctx); err != nil {
+			return err
+		}
+		drained = true
+	}
+
+	if drained && drainTimeout > 0 {
+		log.Printf("[INFO] Waiting %s for the connections of the drained members to complete", drainTimeout)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(drainTimeout):
+		}
+	}
+	return nil
+}
+
+// instanceGroupPoolMemberPath returns the IDs of the load balancer and of the
+// pool of the pool member of the membership.
+func instanceGroupPoolMemberPath(membership vpcv1.InstanceGroupMembership) (lbID, poolID string, err error) {
+	// The href of a pool member is .../load_balancers/<lb>/pools/<pool>/members/<member>
+	parts := strings.Split(*membership.PoolMember.Href, "/")
+	if len(parts) < 10 {
+		return "", "", fmt.Errorf("[ERROR] Unexpected load balancer pool member href %s", *membership.PoolMember.Href)
+	}
+	return parts[5], parts[7], nil
+}
+
+func listInstanceGroupMemberships(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	start := ""
+	allrecs := []vpcv1.InstanceGroupMembership{}
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMembershipsWithContext(context, &listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing instance group memberships: %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, instanceGroupMembershipCollection.Memberships...)
+		start = flex.GetNext(instanceGroupMembershipCollection.Next)
+		if start == "" {
+			return allrecs, nil
+		}
+	}
+}
+
+func setInstanceGroupMembershipCount(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string, membershipCount int64) error {
+	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
+	instanceGroup, response, err := sess.GetInstanceGroupWithContext(context, &getInstanceGroupOptions)
+	if err != nil || instanceGroup == nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+	}
+	if *instanceGroup.MembershipCount == membershipCount {
+		return nil
+	}
+
+	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{
+		MembershipCount: &membershipCount,
+	}
+	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+	}
+	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{
+		ID:                 &instanceGroupID,
+		InstanceGroupPatch: instanceGroupPatch,
+	}
+	_, response, err = sess.UpdateInstanceGroupWithContext(context, &instanceGroupUpdateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the membership count of instance group %s: %s\n%s", instanceGroupID, err, response)
+	}
+	return nil
+}
+
+// hasEnabledInstanceGroupAutoScaleManager reports whether the instance group
+// has an autoscale manager with management enabled.
+func hasEnabledInstanceGroupAutoScaleManager(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string) (bool, error) {
+	start := ""
+	for {
+		listInstanceGroupManagersOptions := vpcv1.ListInstanceGroupManagersOptions{InstanceGroupID: &instanceGroupID}
+		if start != "" {
+			listInstanceGroupManagersOptions.Start = &start
+		}
+		managers, response, err := sess.ListInstanceGroupManagersWithContext(context, &listInstanceGroupManagersOptions)
+		if err != nil || managers == nil {
+			return false, fmt.Errorf("[ERROR] Error listing the managers of instance group %s: %s\n%s", instanceGroupID, err, response)
+		}
+		for _, managerIntf := range managers.Managers {
+			manager, ok := managerIntf.(*vpcv1.InstanceGroupManager)
+			if ok && manager.ManagerType != nil && *manager.ManagerType == "autoscale" && manager.ManagementEnabled != nil && *manager.ManagementEnabled {
+				return true, nil
+			}
+		}
+		start = flex.GetNext(managers.Next)
+		if start == "" {
+			return false, nil
+		}
+	}
+}
+
+// isInstanceGroupMembershipHealthy reports whether the membership is healthy
+// and, when the instance group has a load balancer pool, whether its pool
+// member is healthy.
+func isInstanceGroupMembershipHealthy(context context.Context, sess *vpcv1.VpcV1, membership vpcv1.InstanceGroupMembership) (bool, error) {
+	if *membership.Status != HEALTHY {
+		return false, nil
+	}
+	if membership.PoolMember == nil || membership.PoolMember.Href == nil {
+		return true, nil
+	}
+
+	lbID, poolID, err := instanceGroupPoolMemberPath(membership)
+	if err != nil {
+		return false, err
+	}
+	getLoadBalancerPoolMemberOptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &poolID,
+		ID:             membership.PoolMember.ID,
+	}
+	poolMember, response, err := sess.GetLoadBalancerPoolMemberWithContext(context, getLoadBalancerPoolMemberOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+	}
+	return *poolMember.Health == "ok", nil
+}
+
+// waitForInstanceGroupMembers waits until the instance group has the number
+// of members, and all of them are healthy.
+func waitForInstanceGroupMembers(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, instanceGroupID string, membershipCount int64, timeout time.Duration) (interface{}, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance group (%s) memberships", instanceGroupID),
+		Pending:  []string{SCALING},
+		Target:   []string{HEALTHY},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			memberships, err := listInstanceGroupMemberships(ctx, sess, instanceGroupID)
+			if err != nil {
+				return nil, SCALING, err
+			}
+			if int64(len(memberships)) != membershipCount {
+				return memberships, SCALING, nil
+			}
+			for _, membership := range memberships {
+				if *membership.Status == "failed" {
+					return memberships, SCALING, fmt.Errorf("[ERROR] Instance group membership %s failed", *membership.ID)
+				}
+				healthy, err := isInstanceGroupMembershipHealthy(ctx, sess, membership)
+				if err != nil {
+					return nil, SCALING, err
+				}
+				if !healthy {
+					return memberships, SCALING, nil
+				}
+			}
+			return memberships, HEALTHY, nil
+		},
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
+	}
+
+	return stateWaiter.Wait(ctx)
+}
+
 func resourceIBMISInstanceGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -502,6 +926,21 @@ func resourceIBMISInstanceGroupRead(context context.Context, d *schema.ResourceD
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_group", "read", "set-crn").GetDiag()
 	}
 
+	outdatedMembers := 0
+	if len(d.Get(isInstanceGroupUpdatePolicy).([]interface{})) > 0 && !core.IsNil(instanceGroup.InstanceTemplate) {
+		memberships, err := listInstanceGroupMemberships(context, sess, instanceGroupID)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_group", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		outdatedMembers = len(outdatedInstanceGroupMemberships(memberships, *instanceGroup.InstanceTemplate.ID))
+	}
+	if err = d.Set(isInstanceGroupOutdatedMembers, outdatedMembers); err != nil {
+		err = fmt.Errorf("Error setting outdated_members: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_group", "read", "set-outdated_members").GetDiag()
+	}
+
 	tags, err := flex.GetTagsUsingCRN(meta, *instanceGroup.CRN)
 	if err != nil {
 		log.Printf(
@@ -571,7 +1010,7 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -593,7 +1032,7 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 		}
 		if lbStatus != "active" {
 			log.Printf("Load Balancer [%s] is not active....Waiting it to be active!\n", loadBalancerID)
-			_, err := isWaitForLBAvailable(sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
+			_, err := isWaitForLBAvailable(context, sess, meta, loadBalancerID, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_instance_group", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -625,7 +1064,7 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
-	_, deleteError := waitForInstanceGroupDelete(d, meta)
+	_, deleteError := waitForInstanceGroupDelete(context, d, meta)
 	if deleteError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForInstanceGroupDelete failed: %s", deleteError.Error()), "ibm_is_instance_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -634,6 +1073,26 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
+// resourceIBMISInstanceGroupOutdatedMembersCustomizeDiff plans the
+// replacement of the members that run another instance template, when the
+// instance group has an update policy. The replacement is also planned when
+// a previous apply did not replace all of the members.
+func resourceIBMISInstanceGroupOutdatedMembersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if len(diff.Get(isInstanceGroupUpdatePolicy).([]interface{})) == 0 {
+		if diff.HasChange("instance_template") {
+			return diff.SetNewComputed(isInstanceGroupOutdatedMembers)
+		}
+		return nil
+	}
+	if diff.HasChange("instance_template") || diff.Get(isInstanceGroupOutdatedMembers).(int) > 0 {
+		return diff.SetNew(isInstanceGroupOutdatedMembers, 0)
+	}
+	return nil
+}
+
 func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -651,7 +1110,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForHealthyInstanceGroup(ctx context.Context, instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -659,11 +1118,12 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 
-	healthStateConf := &resource.StateChangeConf{
-		Pending: []string{SCALING},
-		Target:  []string{HEALTHY},
-		Refresh: func() (interface{}, string, error) {
-			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance group (%s)", instanceGroupID),
+		Pending:  []string{SCALING},
+		Target:   []string{HEALTHY},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			instanceGroup, response, err := sess.GetInstanceGroupWithContext(ctx, &getInstanceGroupOptions)
 			if err != nil || instanceGroup == nil {
 				return nil, SCALING, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
 			}
@@ -674,33 +1134,30 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 			}
 			return instanceGroup, *instanceGroup.Status, nil
 		},
-		Timeout:      timeout,
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return healthStateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 
 }
 
-func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
-	healthStateConf := &resource.StateChangeConf{
-		Pending: []string{HEALTHY},
-		Target:  []string{DELETING},
-		Refresh: func() (interface{}, string, error) {
+func waitForInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance group (%s)", d.Id()),
+		Pending:  []string{HEALTHY},
+		Target:   []string{DELETING},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			resp, err := resourceIBMISInstanceGroupExists(d, meta)
 			if resp {
 				return resp, HEALTHY, nil
 			}
 			return resp, DELETING, err
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return healthStateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 
 }
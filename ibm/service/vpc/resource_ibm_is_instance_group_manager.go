@@ -262,7 +262,7 @@ func resourceIBMISInstanceGroupManagerCreate(context context.Context, d *schema.
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 		if healthError != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -353,7 +353,7 @@ func resourceIBMISInstanceGroupManagerUpdate(context context.Context, d *schema.
 		}
 		updateInstanceGroupManagerOptions.InstanceGroupManagerPatch = instanceGroupManagerPatch
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -529,7 +529,7 @@ func resourceIBMISInstanceGroupManagerDelete(context context.Context, d *schema.
 		InstanceGroupID: &instanceGroupID,
 	}
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
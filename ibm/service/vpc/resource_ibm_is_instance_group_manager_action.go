@@ -274,7 +274,7 @@ func resourceIBMISInstanceGroupManagerActionCreate(context context.Context, d *s
 
 	instanceGroupManagerActionOptions.InstanceGroupManagerActionPrototype = &instanceGroupManagerActionPrototype
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager_action", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -375,7 +375,7 @@ func resourceIBMISInstanceGroupManagerActionUpdate(context context.Context, d *s
 		}
 		updateInstanceGroupManagerActionOptions.InstanceGroupManagerActionPatch = instanceGroupManagerActionPatch
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager_action", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -541,7 +541,7 @@ func resourceIBMISInstanceGroupManagerActionDelete(context context.Context, d *s
 	deleteInstanceGroupManagerActionOptions.InstanceGroupManagerID = &instancegroupmanagerscheduledID
 	deleteInstanceGroupManagerActionOptions.ID = &instanceGroupManagerActionID
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager_action", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -142,7 +142,7 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(context context.Context, d *s
 	conns.IbmMutexKV.Lock(isInsGrpKey)
 	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager_policy", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -217,7 +217,7 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(context context.Context, d *s
 		conns.IbmMutexKV.Lock(isInsGrpKey)
 		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
 
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager_policy", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -331,7 +331,7 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(context context.Context, d *s
 	conns.IbmMutexKV.Lock(isInsGrpKey)
 	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
 
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", healthError.Error()), "ibm_is_instance_group_manager_policy", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -10,10 +10,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -351,7 +351,7 @@ func resourceIBMIsInstanceNetworkAttachmentCreate(context context.Context, d *sc
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkAttachmentOptions.InstanceID, *instanceNetworkAttachment.ID))
-	_, err = isWaitForInstanceNetworkAttachmentStable(vpcClient, *createInstanceNetworkAttachmentOptions.InstanceID, *instanceNetworkAttachment.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForInstanceNetworkAttachmentStable(context, vpcClient, meta, *createInstanceNetworkAttachmentOptions.InstanceID, *instanceNetworkAttachment.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceNetworkAttachmentStable failed: %s", err.Error()), "ibm_is_instance_network_attachment", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -657,7 +657,7 @@ func resourceIBMIsInstanceNetworkAttachmentUpdate(context context.Context, d *sc
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -678,7 +678,7 @@ func resourceIBMIsInstanceNetworkAttachmentUpdate(context context.Context, d *sc
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -746,7 +746,7 @@ func resourceIBMIsInstanceNetworkAttachmentDelete(context context.Context, d *sc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceNetworkAttachmentDeleted(vpcClient, parts[0], parts[1], ina, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForInstanceNetworkAttachmentDeleted(context, vpcClient, meta, parts[0], parts[1], ina, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceNetworkAttachmentDeleted failed: %s", err.Error()), "ibm_is_instance_network_attachment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1062,27 +1062,27 @@ func resourceIBMIsInstanceNetworkAttachmentSubnetReferenceDeletedToMap(model *vp
 	return modelMap, nil
 }
 
-func isWaitForInstanceNetworkAttachmentStable(instanceC *vpcv1.VpcV1, instanceId, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForInstanceNetworkAttachmentStable(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, instanceId, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for instance network attachment (%s) to be stable.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting", "waiting", "updating", "pending"},
-		Target:     []string{"stable", "failed", "suspended", ""},
-		Refresh:    isInstanceNetworkAttachmentRefreshFunc(instanceC, instanceId, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s) network attachment (%s)", instanceId, id),
+		Pending:  []string{"deleting", "waiting", "updating", "pending"},
+		Target:   []string{"stable", "failed", "suspended", ""},
+		Refresh:  isInstanceNetworkAttachmentRefreshFunc(instanceC, instanceId, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
-func isInstanceNetworkAttachmentRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceNetworkAttachmentRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{
 			InstanceID: &instanceId,
 			ID:         &id,
 		}
-		networkAttachment, response, err := instanceC.GetInstanceNetworkAttachment(getInstanceNetworkAttachmentOptions)
+		networkAttachment, response, err := instanceC.GetInstanceNetworkAttachmentWithContext(ctx, getInstanceNetworkAttachmentOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting network attachment: %s\n%s", err, response)
 		}
@@ -1094,27 +1094,27 @@ func isInstanceNetworkAttachmentRefreshFunc(instanceC *vpcv1.VpcV1, instanceId,
 		return networkAttachment, *networkAttachment.LifecycleState, nil
 	}
 }
-func isWaitForInstanceNetworkAttachmentDeleted(instanceC *vpcv1.VpcV1, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment, timeout time.Duration) (interface{}, error) {
+func isWaitForInstanceNetworkAttachmentDeleted(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for instance network attachment (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting", "waiting", "updating", "pending"},
-		Target:     []string{"deleted", "failed", "suspended", ""},
-		Refresh:    isInstanceNetworkAttachmentDeleteRefreshFunc(instanceC, instanceId, id, ina),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s) network attachment (%s)", instanceId, id),
+		Pending:  []string{"deleting", "waiting", "updating", "pending"},
+		Target:   []string{"deleted", "failed", "suspended", ""},
+		Refresh:  isInstanceNetworkAttachmentDeleteRefreshFunc(instanceC, instanceId, id, ina),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
-func isInstanceNetworkAttachmentDeleteRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceNetworkAttachmentDeleteRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{
 			InstanceID: &instanceId,
 			ID:         &id,
 		}
-		networkAttachment, response, err := instanceC.GetInstanceNetworkAttachment(getInstanceNetworkAttachmentOptions)
+		networkAttachment, response, err := instanceC.GetInstanceNetworkAttachmentWithContext(ctx, getInstanceNetworkAttachmentOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return ina, "deleted", nil
@@ -11,9 +11,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -359,7 +359,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -383,7 +383,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -392,7 +392,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, meta, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -649,7 +649,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, vpcClient, meta, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -670,7 +670,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, vpcClient, meta, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -754,13 +754,13 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, meta, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -799,14 +799,14 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceDelete(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceDelete failed: %s", err.Error()), "ibm_is_instance_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, meta, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -818,23 +818,23 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	return nil
 }
 
-func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isNetworkInterfacePending},
-		Target:     []string{isNetworkInterfaceAvailable, isNetworkInterfaceFailed},
-		Refresh:    isNetworkInterfaceRefreshFunc(vpcClient, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("network interface (%s)", id),
+		Pending:  []string{isNetworkInterfacePending},
+		Target:   []string{isNetworkInterfaceAvailable, isNetworkInterfaceFailed},
+		Refresh:  isNetworkInterfaceRefreshFunc(vpcClient, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getInstanceNetworkInterfaceOptions := &vpcv1.GetInstanceNetworkInterfaceOptions{}
 		parts, err := flex.SepIdParts(d.Id(), "/")
@@ -845,7 +845,7 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 		getInstanceNetworkInterfaceOptions.SetInstanceID(parts[0])
 		getInstanceNetworkInterfaceOptions.SetID(parts[1])
 
-		networkInterface, response, err := vpcClient.GetInstanceNetworkInterface(getInstanceNetworkInterfaceOptions)
+		networkInterface, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(ctx, getInstanceNetworkInterfaceOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("GetInstanceNetworkInterface failed %s\n%s", err, response)
 		}
@@ -858,23 +858,23 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isNetworkInterfacePending, isNetworkInterfaceDeleting, isNetworkInterfaceAvailable},
-		Target:     []string{isNetworkInterfaceDeleted},
-		Refresh:    isNetworkInterfaceRefreshDeleteFunc(vpcClient, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("network interface (%s)", id),
+		Pending:  []string{isNetworkInterfacePending, isNetworkInterfaceDeleting, isNetworkInterfaceAvailable},
+		Target:   []string{isNetworkInterfaceDeleted},
+		Refresh:  isNetworkInterfaceRefreshDeleteFunc(vpcClient, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getInstanceNetworkInterfaceOptions := &vpcv1.GetInstanceNetworkInterfaceOptions{}
 		parts, err := flex.SepIdParts(d.Id(), "/")
@@ -885,7 +885,7 @@ func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *s
 		getInstanceNetworkInterfaceOptions.SetInstanceID(parts[0])
 		getInstanceNetworkInterfaceOptions.SetID(parts[1])
 
-		networkInterface, response, err := vpcClient.GetInstanceNetworkInterface(getInstanceNetworkInterfaceOptions)
+		networkInterface, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(ctx, getInstanceNetworkInterfaceOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return networkInterface, isNetworkInterfaceDeleted, nil
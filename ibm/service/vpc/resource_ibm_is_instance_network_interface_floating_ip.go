@@ -11,9 +11,9 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -292,7 +292,7 @@ func instanceNetworkInterfaceFipDelete(context context.Context, d *schema.Resour
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceNetworkInterfaceFloatingIpDeleted(sess, instanceId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForInstanceNetworkInterfaceFloatingIpDeleted(context, sess, meta, instanceId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceNetworkInterfaceFloatingIpDeleted failed: %s", err.Error()), "ibm_is_instance_network_interface_floating_ip", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -302,29 +302,29 @@ func instanceNetworkInterfaceFipDelete(context context.Context, d *schema.Resour
 	return nil
 }
 
-func isWaitForInstanceNetworkInterfaceFloatingIpDeleted(instanceC *vpcv1.VpcV1, instanceId, nicId, fipId string, timeout time.Duration) (interface{}, error) {
+func isWaitForInstanceNetworkInterfaceFloatingIpDeleted(ctx context.Context, instanceC *vpcv1.VpcV1, meta interface{}, instanceId, nicId, fipId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for (%s) / (%s) / (%s) to be deleted.", instanceId, nicId, fipId)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceNetworkInterfaceFloatingIpAvailable, isInstanceNetworkInterfaceFloatingIpDeleting, isInstanceNetworkInterfaceFloatingIpPending},
-		Target:     []string{isInstanceNetworkInterfaceFloatingIpDeleted, isInstanceNetworkInterfaceFloatingIpFailed, ""},
-		Refresh:    isInstanceNetworkInterfaceFloatingIpDeleteRefreshFunc(instanceC, instanceId, nicId, fipId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s) network interface (%s) floating IP (%s)", instanceId, nicId, fipId),
+		Pending:  []string{isInstanceNetworkInterfaceFloatingIpAvailable, isInstanceNetworkInterfaceFloatingIpDeleting, isInstanceNetworkInterfaceFloatingIpPending},
+		Target:   []string{isInstanceNetworkInterfaceFloatingIpDeleted, isInstanceNetworkInterfaceFloatingIpFailed, ""},
+		Refresh:  isInstanceNetworkInterfaceFloatingIpDeleteRefreshFunc(instanceC, instanceId, nicId, fipId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isInstanceNetworkInterfaceFloatingIpDeleteRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, nicId, fipId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceNetworkInterfaceFloatingIpDeleteRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, nicId, fipId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getBmsNicFloatingIpOptions := &vpcv1.GetInstanceNetworkInterfaceFloatingIPOptions{
 			InstanceID:         &instanceId,
 			NetworkInterfaceID: &nicId,
 			ID:                 &fipId,
 		}
-		fip, response, err := instanceC.GetInstanceNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
+		fip, response, err := instanceC.GetInstanceNetworkInterfaceFloatingIPWithContext(ctx, getBmsNicFloatingIpOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -336,28 +336,28 @@ func isInstanceNetworkInterfaceFloatingIpDeleteRefreshFunc(instanceC *vpcv1.VpcV
 	}
 }
 
-func isWaitForInstanceNetworkInterfaceFloatingIpAvailable(client *vpcv1.VpcV1, instanceId, nicId, fipId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceNetworkInterfaceFloatingIpAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, instanceId, nicId, fipId string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Instance (%s) Network Interface (%s) to be available.", instanceId, nicId)
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceNetworkInterfaceFloatingIpPending},
-		Target:     []string{isInstanceNetworkInterfaceFloatingIpAvailable, isInstanceNetworkInterfaceFloatingIpFailed},
-		Refresh:    isInstanceNetworkInterfaceFloatingIpRefreshFunc(client, instanceId, nicId, fipId, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("instance (%s) network interface (%s) floating IP (%s)", instanceId, nicId, fipId),
+		Pending:  []string{isInstanceNetworkInterfaceFloatingIpPending},
+		Target:   []string{isInstanceNetworkInterfaceFloatingIpAvailable, isInstanceNetworkInterfaceFloatingIpFailed},
+		Refresh:  isInstanceNetworkInterfaceFloatingIpRefreshFunc(client, instanceId, nicId, fipId, d, communicator),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isInstanceNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1, instanceId, nicId, fipId string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isInstanceNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1, instanceId, nicId, fipId string, d *schema.ResourceData, communicator chan interface{}) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getBmsNicFloatingIpOptions := &vpcv1.GetInstanceNetworkInterfaceFloatingIPOptions{
 			InstanceID:         &instanceId,
 			NetworkInterfaceID: &nicId,
 			ID:                 &fipId,
 		}
-		fip, response, err := client.GetInstanceNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
+		fip, response, err := client.GetInstanceNetworkInterfaceFloatingIPWithContext(ctx, getBmsNicFloatingIpOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Instance (%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", instanceId, nicId, fipId, err, response)
 		}
@@ -75,8 +75,11 @@ const (
 	isInstanceTemplateSourceSnapshot = "source_snapshot"
 )
 
+// ibmIsInstanceTemplateIdentity is the identity of ibm_is_instance_template, which can be used to import it.
+var ibmIsInstanceTemplateIdentity = vpcResourceIdentity("The unique identifier of the instance template.")
+
 func ResourceIBMISInstanceTemplate() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMisInstanceTemplateCreate,
 		ReadContext:   resourceIBMisInstanceTemplateRead,
 		UpdateContext: resourceIBMisInstanceTemplateUpdate,
@@ -1416,7 +1419,7 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsInstanceTemplateIdentity)
 }
 
 func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
@@ -492,7 +492,7 @@ func instanceVolAttachmentCreate(context context.Context, d *schema.ResourceData
 		return tfErr.GetDiag()
 	}
 	d.SetId(makeTerraformVolAttID(instanceId, *instanceVolAtt.ID))
-	volAtt, err := isWaitForInstanceVolumeAttached(sess, d, instanceId, *instanceVolAtt.ID)
+	volAtt, err := isWaitForInstanceVolumeAttached(context, sess, meta, d, instanceId, *instanceVolAtt.ID)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeAttached failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -833,7 +833,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -863,7 +863,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(context, instanceC, meta, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -932,7 +932,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -982,7 +982,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, meta, instanceId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1009,7 +1009,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, meta, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1051,7 +1051,7 @@ func instanceVolAttDelete(context context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceId, id)
+	_, err = isWaitForInstanceVolumeDetached(context, instanceC, meta, d, instanceId, id)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1067,7 +1067,7 @@ func instanceVolAttDelete(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForVolumeDeleted(context, instanceC, meta, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -11,12 +11,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -54,8 +54,11 @@ const (
 	isLBAccessTags                    = "access_tags"
 )
 
+// ibmIsLBIdentity is the identity of ibm_is_lb, which can be used to import it.
+var ibmIsLBIdentity = vpcResourceIdentity("The unique identifier of the load balancer.")
+
 func ResourceIBMISLB() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBCreate,
 		ReadContext:   resourceIBMISLBRead,
 		UpdateContext: resourceIBMISLBUpdate,
@@ -353,7 +356,7 @@ func ResourceIBMISLB() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, ibmIsLBIdentity)
 }
 
 func ResourceIBMISLBValidator() *validate.ResourceValidator {
@@ -542,7 +545,7 @@ func lbCreate(context context.Context, d *schema.ResourceData, meta interface{},
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
-	_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -954,7 +957,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -993,7 +996,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1065,7 +1068,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1096,7 +1099,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1151,7 +1154,7 @@ func lbDelete(context context.Context, d *schema.ResourceData, meta interface{},
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBDeleted failed: %s", err.Error()), "ibm_is_lb", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1161,28 +1164,28 @@ func lbDelete(context context.Context, d *schema.ResourceData, meta interface{},
 	return nil
 }
 
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBDeleting},
-		Target:     []string{isLBDeleted, "failed"},
-		Refresh:    isLBDeleteRefreshFunc(lbc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s)", id),
+		Pending:  []string{"retry", isLBDeleting},
+		Target:   []string{isLBDeleted, "failed"},
+		Refresh:  isLBDeleteRefreshFunc(lbc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb delete function here")
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
-		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
+		lb, response, err := lbc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
@@ -1225,28 +1228,28 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	return true, nil
 }
 
-func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s)", lbId),
+		Pending:  []string{"retry", isLBProvisioning, "update_pending"},
+		Target:   []string{isLBProvisioningDone, ""},
+		Refresh:  isLBRefreshFunc(sess, lbId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
@@ -12,11 +12,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -42,8 +42,11 @@ const (
 	isLBListenerIdleConnectionTimeout   = "idle_connection_timeout"
 )
 
+// ibmIsLBListenerIdentity is the identity of ibm_is_lb_listener, which can be used to import it.
+var ibmIsLBListenerIdentity = vpcChildResourceIdentity("lb", "The unique identifier of the load balancer.", "The unique identifier of the listener.")
+
 func ResourceIBMISLBListener() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBListenerCreate,
 		ReadContext:   resourceIBMISLBListenerRead,
 		UpdateContext: resourceIBMISLBListenerUpdate,
@@ -247,7 +250,7 @@ func ResourceIBMISLBListener() *schema.Resource {
 				Description: "The crn of the LB resource",
 			},
 		},
-	}
+	}, ibmIsLBListenerIdentity)
 }
 
 func ResourceIBMISLBListenerValidator() *validate.ResourceValidator {
@@ -438,7 +441,7 @@ func lbListenerCreate(context context.Context, d *schema.ResourceData, meta inte
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -452,13 +455,13 @@ func lbListenerCreate(context context.Context, d *schema.ResourceData, meta inte
 		return tfErr.GetDiag()
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBListenerAvailable(context, sess, meta, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBListenerAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -469,29 +472,29 @@ func lbListenerCreate(context context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
-func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone, ""},
-		Refresh:    isLBListenerRefreshFunc(sess, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s) listener (%s)", lbID, lbListenerID),
+		Pending:  []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:   []string{isLBListenerProvisioningDone, ""},
+		Refresh:  isLBListenerRefreshFunc(sess, lbID, lbListenerID),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
 			LoadBalancerID: &lbID,
 			ID:             &lbListenerID,
 		}
-		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+		lblis, response, err := sess.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Listener: %s\n%s", err, response)
 		}
@@ -815,7 +818,7 @@ func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta inte
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -828,14 +831,14 @@ func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta inte
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBListenerAvailable(context, sess, meta, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBListenerAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -889,7 +892,7 @@ func lbListenerDelete(context context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -905,13 +908,13 @@ func lbListenerDelete(context context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBListenerDeleted(context, sess, meta, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBListenerDeleted failed: %s", err.Error()), "ibm_is_lb_listener", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -922,28 +925,28 @@ func lbListenerDelete(context context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
-func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerDeleted(ctx context.Context, lbc *vpcv1.VpcV1, meta interface{}, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerDeleting, "delete_pending"},
-		Target:     []string{isLBListenerDeleted, ""},
-		Refresh:    isLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s) listener (%s)", lbID, lbListenerID),
+		Pending:  []string{"retry", isLBListenerDeleting, "delete_pending"},
+		Target:   []string{isLBListenerDeleted, ""},
+		Refresh:  isLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
 			LoadBalancerID: &lbID,
 			ID:             &lbListenerID,
 		}
-		lbLis, response, err := lbc.GetLoadBalancerListener(getLoadBalancerListenerOptions)
+		lbLis, response, err := lbc.GetLoadBalancerListenerWithContext(ctx, getLoadBalancerListenerOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
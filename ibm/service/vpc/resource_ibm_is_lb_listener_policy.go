@@ -13,11 +13,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -611,7 +611,7 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 	conns.IbmMutexKV.Lock(isLBKey)
 	defer conns.IbmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -627,7 +627,7 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -636,28 +636,28 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 	return nil
 }
 
-func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbAvailable(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyPending},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLbRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s)", id),
+		Pending:  []string{isLBListenerPolicyPending},
+		Target:   []string{isLBProvisioningDone},
+		Refresh:  isLbRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getLbOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 
-		lb, _, err := vpc.GetLoadBalancer(getLbOptions)
+		lb, _, err := vpc.GetLoadBalancerWithContext(ctx, getLbOptions)
 		if err != nil {
 			return nil, "", err
 		}
@@ -670,22 +670,22 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyAvailable(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone},
-		Refresh:    isLbListenerPolicyRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer listener policy (%s)", id),
+		Pending:  []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:   []string{isLBListenerProvisioningDone},
+		Refresh:  isLbListenerPolicyRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
 		if err != nil {
@@ -702,7 +702,7 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 			ID:             &policyID,
 		}
 
-		policy, _, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+		policy, _, err := vpc.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 
 		if err != nil {
 			return policy, "", err
@@ -941,7 +941,7 @@ func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, met
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -954,7 +954,7 @@ func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, met
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1020,7 +1020,7 @@ func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, met
 		ID:             &ID,
 	}
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1033,7 +1033,7 @@ func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, met
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyDeleted(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListnerPolicyDeleted failed: %s", err.Error()), "ibm_is_lb_listener_policy", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1041,22 +1041,22 @@ func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, met
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyDeleted(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
-		Target:     []string{isLBListenerPolicyFailed, isLBListenerPolicyDeleted},
-		Refresh:    isLbListenerPolicyDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer listener policy (%s)", id),
+		Pending:  []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
+		Target:   []string{isLBListenerPolicyFailed, isLBListenerPolicyDeleted},
+		Refresh:  isLbListenerPolicyDeleteRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		//Retrieve lbId, listenerId and policyID
 		parts, err := flex.IdParts(id)
@@ -1075,7 +1075,7 @@ func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.S
 		}
 
 		//Getting lb listener policy
-		policy, response, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
+		policy, response, err := vpc.GetLoadBalancerListenerPolicyWithContext(ctx, getLbListenerPolicyOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -12,9 +12,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -271,7 +271,7 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 	conns.IbmMutexKV.Lock(isLBKey)
 	defer conns.IbmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLoadbalancerAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLoadbalancerAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -287,7 +287,7 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyRuleAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyRuleAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -297,28 +297,28 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadbalancerAvailable(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRulePending},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLoadbalancerRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s)", id),
+		Pending:  []string{isLBListenerPolicyRulePending},
+		Target:   []string{isLBProvisioningDone},
+		Refresh:  isLoadbalancerRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getLbOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 
-		lb, _, err := vpc.GetLoadBalancer(getLbOptions)
+		lb, _, err := vpc.GetLoadBalancerWithContext(ctx, getLbOptions)
 		if err != nil {
 			return nil, "", err
 		}
@@ -331,22 +331,22 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleAvailable(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerPolicyRuleProvisioningDone},
-		Refresh:    isLbListenerPolicyRuleRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer listener policy rule (%s)", id),
+		Pending:  []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:   []string{isLBListenerPolicyRuleProvisioningDone},
+		Refresh:  isLbListenerPolicyRuleRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		parts, err := flex.IdParts(id)
 		if err != nil {
@@ -365,7 +365,7 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 			ID:             &ruleID,
 		}
 
-		rule, _, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+		rule, _, err := vpc.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 
 		if err != nil {
 			return rule, "", err
@@ -520,7 +520,7 @@ func lbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData,
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLoadbalancerAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLoadbalancerAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -534,7 +534,7 @@ func lbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData,
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyRuleAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyRuleAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -612,7 +612,7 @@ func lbListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyRuleDeleted(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListnerPolicyRuleDeleted failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -620,22 +620,22 @@ func lbListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData,
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyRuleDeleted(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
-		Target:     []string{isLBListenerPolicyRuleDeleted, isLBListenerPolicyRuleFailed},
-		Refresh:    isLbListenerPolicyRuleDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer listener policy rule (%s)", id),
+		Pending:  []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
+		Target:   []string{isLBListenerPolicyRuleDeleted, isLBListenerPolicyRuleFailed},
+		Refresh:  isLbListenerPolicyRuleDeleteRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		//Retrieve lbId, listenerId and policyID
 		parts, err := flex.IdParts(id)
@@ -656,7 +656,7 @@ func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resour
 		}
 
 		//Getting lb listener policy
-		rule, response, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
+		rule, response, err := vpc.GetLoadBalancerListenerPolicyRuleWithContext(ctx, getLbListenerPolicyRuleOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
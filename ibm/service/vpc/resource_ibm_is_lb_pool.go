@@ -11,12 +11,12 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,8 +46,11 @@ const (
 	isLBPool                              = "pool_id"
 )
 
+// ibmIsLBPoolIdentity is the identity of ibm_is_lb_pool, which can be used to import it.
+var ibmIsLBPoolIdentity = vpcChildResourceIdentity("lb", "The unique identifier of the load balancer.", "The unique identifier of the pool.")
+
 func ResourceIBMISLBPool() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBPoolCreate,
 		ReadContext:   resourceIBMISLBPoolRead,
 		UpdateContext: resourceIBMISLBPoolUpdate,
@@ -245,7 +248,7 @@ func ResourceIBMISLBPool() *schema.Resource {
 				Description: "The crn of the LB resource",
 			},
 		},
-	}
+	}, ibmIsLBPoolIdentity)
 }
 
 func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
@@ -362,7 +365,7 @@ func lbPoolCreate(context context.Context, d *schema.ResourceData, meta interfac
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateLoadBalancerPoolWithContext failed: %s", err.Error()), "ibm_is_lb_pool", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -415,14 +418,14 @@ func lbPoolCreate(context context.Context, d *schema.ResourceData, meta interfac
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, meta, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -737,14 +740,14 @@ func lbPoolUpdate(context context.Context, d *schema.ResourceData, meta interfac
 		isLBKey := "load_balancer_key_" + lbID
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err := isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -776,14 +779,14 @@ func lbPoolUpdate(context context.Context, d *schema.ResourceData, meta interfac
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -836,14 +839,14 @@ func lbPoolDelete(context context.Context, d *schema.ResourceData, meta interfac
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -860,14 +863,14 @@ func lbPoolDelete(context context.Context, d *schema.ResourceData, meta interfac
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolDeleted(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolDeleted failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -920,29 +923,29 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return true, nil
 }
 
-func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolActive(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
-		Target:     []string{isLBPoolActive, ""},
-		Refresh:    isLBPoolRefreshFunc(sess, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s) pool (%s)", lbId, lbPoolId),
+		Pending:  []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
+		Target:   []string{isLBPoolActive, ""},
+		Refresh:  isLBPoolRefreshFunc(sess, lbId, lbPoolId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
 			LoadBalancerID: &lbId,
 			ID:             &lbPoolId,
 		}
-		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
+		lbPool, response, err := sess.GetLoadBalancerPoolWithContext(ctx, getlbpOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool: %s\n%s", err, response)
 		}
@@ -955,29 +958,29 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 	}
 }
 
-func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolDeleted(ctx context.Context, lbc *vpcv1.VpcV1, meta interface{}, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
-		Target:     []string{isLBPoolDeleteDone, ""},
-		Refresh:    isLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s) pool (%s)", lbId, lbPoolId),
+		Pending:  []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
+		Target:   []string{isLBPoolDeleteDone, ""},
+		Refresh:  isLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is lb pool delete function here")
 		getlbpOptions := &vpcv1.GetLoadBalancerPoolOptions{
 			LoadBalancerID: &lbId,
 			ID:             &lbPoolId,
 		}
-		lbPool, response, err := lbc.GetLoadBalancerPool(getlbpOptions)
+		lbPool, response, err := lbc.GetLoadBalancerPoolWithContext(ctx, getlbpOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
@@ -12,11 +12,11 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -189,14 +189,14 @@ func lbpMemberCreate(context context.Context, d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool_member", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -237,21 +237,21 @@ func lbpMemberCreate(context context.Context, d *schema.ResourceData, meta inter
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolMemberAvailable(context, sess, meta, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolMemberAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool_member", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -261,30 +261,30 @@ func lbpMemberCreate(context context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
-func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberAvailable(ctx context.Context, lbc *vpcv1.VpcV1, meta interface{}, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBPoolMemberActive, ""},
-		Refresh:    isLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s) pool (%s) member (%s)", lbID, lbPoolID, lbPoolMemID),
+		Pending:  []string{"create_pending", "update_pending", "maintenance_pending"},
+		Target:   []string{isLBPoolMemberActive, ""},
+		Refresh:  isLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
 			LoadBalancerID: &lbID,
 			PoolID:         &lbPoolID,
 			ID:             &lbPoolMemID,
 		}
-		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 		}
@@ -441,21 +441,21 @@ func lbpmemberUpdate(context context.Context, d *schema.ResourceData, meta inter
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool_member", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(context, sess, meta, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolMemberAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -501,21 +501,21 @@ func lbpmemberUpdate(context context.Context, d *schema.ResourceData, meta inter
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(context, sess, meta, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolMemberAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool_member", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -571,21 +571,21 @@ func lbpmemberDelete(context context.Context, d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberAvailable(context, sess, meta, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolMemberAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool_member", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -604,21 +604,21 @@ func lbpmemberDelete(context context.Context, d *schema.ResourceData, meta inter
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberDeleted(context, sess, meta, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolMemberDeleted failed: %s", err.Error()), "ibm_is_lb_pool_member", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool_member", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, meta, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool_member", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -629,30 +629,30 @@ func lbpmemberDelete(context context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
-func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberDeleted(ctx context.Context, lbc *vpcv1.VpcV1, meta interface{}, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolMemberDeletePending},
-		Target:     []string{isLBPoolMemberDeleted, ""},
-		Refresh:    isDeleteLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s) pool (%s) member (%s)", lbID, lbPoolID, lbPoolMemID),
+		Pending:  []string{isLBPoolMemberDeletePending},
+		Target:   []string{isLBPoolMemberDeleted, ""},
+		Refresh:  isDeleteLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
 			LoadBalancerID: &lbID,
 			PoolID:         &lbPoolID,
 			ID:             &lbPoolMemID,
 		}
-		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMemberWithContext(ctx, getlbpmoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
@@ -11,10 +11,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -36,8 +36,11 @@ const (
 	isPlacementGroupAccessTags = "access_tags"
 )
 
+// ibmIsPlacementGroupIdentity is the identity of ibm_is_placement_group, which can be used to import it.
+var ibmIsPlacementGroupIdentity = vpcResourceIdentity("The unique identifier of the placement group.")
+
 func ResourceIbmIsPlacementGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmIsPlacementGroupCreate,
 		ReadContext:   resourceIbmIsPlacementGroupRead,
 		UpdateContext: resourceIbmIsPlacementGroupUpdate,
@@ -117,7 +120,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				Description: "The resource type.",
 			},
 		},
-	}
+	}, ibmIsPlacementGroupIdentity)
 }
 
 func ResourceIbmIsPlacementGroupValidator() *validate.ResourceValidator {
@@ -193,7 +196,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 
 	d.SetId(*placementGroup.ID)
 
-	_, err = isWaitForPlacementGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForPlacementGroupAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPlacementGroupAvailable failed: %s", err.Error()), "ibm_is_placement_group", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -373,7 +376,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	response, err := vpcClient.DeletePlacementGroupWithContext(context, deletePlacementGroupOptions)
 	if err != nil {
 		if response.StatusCode == 409 {
-			_, err = isWaitForPlacementGroupDeleteRetry(vpcClient, d, d.Id())
+			_, err = isWaitForPlacementGroupDeleteRetry(context, vpcClient, meta, d, d.Id())
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPlacementGroupDeleteRetry failed: %s", err.Error()), "ibm_is_placement_group", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -385,7 +388,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
+	_, err = isWaitForPlacementGroupDelete(context, vpcClient, meta, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPlacementGroupDelete failed: %s", err.Error()), "ibm_is_placement_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -396,17 +399,18 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	return nil
 }
 
-func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isPlacementGroupDeleting, isPlacementGroupStable, isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
-		Target:  []string{isPlacementGroupDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("placement group (%s)", id),
+		Pending:  []string{isPlacementGroupDeleting, isPlacementGroupStable, isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
+		Target:   []string{isPlacementGroupDeleteDone, ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getPlacementGroupOptions := &vpcv1.GetPlacementGroupOptions{
 				ID: &id,
 			}
 
-			placementGroup, response, err := vpcClient.GetPlacementGroup(getPlacementGroupOptions)
+			placementGroup, response, err := vpcClient.GetPlacementGroupWithContext(ctx, getPlacementGroupOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return placementGroup, isPlacementGroupDeleteDone, nil
@@ -420,25 +424,25 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 			}
 			return placementGroup, isPlacementGroupDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isPlacementGroupResourcesAttached},
-		Target:  []string{isPlacementGroupDeleting, isPlacementGroupDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("placement group (%s)", id),
+		Pending:  []string{isPlacementGroupResourcesAttached},
+		Target:   []string{isPlacementGroupDeleting, isPlacementGroupDeleteDone, ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			deletePlacementGroupOptions := &vpcv1.DeletePlacementGroupOptions{}
 
 			deletePlacementGroupOptions.SetID(id)
 
-			response, err := vpcClient.DeletePlacementGroup(deletePlacementGroupOptions)
+			response, err := vpcClient.DeletePlacementGroupWithContext(ctx, deletePlacementGroupOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, isPlacementGroupResourcesAttached, err
@@ -449,35 +453,34 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 			}
 			return response, isPlacementGroupDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForPlacementGroupAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for placement group (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
-		Target:     []string{isPlacementGroupFailed, isPlacementGroupStable, isPlacementGroupSuspended, ""},
-		Refresh:    isPlacementGroupRefreshFunc(vpcClient, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("placement group (%s)", id),
+		Pending:  []string{isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
+		Target:   []string{isPlacementGroupFailed, isPlacementGroupStable, isPlacementGroupSuspended, ""},
+		Refresh:  isPlacementGroupRefreshFunc(vpcClient, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getinsOptions := &vpcv1.GetPlacementGroupOptions{
 			ID: &id,
 		}
-		placementGroup, response, err := vpcClient.GetPlacementGroup(getinsOptions)
+		placementGroup, response, err := vpcClient.GetPlacementGroupWithContext(ctx, getinsOptions)
 		if placementGroup == nil || err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting placementGroup : %s\n%s", err, response)
 		}
@@ -10,12 +10,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -247,7 +248,7 @@ func resourceIBMIsPrivatePathServiceGatewayCreate(context context.Context, d *sc
 	}
 
 	d.SetId(*privatePathServiceGateway.ID)
-	_, err = isWaitForPPSGAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitForPPSGAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPPSGAvailable failed: %s", err.Error()), "ibm_is_private_path_service_gateway", "create").GetDiag()
 
@@ -412,7 +413,7 @@ func resourceIBMIsPrivatePathServiceGatewayDelete(context context.Context, d *sc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForPPSGDeleted(vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPPSGDeleted(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPPSGDeleted failed: %s", err.Error()), "ibm_is_private_path_service_gateway", "delete").GetDiag()
 
@@ -422,16 +423,17 @@ func resourceIBMIsPrivatePathServiceGatewayDelete(context context.Context, d *sc
 	return nil
 }
 
-func isWaitForPPSGDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPPSGDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying PPSG (%s) delete", id)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"ppsg_in_use"},
-		Target:  []string{"deleting", "done", ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("private path service gateway (%s)", id),
+		Pending:  []string{"ppsg_in_use"},
+		Target:   []string{"deleting", "done", ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			deletePrivatePathServiceGatewayOptions := &vpcv1.DeletePrivatePathServiceGatewayOptions{}
 			deletePrivatePathServiceGatewayOptions.SetID(id)
 			log.Printf("[DEBUG] Retrying PPSG (%s) delete", id)
-			response, err := vpcClient.DeletePrivatePathServiceGateway(deletePrivatePathServiceGatewayOptions)
+			response, err := vpcClient.DeletePrivatePathServiceGatewayWithContext(ctx, deletePrivatePathServiceGatewayOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, "ppsg_in_use", nil
@@ -442,34 +444,33 @@ func isWaitForPPSGDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Du
 			}
 			return response, "deleting", nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
-func isWaitForPPSGDeleted(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPPSGDeleted(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for ppsg (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", "deleting", "stable"},
-		Target:     []string{"deleted", ""},
-		Refresh:    isPPSGDeleteRefreshFunc(vpcClient, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("private path service gateway (%s)", id),
+		Pending:  []string{"retry", "deleting", "stable"},
+		Target:   []string{"deleted", ""},
+		Refresh:  isPPSGDeleteRefreshFunc(vpcClient, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPPSGDeleteRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPPSGDeleteRefreshFunc(vpcClient *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is ppsg delete function here")
 		getPPSGOptions := &vpcv1.GetPrivatePathServiceGatewayOptions{
 			ID: &id,
 		}
-		ppsg, response, err := vpcClient.GetPrivatePathServiceGateway(getPPSGOptions)
+		ppsg, response, err := vpcClient.GetPrivatePathServiceGatewayWithContext(ctx, getPPSGOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return ppsg, "deleted", nil
@@ -479,31 +480,23 @@ func isPPSGDeleteRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRe
 		return ppsg, "deleting", err
 	}
 }
-func isWaitForPPSGAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPPSGAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for ppsg (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending", "updating"},
-		Target:     []string{"stable", "failed", "suspended"},
-		Refresh:    isPPSGRefreshFunc(vpcClient, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("private path service gateway (%s)", id),
+		Pending:  []string{"pending", "updating"},
+		Target:   []string{"stable", "failed", "suspended"},
+		Refresh:  isPPSGRefreshFunc(vpcClient, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPPSGRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		getPPSGOptions := &vpcv1.GetPrivatePathServiceGatewayOptions{
-			ID: &id,
-		}
-		ppsg, response, err := vpcClient.GetPrivatePathServiceGateway(getPPSGOptions)
-		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting ppsg : %s\n%s", err, response)
-		}
-
-		return ppsg, *ppsg.LifecycleState, nil
-	}
+func isPPSGRefreshFunc(vpcClient *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return waiter.LifecycleStateRefresh(func(ctx context.Context) (*vpcv1.PrivatePathServiceGateway, *core.DetailedResponse, error) {
+		return vpcClient.GetPrivatePathServiceGatewayWithContext(ctx, &vpcv1.GetPrivatePathServiceGatewayOptions{ID: &id})
+	})
 }
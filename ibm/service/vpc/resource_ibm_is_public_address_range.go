@@ -13,10 +13,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -314,7 +314,7 @@ func resourceIBMPublicAddressRangeCreate(context context.Context, d *schema.Reso
 	d.SetId(*publicAddressRange.ID)
 	log.Printf("[INFO] PublicAddressRange : %s", *publicAddressRange.ID)
 
-	_, err = isWaitForPublicAddressRangeAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicAddressRangeAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPublicAddressRangeAvailable failed: %s", err.Error()), "ibm_public_address_range", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -343,12 +343,12 @@ func resourceIBMPublicAddressRangeCreate(context context.Context, d *schema.Reso
 	return resourceIBMPublicAddressRangeRead(context, d, meta)
 }
 
-func isPublicAddressRangeRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPublicAddressRangeRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getPublicAddressRangeOptions := &vpcv1.GetPublicAddressRangeOptions{
 			ID: &id,
 		}
-		PublicAddressRange, response, err := sess.GetPublicAddressRange(getPublicAddressRangeOptions)
+		PublicAddressRange, response, err := sess.GetPublicAddressRangeWithContext(ctx, getPublicAddressRangeOptions)
 		if err != nil {
 			return nil, isPublicAddressRangeFailed, fmt.Errorf("[ERROR] Error getting PublicAddressRange : %s\n%s", err, response)
 		}
@@ -363,19 +363,19 @@ func isPublicAddressRangeRefreshFunc(sess *vpcv1.VpcV1, id string) resource.Stat
 	}
 }
 
-func isWaitForPublicAddressRangeAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicAddressRangeAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public address range (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isPublicAddressRangePending},
-		Target:     []string{isPublicAddressRangeAvailable, isPublicAddressRangeFailed},
-		Refresh:    isPublicAddressRangeRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("public address range (%s)", id),
+		Pending:  []string{isPublicAddressRangePending},
+		Target:   []string{isPublicAddressRangeAvailable, isPublicAddressRangeFailed},
+		Refresh:  isPublicAddressRangeRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceIBMPublicAddressRangeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -536,7 +536,7 @@ func resourceIBMPublicAddressRangeUpdate(context context.Context, d *schema.Reso
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForPublicAddressRangeUpdate(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForPublicAddressRangeUpdate(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdatePublicAddressRangeWithContext failed: %s", err.Error()), "ibm_public_address_range", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -546,26 +546,26 @@ func resourceIBMPublicAddressRangeUpdate(context context.Context, d *schema.Reso
 	return resourceIBMPublicAddressRangeRead(context, d, meta)
 }
 
-func isWaitForPublicAddressRangeUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicAddressRangeUpdate(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PublicAddressRange (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isPublicAddressRangeUpdating},
-		Target:     []string{isPublicAddressRangeAvailable, isPublicAddressRangeFailed},
-		Refresh:    isPublicAddressRangeUpdateRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("public address range (%s)", id),
+		Pending:  []string{isPublicAddressRangeUpdating},
+		Target:   []string{isPublicAddressRangeAvailable, isPublicAddressRangeFailed},
+		Refresh:  isPublicAddressRangeUpdateRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPublicAddressRangeUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPublicAddressRangeUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getPublicAddressRangeOptions := &vpcv1.GetPublicAddressRangeOptions{
 			ID: &id,
 		}
-		publicAddressRange, response, err := sess.GetPublicAddressRange(getPublicAddressRangeOptions)
+		publicAddressRange, response, err := sess.GetPublicAddressRangeWithContext(ctx, getPublicAddressRangeOptions)
 		if err != nil {
 			return nil, isPublicAddressRangeFailed, fmt.Errorf("[ERROR] Error getting PublicAddressRange : %s\n%s", err, response)
 		}
@@ -598,7 +598,7 @@ func resourceIBMPublicAddressRangeDelete(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForPublicAddressRangeDeleted(vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicAddressRangeDeleted(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeletePublicAddressRangeWithContext failed: %s", err.Error()), "ibm_public_address_range", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -610,28 +610,28 @@ func resourceIBMPublicAddressRangeDelete(context context.Context, d *schema.Reso
 	return nil
 }
 
-func isWaitForPublicAddressRangeDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicAddressRangeDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PublicAddressRange (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isPublicAddressRangeDeleting},
-		Target:     []string{isPublicAddressRangeDeleted, isPublicAddressRangeFailed},
-		Refresh:    isPublicAddressRangeDeleteRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("public address range (%s)", id),
+		Pending:  []string{isPublicAddressRangeDeleting},
+		Target:   []string{isPublicAddressRangeDeleted, isPublicAddressRangeFailed},
+		Refresh:  isPublicAddressRangeDeleteRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPublicAddressRangeDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPublicAddressRangeDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] Refresh function for PublicAddressRange delete.")
 		getPublicAddressRangeOptions := &vpcv1.GetPublicAddressRangeOptions{
 			ID: &id,
 		}
-		publicAddressRange, response, err := sess.GetPublicAddressRange(getPublicAddressRangeOptions)
+		publicAddressRange, response, err := sess.GetPublicAddressRangeWithContext(ctx, getPublicAddressRangeOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return publicAddressRange, isPublicAddressRangeDeleted, nil
@@ -12,12 +12,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,8 +39,11 @@ const (
 	isPublicGatewayResourceGroup    = "resource_group"
 )
 
+// ibmIsPublicGatewayIdentity is the identity of ibm_is_public_gateway, which can be used to import it.
+var ibmIsPublicGatewayIdentity = vpcResourceIdentity("The unique identifier of the public gateway.")
+
 func ResourceIBMISPublicGateway() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISPublicGatewayCreate,
 		ReadContext:   resourceIBMISPublicGatewayRead,
 		UpdateContext: resourceIBMISPublicGatewayUpdate,
@@ -162,7 +165,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, ibmIsPublicGatewayIdentity)
 }
 
 func ResourceIBMISPublicGatewayValidator() *validate.ResourceValidator {
@@ -254,7 +257,7 @@ func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.Resourc
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicGatewayAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for PublicGateway available failed: %s", err.Error()), "ibm_is_public_gateway", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -283,27 +286,27 @@ func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.Resourc
 	return resourceIBMISPublicGatewayRead(context, d, meta)
 }
 
-func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayAvailable(ctx context.Context, publicgwC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayProvisioning},
-		Target:     []string{isPublicGatewayProvisioningDone, ""},
-		Refresh:    isPublicGatewayRefreshFunc(publicgwC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("public gateway (%s)", id),
+		Pending:  []string{"retry", isPublicGatewayProvisioning},
+		Target:   []string{isPublicGatewayProvisioningDone, ""},
+		Refresh:  isPublicGatewayRefreshFunc(publicgwC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
+		publicgw, response, err := publicgwC.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
 		}
@@ -537,7 +540,7 @@ func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.Resourc
 					}
 					res, errSub := sess.UnsetSubnetPublicGatewayWithContext(context, unsetSubnetPublicGatewayOptions)
 					if res.StatusCode == 204 {
-						_, err = isWaitForSubnetPublicGatewayUnset(sess, *s.ID, d.Timeout(schema.TimeoutDelete))
+						_, err = isWaitForSubnetPublicGatewayUnset(context, sess, meta, *s.ID, d.Timeout(schema.TimeoutDelete))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UnsetSubnetPublicGatewayWithContext failed: %s", err.Error()), "ibm_is_public_gateway", "delete")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -562,7 +565,7 @@ func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.Resourc
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicGatewayDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for PublicGateway deleted failed: %s", err.Error()), "ibm_is_public_gateway", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -572,28 +575,28 @@ func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
-func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayDeleted(ctx context.Context, pg *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayDeleting},
-		Target:     []string{isPublicGatewayDeleted, ""},
-		Refresh:    isPublicGatewayDeleteRefreshFunc(pg, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("public gateway (%s)", id),
+		Pending:  []string{"retry", isPublicGatewayDeleting},
+		Target:   []string{isPublicGatewayDeleted, ""},
+		Refresh:  isPublicGatewayDeleteRefreshFunc(pg, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is pubic gateway delete function here")
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
-		pgw, response, err := pg.GetPublicGateway(getPublicGatewayOptions)
+		pgw, response, err := pg.GetPublicGatewayWithContext(ctx, getPublicGatewayOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
@@ -623,28 +626,28 @@ func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func isWaitForSubnetPublicGatewayUnset(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetPublicGatewayUnset(ctx context.Context, subnetC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be unset.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", "wait"},
-		Target:     []string{"done", ""},
-		Refresh:    isSubnetPublicGatewayUnsetRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s) public gateway", id),
+		Pending:  []string{"retry", "wait"},
+		Target:   []string{"done", ""},
+		Refresh:  isSubnetPublicGatewayUnsetRefreshFunc(subnetC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSubnetPublicGatewayUnsetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isSubnetPublicGatewayUnsetRefreshFunc(subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
 	log.Printf("Waiting for public gateway (%s) to be unset.", id)
-	return func() (interface{}, string, error) {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSubnetPublicGatewayOptions := &vpcv1.GetSubnetPublicGatewayOptions{
 			ID: &id,
 		}
-		subnetPublicGateway, response, err := subnetC.GetSubnetPublicGateway(getSubnetPublicGatewayOptions)
+		subnetPublicGateway, response, err := subnetC.GetSubnetPublicGatewayWithContext(ctx, getSubnetPublicGatewayOptions)
 		if err != nil {
 			if response.StatusCode == 404 {
 				return subnetPublicGateway, "done", nil
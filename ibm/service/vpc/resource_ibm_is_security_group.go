@@ -12,12 +12,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,9 +31,12 @@ const (
 	isSecurityGroupCRN           = "crn"
 )
 
+// ibmIsSecurityGroupIdentity is the identity of ibm_is_security_group, which can be used to import it.
+var ibmIsSecurityGroupIdentity = vpcResourceIdentity("The unique identifier of the security group.")
+
 func ResourceIBMISSecurityGroup() *schema.Resource {
 
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSecurityGroupCreate,
 		ReadContext:   resourceIBMISSecurityGroupRead,
 		UpdateContext: resourceIBMISSecurityGroupUpdate,
@@ -139,7 +142,7 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, ibmIsSecurityGroupIdentity)
 }
 
 func ResourceIBMISSecurityGroupValidator() *validate.ResourceValidator {
@@ -676,13 +679,13 @@ func resourceIBMISSecurityGroupDelete(context context.Context, d *schema.Resourc
 							log.Printf("[DEBUG] Security group target(%s) binding is already deleted", *securityGroupTargetReference.ID)
 						} else if response.StatusCode == 409 {
 							log.Printf("[DEBUG] Security group target(%s) binding is in deleting status, waiting till target is removed", *securityGroupTargetReference.ID)
-							_, err = isWaitForTargetDeleted(sess, id, *securityGroupTargetReference.ID, securityGroupTargetReferenceIntf, d.Timeout(schema.TimeoutDelete))
+							_, err = isWaitForTargetDeleted(context, sess, meta, id, *securityGroupTargetReference.ID, securityGroupTargetReferenceIntf, d.Timeout(schema.TimeoutDelete))
 							if err != nil {
 								tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForTargetDeleted failed: %s", err.Error()), "ibm_is_security_group", "delete")
 								log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 								return tfErr.GetDiag()
 							}
-							_, err := isWaitForSecurityGroupTargetDeleteRetry(sess, deleteSecurityGroupTargetBindingOptions, d.Timeout(schema.TimeoutDelete))
+							_, err := isWaitForSecurityGroupTargetDeleteRetry(context, sess, meta, deleteSecurityGroupTargetBindingOptions, d.Timeout(schema.TimeoutDelete))
 							if err != nil {
 								tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSecurityGroupTargetDeleteRetry failed: %s", err.Error()), "ibm_is_security_group", "delete")
 								log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -711,13 +714,13 @@ func resourceIBMISSecurityGroupDelete(context context.Context, d *schema.Resourc
 				log.Printf("[DEBUG] Security group(%s) target bindings are already deleted", id)
 			} else if response.StatusCode == 409 {
 				log.Printf("[DEBUG] Security group(%s) has target bindings is in deleting, will wait till target is removed", id)
-				_, err = isWaitForSgCleanup(sess, id, allrecs, d.Timeout(schema.TimeoutDelete))
+				_, err = isWaitForSgCleanup(context, sess, meta, id, allrecs, d.Timeout(schema.TimeoutDelete))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSgCleanup failed: %s", err.Error()), "ibm_is_security_group", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err := isWaitForSecurityGroupDeleteRetry(sess, deleteSecurityGroupOptions, d.Timeout(schema.TimeoutDelete))
+				_, err := isWaitForSecurityGroupDeleteRetry(context, sess, meta, deleteSecurityGroupOptions, d.Timeout(schema.TimeoutDelete))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSecurityGroupDeleteRetry failed: %s", err.Error()), "ibm_is_security_group", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -818,28 +821,28 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	}
 }
 
-func isWaitForTargetDeleted(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
+func isWaitForTargetDeleted(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targetId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{"done", ""},
-		Refresh:    isTargetRefreshFunc(client, sgId, targetId, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("security group (%s) target (%s)", sgId, targetId),
+		Pending:  []string{"deleting"},
+		Target:   []string{"done", ""},
+		Refresh:  isTargetRefreshFunc(client, sgId, targetId, target),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		targetgetoptions := &vpcv1.GetSecurityGroupTargetOptions{
 			SecurityGroupID: &sgId,
 			ID:              &targetId,
 		}
-		sgTarget, response, err := client.GetSecurityGroupTarget(targetgetoptions)
+		sgTarget, response, err := client.GetSecurityGroupTargetWithContext(ctx, targetgetoptions)
 		if err != nil {
 			return target, "", fmt.Errorf("[ERROR] Error getting target(%s): %s\n%s", targetId, err, response)
 		}
@@ -849,29 +852,29 @@ func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv
 		return sgTarget, "deleting", nil
 	}
 }
-func isWaitForSgCleanup(client *vpcv1.VpcV1, sgId string, targets []vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
+func isWaitForSgCleanup(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, sgId string, targets []vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targets)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{"done", ""},
-		Refresh:    isSgRefreshFunc(client, sgId, targets),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("security group (%s) targets", sgId),
+		Pending:  []string{"deleting"},
+		Target:   []string{"done", ""},
+		Refresh:  isSgRefreshFunc(client, sgId, targets),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGroupTargetReferenceIntf) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGroupTargetReferenceIntf) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		start := ""
 		allrecs := []vpcv1.SecurityGroupTargetReferenceIntf{}
 		for {
 			listSecurityGroupTargetsOptions := client.NewListSecurityGroupTargetsOptions(sgId)
 
-			sggroups, response, err := client.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
+			sggroups, response, err := client.ListSecurityGroupTargetsWithContext(ctx, listSecurityGroupTargetsOptions)
 			if err != nil || sggroups == nil {
 				return groups, "", fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
 			}
@@ -890,14 +893,15 @@ func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGr
 	}
 }
 
-func isWaitForSecurityGroupDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecurityGroupOptions *vpcv1.DeleteSecurityGroupOptions, timeout time.Duration) (interface{}, error) {
+func isWaitForSecurityGroupDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, deleteSecurityGroupOptions *vpcv1.DeleteSecurityGroupOptions, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying security group (%s) delete", *deleteSecurityGroupOptions.ID)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"security-group-in-use"},
-		Target:  []string{"deleted", ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("security group (%s)", *deleteSecurityGroupOptions.ID),
+		Pending:  []string{"security-group-in-use"},
+		Target:   []string{"deleted", ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			log.Printf("[DEBUG] Retrying security group (%s) delete", *deleteSecurityGroupOptions.ID)
-			response, err := vpcClient.DeleteSecurityGroup(deleteSecurityGroupOptions)
+			response, err := vpcClient.DeleteSecurityGroupWithContext(ctx, deleteSecurityGroupOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, "security-group-in-use", nil
@@ -908,21 +912,21 @@ func isWaitForSecurityGroupDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecurityGro
 			}
 			return response, "deleted", nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForSecurityGroupTargetDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecurityGroupTargetBindingOptions *vpcv1.DeleteSecurityGroupTargetBindingOptions, timeout time.Duration) (interface{}, error) {
+func isWaitForSecurityGroupTargetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, deleteSecurityGroupTargetBindingOptions *vpcv1.DeleteSecurityGroupTargetBindingOptions, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying security group target (%s) delete", *deleteSecurityGroupTargetBindingOptions.ID)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"security-group-target-in-use"},
-		Target:  []string{"deleted", ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("security group (%s) target (%s)", *deleteSecurityGroupTargetBindingOptions.SecurityGroupID, *deleteSecurityGroupTargetBindingOptions.ID),
+		Pending:  []string{"security-group-target-in-use"},
+		Target:   []string{"deleted", ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			log.Printf("[DEBUG] Retrying security group target(%s) delete", *deleteSecurityGroupTargetBindingOptions.ID)
-			response, err := vpcClient.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
+			response, err := vpcClient.DeleteSecurityGroupTargetBindingWithContext(ctx, deleteSecurityGroupTargetBindingOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, "security-group-target-in-use", nil
@@ -933,9 +937,8 @@ func isWaitForSecurityGroupTargetDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecur
 			}
 			return response, "deleted", nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
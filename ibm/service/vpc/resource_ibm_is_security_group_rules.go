@@ -0,0 +1,698 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSecurityGroupRulesSecurityGroup = "security_group"
+	isSecurityGroupRulesRules         = "rules"
+	isSecurityGroupRulesAnyCIDR       = "0.0.0.0/0"
+	isSecurityGroupRulesPortMinimum   = 1
+	isSecurityGroupRulesPortMaximum   = 65535
+)
+
+// ibmIsSecurityGroupRulesIdentity is the identity of ibm_is_security_group_rules, which can be used to import it.
+var ibmIsSecurityGroupRulesIdentity = vpcResourceIdentity("The unique identifier of the security group.")
+
+func ResourceIBMISSecurityGroupRules() *schema.Resource {
+	return flex.WithResourceIdentity(&schema.Resource{
+		CreateContext: resourceIBMISSecurityGroupRulesCreate,
+		ReadContext:   resourceIBMISSecurityGroupRulesRead,
+		UpdateContext: resourceIBMISSecurityGroupRulesUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRulesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupRulesSecurityGroup: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the security group. All of the rules of the security group are managed by this resource.",
+			},
+			isSecurityGroupRulesRules: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISSecurityGroupRulesHash,
+				Description: "The complete set of rules of the security group. Rules that are not in the set are removed from the security group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSecurityGroupRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the security group rule.",
+						},
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+							Description:  "The direction of traffic to enforce, either inbound or outbound.",
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+							Description:  "The IP version: ipv4.",
+						},
+						isSecurityGroupRuleName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name for this security group rule. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+						},
+						isSecurityGroupRuleProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleProtocol),
+							Description:  "The name of the network protocol. Defaults to icmp_tcp_udp.",
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The remote IP address, CIDR block, or security group identifier. Defaults to 0.0.0.0/0.",
+						},
+						isSecurityGroupRuleLocal: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The local IP address or CIDR block. Defaults to 0.0.0.0/0.",
+						},
+						isSecurityGroupRuleType: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+							Description:  "The ICMP traffic type to allow. Only valid for the icmp protocol.",
+						},
+						isSecurityGroupRuleCode: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+							Description:  "The ICMP traffic code to allow. Only valid for the icmp protocol.",
+						},
+						isSecurityGroupRulePortMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+							Description:  "The inclusive lower bound of the destination port range. Only valid for the tcp and udp protocols.",
+						},
+						isSecurityGroupRulePortMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+							Description:  "The inclusive upper bound of the destination port range. Only valid for the tcp and udp protocols.",
+						},
+					},
+				},
+			},
+		},
+	}, ibmIsSecurityGroupRulesIdentity)
+}
+
+// securityGroupRule is a security group rule with its defaults applied, so
+// that a rule in the configuration can be compared with a rule of the API.
+type securityGroupRule struct {
+	ID        string
+	Name      string
+	Direction string
+	IPVersion string
+	Protocol  string
+	Remote    string
+	Local     string
+	Type      int64
+	Code      int64
+	PortMin   int64
+	PortMax   int64
+}
+
+// securityGroupRuleUpdate is an existing rule that is patched to a rule of
+// the configuration.
+type securityGroupRuleUpdate struct {
+	ID   string
+	Rule securityGroupRule
+}
+
+// securityGroupRulesPlan holds the API calls that make the rules of a
+// security group match the configuration. DeletesFirst are the deleted rules
+// whose names are taken by a created or patched rule, so they must be deleted
+// before the other calls.
+type securityGroupRulesPlan struct {
+	Creates      []securityGroupRule
+	Updates      []securityGroupRuleUpdate
+	DeletesFirst []string
+	Deletes      []string
+}
+
+func (p securityGroupRulesPlan) isEmpty() bool {
+	return len(p.Creates) == 0 && len(p.Updates) == 0 && len(p.DeletesFirst) == 0 && len(p.Deletes) == 0
+}
+
+func (r securityGroupRule) normalize() securityGroupRule {
+	if r.IPVersion == "" {
+		r.IPVersion = isSecurityGroupRuleIPVersionDefault
+	}
+	if r.Protocol == "" {
+		r.Protocol = "icmp_tcp_udp"
+	}
+	if r.Remote == "" {
+		r.Remote = isSecurityGroupRulesAnyCIDR
+	}
+	if r.Local == "" {
+		r.Local = isSecurityGroupRulesAnyCIDR
+	}
+	if r.Protocol != isSecurityGroupRuleProtocolICMP {
+		r.Type, r.Code = 0, 0
+	}
+	if r.Protocol == isSecurityGroupRuleProtocolTCP || r.Protocol == isSecurityGroupRuleProtocolUDP {
+		if r.PortMin == 0 && r.PortMax == 0 {
+			r.PortMin, r.PortMax = isSecurityGroupRulesPortMinimum, isSecurityGroupRulesPortMaximum
+		}
+	} else {
+		r.PortMin, r.PortMax = 0, 0
+	}
+	return r
+}
+
+// key identifies the traffic that a rule allows. Two rules with the same key
+// are the same rule, whatever their names.
+func (r securityGroupRule) key() string {
+	r = r.normalize()
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%d|%d|%d|%d", r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local, r.Type, r.Code, r.PortMin, r.PortMax)
+}
+
+func securityGroupRuleFromMap(m map[string]interface{}) securityGroupRule {
+	rule := securityGroupRule{}
+	rule.ID, _ = m[isSecurityGroupRuleID].(string)
+	rule.Name, _ = m[isSecurityGroupRuleName].(string)
+	rule.Direction, _ = m[isSecurityGroupRuleDirection].(string)
+	rule.IPVersion, _ = m[isSecurityGroupRuleIPVersion].(string)
+	rule.Protocol, _ = m[isSecurityGroupRuleProtocol].(string)
+	rule.Remote, _ = m[isSecurityGroupRuleRemote].(string)
+	rule.Local, _ = m[isSecurityGroupRuleLocal].(string)
+	if v, ok := m[isSecurityGroupRuleType].(int); ok {
+		rule.Type = int64(v)
+	}
+	if v, ok := m[isSecurityGroupRuleCode].(int); ok {
+		rule.Code = int64(v)
+	}
+	if v, ok := m[isSecurityGroupRulePortMin].(int); ok {
+		rule.PortMin = int64(v)
+	}
+	if v, ok := m[isSecurityGroupRulePortMax].(int); ok {
+		rule.PortMax = int64(v)
+	}
+	return rule
+}
+
+func (r securityGroupRule) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		isSecurityGroupRuleID:        r.ID,
+		isSecurityGroupRuleName:      r.Name,
+		isSecurityGroupRuleDirection: r.Direction,
+		isSecurityGroupRuleIPVersion: r.IPVersion,
+		isSecurityGroupRuleProtocol:  r.Protocol,
+		isSecurityGroupRuleRemote:    r.Remote,
+		isSecurityGroupRuleLocal:     r.Local,
+		isSecurityGroupRuleType:      int(r.Type),
+		isSecurityGroupRuleCode:      int(r.Code),
+		isSecurityGroupRulePortMin:   int(r.PortMin),
+		isSecurityGroupRulePortMax:   int(r.PortMax),
+	}
+}
+
+// resourceIBMISSecurityGroupRulesHash hashes a rule by the traffic that it
+// allows, so that the computed attributes of a rule and the defaults that
+// the API fills in do not show up as changes.
+func resourceIBMISSecurityGroupRulesHash(v interface{}) int {
+	return schema.HashString(securityGroupRuleFromMap(v.(map[string]interface{})).key())
+}
+
+func securityGroupRuleFromAPI(rule vpcv1.SecurityGroupRuleIntf) securityGroupRule {
+	var id, name, direction, ipVersion, protocol *string
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	var local vpcv1.SecurityGroupRuleLocalIntf
+	var icmpType, icmpCode, portMin, portMax *int64
+
+	switch r := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id, name, direction, ipVersion, protocol, remote, local = r.ID, r.Name, r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local
+		icmpType, icmpCode = r.Type, r.Code
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id, name, direction, ipVersion, protocol, remote, local = r.ID, r.Name, r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local
+		portMin, portMax = r.PortMin, r.PortMax
+	case *vpcv1.SecurityGroupRuleProtocolAny:
+		id, name, direction, ipVersion, protocol, remote, local = r.ID, r.Name, r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local
+	case *vpcv1.SecurityGroupRuleProtocolIcmptcpudp:
+		id, name, direction, ipVersion, protocol, remote, local = r.ID, r.Name, r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local
+	case *vpcv1.SecurityGroupRuleProtocolIndividual:
+		id, name, direction, ipVersion, protocol, remote, local = r.ID, r.Name, r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local
+	case *vpcv1.SecurityGroupRule:
+		id, name, direction, ipVersion, protocol, remote, local = r.ID, r.Name, r.Direction, r.IPVersion, r.Protocol, r.Remote, r.Local
+		icmpType, icmpCode, portMin, portMax = r.Type, r.Code, r.PortMin, r.PortMax
+	}
+
+	result := securityGroupRule{
+		ID:        flex.StringValue(id),
+		Name:      flex.StringValue(name),
+		Direction: flex.StringValue(direction),
+		IPVersion: flex.StringValue(ipVersion),
+		Protocol:  flex.StringValue(protocol),
+	}
+	if r, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && r != nil {
+		switch {
+		case r.ID != nil:
+			result.Remote = *r.ID
+		case r.Address != nil:
+			result.Remote = *r.Address
+		case r.CIDRBlock != nil:
+			result.Remote = *r.CIDRBlock
+		}
+	}
+	if l, ok := local.(*vpcv1.SecurityGroupRuleLocal); ok && l != nil {
+		switch {
+		case l.Address != nil:
+			result.Local = *l.Address
+		case l.CIDRBlock != nil:
+			result.Local = *l.CIDRBlock
+		}
+	}
+	if icmpType != nil {
+		result.Type = *icmpType
+	}
+	if icmpCode != nil {
+		result.Code = *icmpCode
+	}
+	if portMin != nil {
+		result.PortMin = *portMin
+	}
+	if portMax != nil {
+		result.PortMax = *portMax
+	}
+	return result
+}
+
+// planSecurityGroupRules computes the smallest set of API calls that make
+// the existing rules match the desired rules. Rules that allow the same
+// traffic are kept, and are only patched when their name changes. Of the
+// remaining rules, an existing rule is patched into a desired rule of the
+// same protocol, because the protocol of a rule cannot be patched. The other
+// desired rules are created and the other existing rules are deleted.
+func planSecurityGroupRules(desired, existing []securityGroupRule) securityGroupRulesPlan {
+	plan := securityGroupRulesPlan{}
+
+	matched := make([]bool, len(existing))
+	byKey := map[string][]int{}
+	for i, rule := range existing {
+		byKey[rule.key()] = append(byKey[rule.key()], i)
+	}
+
+	var unmatched []securityGroupRule
+	for _, rule := range desired {
+		candidates := byKey[rule.key()]
+		if len(candidates) == 0 {
+			unmatched = append(unmatched, rule)
+			continue
+		}
+		i := candidates[0]
+		byKey[rule.key()] = candidates[1:]
+		matched[i] = true
+		if rule.Name != "" && rule.Name != existing[i].Name {
+			plan.Updates = append(plan.Updates, securityGroupRuleUpdate{ID: existing[i].ID, Rule: rule})
+		}
+	}
+
+	for _, rule := range unmatched {
+		rule = rule.normalize()
+		candidate := -1
+		for i, existingRule := range existing {
+			if matched[i] || existingRule.normalize().Protocol != rule.Protocol {
+				continue
+			}
+			if candidate == -1 || (rule.Name != "" && existingRule.Name == rule.Name) {
+				candidate = i
+			}
+		}
+		if candidate == -1 {
+			plan.Creates = append(plan.Creates, rule)
+			continue
+		}
+		matched[candidate] = true
+		plan.Updates = append(plan.Updates, securityGroupRuleUpdate{ID: existing[candidate].ID, Rule: rule})
+	}
+
+	names := map[string]bool{}
+	for _, rule := range plan.Creates {
+		names[rule.Name] = rule.Name != ""
+	}
+	for _, update := range plan.Updates {
+		names[update.Rule.Name] = update.Rule.Name != ""
+	}
+	for i, rule := range existing {
+		switch {
+		case matched[i]:
+		case names[rule.Name]:
+			plan.DeletesFirst = append(plan.DeletesFirst, rule.ID)
+		default:
+			plan.Deletes = append(plan.Deletes, rule.ID)
+		}
+	}
+	return plan
+}
+
+func (r securityGroupRule) prototype() *vpcv1.SecurityGroupRulePrototype {
+	r = r.normalize()
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &r.Direction,
+		IPVersion: &r.IPVersion,
+		Protocol:  &r.Protocol,
+	}
+	if r.Name != "" {
+		prototype.Name = &r.Name
+	}
+
+	remoteAddress, remoteCIDR, remoteID, _ := inferRemoteSecurityGroup(r.Remote)
+	switch {
+	case remoteAddress != "":
+		prototype.Remote = &vpcv1.SecurityGroupRuleRemotePrototype{Address: &remoteAddress}
+	case remoteCIDR != "":
+		prototype.Remote = &vpcv1.SecurityGroupRuleRemotePrototype{CIDRBlock: &remoteCIDR}
+	case remoteID != "":
+		prototype.Remote = &vpcv1.SecurityGroupRuleRemotePrototype{ID: &remoteID}
+	}
+	localAddress, localCIDR, _ := inferLocalSecurityGroup(r.Local)
+	switch {
+	case localAddress != "":
+		prototype.Local = &vpcv1.SecurityGroupRuleLocalPrototype{Address: &localAddress}
+	case localCIDR != "":
+		prototype.Local = &vpcv1.SecurityGroupRuleLocalPrototype{CIDRBlock: &localCIDR}
+	}
+
+	switch r.Protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		if r.Type != 0 {
+			prototype.Type = &r.Type
+			if r.Code != 0 {
+				prototype.Code = &r.Code
+			}
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		prototype.PortMin = &r.PortMin
+		prototype.PortMax = &r.PortMax
+	}
+	return prototype
+}
+
+func (r securityGroupRule) patch() (map[string]interface{}, error) {
+	r = r.normalize()
+	patchModel := &vpcv1.SecurityGroupRulePatch{
+		Direction: &r.Direction,
+		IPVersion: &r.IPVersion,
+	}
+	if r.Name != "" {
+		patchModel.Name = &r.Name
+	}
+
+	remoteAddress, remoteCIDR, remoteID, _ := inferRemoteSecurityGroup(r.Remote)
+	switch {
+	case remoteAddress != "":
+		patchModel.Remote = &vpcv1.SecurityGroupRuleRemotePatch{Address: &remoteAddress}
+	case remoteCIDR != "":
+		patchModel.Remote = &vpcv1.SecurityGroupRuleRemotePatch{CIDRBlock: &remoteCIDR}
+	case remoteID != "":
+		patchModel.Remote = &vpcv1.SecurityGroupRuleRemotePatch{ID: &remoteID}
+	}
+	localAddress, localCIDR, _ := inferLocalSecurityGroup(r.Local)
+	switch {
+	case localAddress != "":
+		patchModel.Local = &vpcv1.SecurityGroupRuleLocalPatch{Address: &localAddress}
+	case localCIDR != "":
+		patchModel.Local = &vpcv1.SecurityGroupRuleLocalPatch{CIDRBlock: &localCIDR}
+	}
+
+	switch r.Protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		if r.Type != 0 {
+			patchModel.Type = &r.Type
+			if r.Code != 0 {
+				patchModel.Code = &r.Code
+			}
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		patchModel.PortMin = &r.PortMin
+		patchModel.PortMax = &r.PortMax
+	}
+
+	patch, err := patchModel.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupRulePatch: %s", err)
+	}
+	// An ICMP type and code that are no longer set must be removed explicitly.
+	if r.Protocol == isSecurityGroupRuleProtocolICMP {
+		if r.Type == 0 {
+			patch["type"] = nil
+		}
+		if r.Code == 0 {
+			patch["code"] = nil
+		}
+	}
+	return patch, nil
+}
+
+func resourceIBMISSecurityGroupRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityGroupID := d.Get(isSecurityGroupRulesSecurityGroup).(string)
+	if diags := resourceIBMISSecurityGroupRulesApply(context, d, meta, securityGroupID, "create"); diags.HasError() {
+		return diags
+	}
+	d.SetId(securityGroupID)
+	return resourceIBMISSecurityGroupRulesGet(context, d, meta, "create", false)
+}
+
+func resourceIBMISSecurityGroupRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIBMISSecurityGroupRulesGet(context, d, meta, "read", true)
+}
+
+func resourceIBMISSecurityGroupRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isSecurityGroupRulesRules) {
+		if diags := resourceIBMISSecurityGroupRulesApply(context, d, meta, d.Id(), "update"); diags.HasError() {
+			return diags
+		}
+	}
+	return resourceIBMISSecurityGroupRulesGet(context, d, meta, "update", false)
+}
+
+func resourceIBMISSecurityGroupRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	securityGroupID := d.Id()
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	for _, v := range d.Get(isSecurityGroupRulesRules).(*schema.Set).List() {
+		rule := securityGroupRuleFromMap(v.(map[string]interface{}))
+		if rule.ID == "" {
+			continue
+		}
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              &rule.ID,
+		}
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, deleteSecurityGroupRuleOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecurityGroupRuleWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISSecurityGroupRulesApply makes the rules of the security group
+// match the configuration. The rules are listed and changed while the rules
+// of the security group are locked, so that the plan cannot go stale.
+func resourceIBMISSecurityGroupRulesApply(context context.Context, d *schema.ResourceData, meta interface{}, securityGroupID, operation string) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	existing, _, err := listSecurityGroupRules(context, sess, securityGroupID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecurityGroupRulesWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	var desired []securityGroupRule
+	for _, v := range d.Get(isSecurityGroupRulesRules).(*schema.Set).List() {
+		desired = append(desired, securityGroupRuleFromMap(v.(map[string]interface{})))
+	}
+
+	plan := planSecurityGroupRules(desired, existing)
+	if plan.isEmpty() {
+		return nil
+	}
+	log.Printf("[DEBUG] Applying the rules of security group %s: %d to create, %d to update, %d to delete", securityGroupID, len(plan.Creates), len(plan.Updates), len(plan.DeletesFirst)+len(plan.Deletes))
+
+	// Rules are created and patched before the other rules are deleted, so
+	// that a failed apply leaves the security group with more rules rather
+	// than with missing rules. Only the rules whose names are reused are
+	// deleted first.
+	if diags := deleteSecurityGroupRules(context, sess, securityGroupID, plan.DeletesFirst, operation); diags.HasError() {
+		return diags
+	}
+
+	for _, update := range plan.Updates {
+		patch, err := update.Rule.patch()
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation, "parse-request-body").GetDiag()
+		}
+		updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+			SecurityGroupID:        &securityGroupID,
+			ID:                     core.StringPtr(update.ID),
+			SecurityGroupRulePatch: patch,
+		}
+		if _, _, err := sess.UpdateSecurityGroupRuleWithContext(context, updateSecurityGroupRuleOptions); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateSecurityGroupRuleWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	for _, rule := range plan.Creates {
+		createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &securityGroupID,
+			SecurityGroupRulePrototype: rule.prototype(),
+		}
+		if _, _, err := sess.CreateSecurityGroupRuleWithContext(context, createSecurityGroupRuleOptions); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecurityGroupRuleWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return deleteSecurityGroupRules(context, sess, securityGroupID, plan.Deletes, operation)
+}
+
+func deleteSecurityGroupRules(context context.Context, sess *vpcv1.VpcV1, securityGroupID string, ruleIDs []string, operation string) diag.Diagnostics {
+	for _, ruleID := range ruleIDs {
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              core.StringPtr(ruleID),
+		}
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecurityGroupRuleWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	return nil
+}
+
+// resourceIBMISSecurityGroupRulesGet reads all of the rules of the security
+// group. When reportDrift is set, the rules that were added outside of
+// Terraform since the last refresh are reported in a warning.
+func resourceIBMISSecurityGroupRulesGet(context context.Context, d *schema.ResourceData, meta interface{}, operation string, reportDrift bool) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	securityGroupID := d.Id()
+	rules, response, err := listSecurityGroupRules(context, sess, securityGroupID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecurityGroupRulesWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	known := map[string]bool{}
+	for _, v := range d.Get(isSecurityGroupRulesRules).(*schema.Set).List() {
+		if rule := securityGroupRuleFromMap(v.(map[string]interface{})); rule.ID != "" {
+			known[rule.ID] = true
+		}
+	}
+
+	var diags diag.Diagnostics
+	var outOfBand []string
+	rulesList := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		rulesList = append(rulesList, rule.toMap())
+		if !known[rule.ID] {
+			outOfBand = append(outOfBand, rule.ID)
+		}
+	}
+	if reportDrift && len(known) > 0 && len(outOfBand) > 0 {
+		sort.Strings(outOfBand)
+		tfWarning := flex.TerraformWarningf(nil, fmt.Sprintf("The security group %s has rules that were added outside of Terraform: %s", securityGroupID, strings.Join(outOfBand, ", ")), "ibm_is_security_group_rules", operation).
+			WithAttribute(isSecurityGroupRulesRules).
+			WithRemediation("Add the rules to the configuration, or they are removed on the next apply.")
+		diags = append(diags, tfWarning.GetDiag()...)
+	}
+
+	if err = d.Set(isSecurityGroupRulesSecurityGroup, securityGroupID); err != nil {
+		err = fmt.Errorf("Error setting security_group: %s", err)
+		return append(diags, flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation, "set-security_group").GetDiag()...)
+	}
+	if err = d.Set(isSecurityGroupRulesRules, schema.NewSet(resourceIBMISSecurityGroupRulesHash, rulesList)); err != nil {
+		err = fmt.Errorf("Error setting rules: %s", err)
+		return append(diags, flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation, "set-rules").GetDiag()...)
+	}
+	return diags
+}
+
+func listSecurityGroupRules(context context.Context, sess *vpcv1.VpcV1, securityGroupID string) ([]securityGroupRule, *core.DetailedResponse, error) {
+	listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+		SecurityGroupID: &securityGroupID,
+	}
+	collection, response, err := sess.ListSecurityGroupRulesWithContext(context, listSecurityGroupRulesOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	rules := make([]securityGroupRule, 0, len(collection.Rules))
+	for _, rule := range collection.Rules {
+		rules = append(rules, securityGroupRuleFromAPI(rule))
+	}
+	return rules, response, nil
+}
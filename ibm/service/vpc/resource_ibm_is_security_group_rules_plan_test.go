@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanSecurityGroupRules(t *testing.T) {
+	sshRule := securityGroupRule{Direction: "inbound", Protocol: "tcp", PortMin: 22, PortMax: 22}
+	httpsRule := securityGroupRule{Direction: "inbound", Protocol: "tcp", PortMin: 443, PortMax: 443}
+	dnsRule := securityGroupRule{Direction: "inbound", Protocol: "udp", PortMin: 53, PortMax: 53}
+
+	withID := func(rule securityGroupRule, id, name string) securityGroupRule {
+		rule = rule.normalize()
+		rule.ID, rule.Name = id, name
+		return rule
+	}
+	withName := func(rule securityGroupRule, name string) securityGroupRule {
+		rule.Name = name
+		return rule
+	}
+
+	testcases := []struct {
+		description string
+		desired     []securityGroupRule
+		existing    []securityGroupRule
+		expected    securityGroupRulesPlan
+	}{
+		{
+			description: "When the rules only differ by their defaults, Expect no change",
+			desired:     []securityGroupRule{sshRule, {Direction: "outbound"}},
+			existing: []securityGroupRule{
+				withID(securityGroupRule{Direction: "outbound"}, "r-2", "rule-2"),
+				withID(sshRule, "r-1", "rule-1"),
+			},
+			expected: securityGroupRulesPlan{},
+		},
+		{
+			description: "When a rule is renamed, Expect it to be patched",
+			desired:     []securityGroupRule{withName(sshRule, "ssh")},
+			existing:    []securityGroupRule{withID(sshRule, "r-1", "rule-1")},
+			expected: securityGroupRulesPlan{
+				Updates: []securityGroupRuleUpdate{{ID: "r-1", Rule: withName(sshRule, "ssh")}},
+			},
+		},
+		{
+			description: "When the ports of a rule change, Expect it to be patched",
+			desired:     []securityGroupRule{httpsRule},
+			existing:    []securityGroupRule{withID(sshRule, "r-1", "rule-1")},
+			expected: securityGroupRulesPlan{
+				Updates: []securityGroupRuleUpdate{{ID: "r-1", Rule: httpsRule.normalize()}},
+			},
+		},
+		{
+			description: "When the protocol of a rule changes, Expect it to be created and the old rule to be deleted",
+			desired:     []securityGroupRule{dnsRule},
+			existing:    []securityGroupRule{withID(sshRule, "r-1", "rule-1")},
+			expected: securityGroupRulesPlan{
+				Creates: []securityGroupRule{dnsRule.normalize()},
+				Deletes: []string{"r-1"},
+			},
+		},
+		{
+			description: "When a created rule reuses the name of a deleted rule, Expect the rule to be deleted first",
+			desired:     []securityGroupRule{withName(dnsRule, "rule-1")},
+			existing:    []securityGroupRule{withID(sshRule, "r-1", "rule-1")},
+			expected: securityGroupRulesPlan{
+				Creates:      []securityGroupRule{withName(dnsRule, "rule-1").normalize()},
+				DeletesFirst: []string{"r-1"},
+			},
+		},
+		{
+			description: "When a rule exists twice, Expect one of them to be deleted",
+			desired:     []securityGroupRule{sshRule},
+			existing:    []securityGroupRule{withID(sshRule, "r-1", "rule-1"), withID(sshRule, "r-2", "rule-2")},
+			expected: securityGroupRulesPlan{
+				Deletes: []string{"r-2"},
+			},
+		},
+		{
+			description: "When several rules of the protocol can be patched, Expect the rule with the same name to be patched",
+			desired:     []securityGroupRule{withName(httpsRule, "web")},
+			existing: []securityGroupRule{
+				withID(sshRule, "r-1", "ssh"),
+				withID(securityGroupRule{Direction: "inbound", Protocol: "tcp", PortMin: 80, PortMax: 80}, "r-2", "web"),
+			},
+			expected: securityGroupRulesPlan{
+				Updates: []securityGroupRuleUpdate{{ID: "r-2", Rule: withName(httpsRule, "web").normalize()}},
+				Deletes: []string{"r-1"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			require.Equal(t, tc.expected, planSecurityGroupRules(tc.desired, tc.existing))
+		})
+	}
+}
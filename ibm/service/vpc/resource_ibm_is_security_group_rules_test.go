@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSecurityGroupRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acctest.RandIntRange(10, 100))
+	sgname := fmt.Sprintf("tfsgrules-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, sgname, "ssh"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 3),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.*", map[string]string{
+							"name":     "ssh",
+							"protocol": "tcp",
+							"port_min": "22",
+							"port_max": "22",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesUpdateConfig(vpcname, sgname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 2),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.*", map[string]string{
+							"name":     "https",
+							"protocol": "tcp",
+							"port_min": "443",
+							"port_max": "443",
+						}),
+				),
+			},
+			{
+				// A rule that is added outside of Terraform is detected as drift.
+				PreConfig:          testAccIBMISSecurityGroupRulesAddRule(sgname),
+				Config:             testAccCheckIBMISSecurityGroupRulesUpdateConfig(vpcname, sgname),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesUpdateConfig(vpcname, sgname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 2),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_rules.testacc_security_group_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSecurityGroupRulesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_security_group" {
+			continue
+		}
+
+		getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+		if err == nil {
+			return fmt.Errorf("security group still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSecurityGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err != nil {
+			return err
+		}
+		if len(rules.Rules) != count {
+			return fmt.Errorf("expected %d rules in security group %s, got %d", count, rs.Primary.ID, len(rules.Rules))
+		}
+		return nil
+	}
+}
+
+func testAccIBMISSecurityGroupRulesAddRule(sgname string) func() {
+	return func() {
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		name := sgname
+		securityGroups, _, err := sess.ListSecurityGroups(&vpcv1.ListSecurityGroupsOptions{})
+		if err != nil {
+			panic(err)
+		}
+		for _, securityGroup := range securityGroups.SecurityGroups {
+			if *securityGroup.Name != name {
+				continue
+			}
+			direction := "outbound"
+			protocol := "icmp"
+			createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+				SecurityGroupID: securityGroup.ID,
+				SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+					Direction: &direction,
+					Protocol:  &protocol,
+				},
+			}
+			if _, _, err := sess.CreateSecurityGroupRule(createSecurityGroupRuleOptions); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+func testAccCheckIBMISSecurityGroupRulesConfig(vpcname, sgname, sshName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		security_group = ibm_is_security_group.testacc_security_group.id
+
+		rules {
+			name      = "%s"
+			direction = "inbound"
+			protocol  = "tcp"
+			remote    = "10.0.0.0/8"
+			port_min  = 22
+			port_max  = 22
+		}
+		rules {
+			direction = "inbound"
+			protocol  = "icmp"
+			type      = 8
+		}
+		rules {
+			direction = "outbound"
+			protocol  = "any"
+		}
+	}`, vpcname, sgname, sshName)
+}
+
+func testAccCheckIBMISSecurityGroupRulesUpdateConfig(vpcname, sgname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		security_group = ibm_is_security_group.testacc_security_group.id
+
+		rules {
+			name      = "https"
+			direction = "inbound"
+			protocol  = "tcp"
+			remote    = "10.0.0.0/8"
+			port_min  = 443
+			port_max  = 443
+		}
+		rules {
+			direction = "outbound"
+			protocol  = "any"
+		}
+	}`, vpcname, sgname)
+}
@@ -12,10 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -126,7 +126,7 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 			log.Printf("[INFO] Load balancer with ID '%s' is in UPDATE_PENDING state. Waiting for it to become available before retrying...", targetID)
 
 			// Wait for the load balancer to become available
-			_, waitErr := isWaitForSGTargetLBAvailable(sess, targetID, d.Timeout(schema.TimeoutCreate))
+			_, waitErr := isWaitForSGTargetLBAvailable(context, sess, meta, targetID, d.Timeout(schema.TimeoutCreate))
 			if waitErr != nil {
 				err = fmt.Errorf("isWaitForSGTargetLBAvailable failed: waiting for load balancer to become available while creating Security Group Target Binding: %s", waitErr)
 				tfErr := flex.TerraformErrorf(waitErr, fmt.Sprintf("isWaitForLbSgTargetCreateAvailable failed: %s", waitErr.Error()), "ibm_is_security_group_target", "create")
@@ -152,7 +152,7 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 	crn := sgtarget.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := sgtarget.ID
-		_, errsgt := isWaitForLbSgTargetCreateAvailable(sess, *lbid, d.Timeout(schema.TimeoutCreate))
+		_, errsgt := isWaitForLbSgTargetCreateAvailable(context, sess, meta, *lbid, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbSgTargetCreateAvailable failed: %s", errsgt.Error()), "ibm_is_security_group_target", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -160,7 +160,7 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 		}
 	} else if crn != nil && *crn != "" && (strings.Contains(*crn, "virtual-network-interface") || strings.Contains(*crn, "virtual-network-interfaces")) {
 		vniId := sgtarget.ID
-		_, errsgt := isWaitForVNISgTargetCreateAvailable(sess, *vniId, d.Timeout(schema.TimeoutCreate))
+		_, errsgt := isWaitForVNISgTargetCreateAvailable(context, sess, meta, *vniId, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			tfErr := flex.TerraformErrorf(errsgt, fmt.Sprintf("isWaitForVNISgTargetCreateAvailable failed: %s", errsgt.Error()), "ibm_is_security_group_target", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -280,7 +280,7 @@ func resourceIBMISSecurityGroupTargetDelete(context context.Context, d *schema.R
 	crn := securityGroupTargetReference.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := securityGroupTargetReference.ID
-		_, errsgt := isWaitForLBRemoveAvailable(sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
+		_, errsgt := isWaitForLBRemoveAvailable(context, sess, meta, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
 		if errsgt != nil {
 			tfErr := flex.TerraformErrorf(errsgt, fmt.Sprintf("isWaitForLBRemoveAvailable failed: %s", errsgt.Error()), "ibm_is_security_group_target", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -326,37 +326,36 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 
 }
 
-func isWaitForLBRemoveAvailable(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBRemoveAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[INFO] Waiting for load balancer binding (%s) to be removed.", lbId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:        []string{isLBProvisioning},
-		Target:         []string{isLBProvisioningDone},
-		Refresh:        isLBRemoveRefreshFunc(sess, sgt, lbId, securityGroupID, securityGroupTargetID),
-		Timeout:        timeout,
-		Delay:          10 * time.Second,
-		MinTimeout:     10 * time.Second,
-		NotFoundChecks: 1,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("security group (%s) load balancer target (%s)", securityGroupID, lbId),
+		Pending:  []string{isLBProvisioning},
+		Target:   []string{isLBProvisioningDone},
+		Refresh:  isLBRemoveRefreshFunc(sess, sgt, lbId, securityGroupID, securityGroupTargetID),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getSecurityGroupTargetOptions := &vpcv1.GetSecurityGroupTargetOptions{
 			SecurityGroupID: &securityGroupID,
 			ID:              &securityGroupTargetID,
 		}
-		_, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+		_, response, err := sess.GetSecurityGroupTargetWithContext(ctx, getSecurityGroupTargetOptions)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				getlboptions := &vpcv1.GetLoadBalancerOptions{
 					ID: &lbId,
 				}
-				lb, response, err := sess.GetLoadBalancer(getlboptions)
+				lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 				if err != nil {
 					return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 				}
@@ -373,28 +372,28 @@ func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetRefer
 	}
 }
 
-func isWaitForLbSgTargetCreateAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbSgTargetCreateAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBSgTargetRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s)", lbId),
+		Pending:  []string{"retry", isLBProvisioning, "update_pending"},
+		Target:   []string{isLBProvisioningDone, ""},
+		Refresh:  isLBSgTargetRefreshFunc(sess, lbId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
@@ -407,28 +406,28 @@ func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefre
 	}
 }
 
-func isWaitForVNISgTargetCreateAvailable(sess *vpcv1.VpcV1, vniId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVNISgTargetCreateAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, vniId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual network interface (%s) to be available.", vniId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending", "updating", "waiting"},
-		Target:     []string{isLBProvisioningDone, "", "stable"},
-		Refresh:    isVNISgTargetRefreshFunc(sess, vniId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("virtual network interface (%s)", vniId),
+		Pending:  []string{"pending", "updating", "waiting"},
+		Target:   []string{isLBProvisioningDone, "", "stable"},
+		Refresh:  isVNISgTargetRefreshFunc(sess, vniId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVNISgTargetRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVNISgTargetRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getVNIOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
 			ID: &vniId,
 		}
-		vni, response, err := vpcClient.GetVirtualNetworkInterface(getVNIOptions)
+		vni, response, err := vpcClient.GetVirtualNetworkInterfaceWithContext(ctx, getVNIOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting virtual network interface : %s\n%s", err, response)
 		}
@@ -441,28 +440,28 @@ func isVNISgTargetRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string) resource.Sta
 }
 
 // New function to wait for a load balancer to become available before attaching a security group
-func isWaitForSGTargetLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForSGTargetLBAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available before attaching security group.", lbId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning, "update_pending"},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isSGTargetLBRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("load balancer (%s)", lbId),
+		Pending:  []string{"retry", isLBProvisioning, "update_pending"},
+		Target:   []string{isLBProvisioningDone, ""},
+		Refresh:  isSGTargetLBRefreshFunc(sess, lbId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 // Refresh function for checking load balancer status before security group attachment
-func isSGTargetLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSGTargetLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getlboptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &lbId,
 		}
-		lb, response, err := sess.GetLoadBalancer(getlboptions)
+		lb, response, err := sess.GetLoadBalancerWithContext(ctx, getlboptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
 		}
@@ -13,10 +13,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -37,8 +37,12 @@ func normalizeValue(value string) string {
 	}
 	return value
 }
+
+// ibmIsShareIdentity is the identity of ibm_is_share, which can be used to import it.
+var ibmIsShareIdentity = vpcResourceIdentity("The unique identifier of the file share.")
+
 func ResourceIbmIsShare() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIbmIsShareCreate,
 		ReadContext:   resourceIbmIsShareRead,
 		UpdateContext: resourceIbmIsShareUpdate,
@@ -1055,7 +1059,7 @@ func ResourceIbmIsShare() *schema.Resource {
 				Description: "The storage generation for this share",
 			},
 		},
-	}
+	}, ibmIsShareIdentity)
 }
 
 func ResourceIbmIsShareValidator() *validate.ResourceValidator {
@@ -1353,7 +1357,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForShareAvailable(context, vpcClient, *share.ID, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForShareAvailable(context, vpcClient, meta, *share.ID, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("wait for share available failed: %s\n%s", err.Error(), response), "ibm_is_share", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1361,7 +1365,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 	}
 
 	if share.ReplicaShare != nil && share.ReplicaShare.ID != nil {
-		_, err = isWaitForShareAvailable(context, vpcClient, *share.ReplicaShare.ID, d, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForShareAvailable(context, vpcClient, meta, *share.ReplicaShare.ID, d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("wait for share available  failed: %s\n%s", err.Error(), response), "ibm_is_share", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1820,7 +1824,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return diag.FromErr(tfErr)
 				}
-				_, err = isWaitForTargetDelete(context, vpcClient, d, d.Id(), *targetsItem.ID)
+				_, err = isWaitForTargetDelete(context, vpcClient, meta, d, d.Id(), *targetsItem.ID)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for target delete failed: %s", err.Error()), "ibm_is_share", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1861,7 +1865,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return diag.FromErr(tfErr)
 						}
-						_, err = isWaitForTargetDelete(context, vpcClient, d, d.Id(), *targetsItem.ID)
+						_, err = isWaitForTargetDelete(context, vpcClient, meta, d, d.Id(), *targetsItem.ID)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for target delete failed: %s", err.Error()), "ibm_is_share", "delete")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1891,7 +1895,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 				return diag.FromErr(tfErr)
 			}
 
-			_, err = isWaitForShareDelete(context, vpcClient, d, *replicaShare.ID)
+			_, err = isWaitForShareDelete(context, vpcClient, meta, d, *replicaShare.ID)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for share delete failed: %s", err.Error()), "ibm_is_share", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1921,7 +1925,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 		return diag.FromErr(tfErr)
 	}
 
-	_, err = isWaitForShareDelete(context, vpcClient, d, d.Id())
+	_, err = isWaitForShareDelete(context, vpcClient, meta, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for share delete failed: %s", err.Error()), "ibm_is_share", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1933,28 +1937,28 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func isWaitForShareAvailable(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForShareAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for share (%s) to be available.", shareid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"updating", "pending", "waiting"},
-		Target:     []string{"stable", "failed"},
-		Refresh:    isShareRefreshFunc(context, vpcClient, shareid, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s)", shareid),
+		Pending:  []string{"updating", "pending", "waiting"},
+		Target:   []string{"stable", "failed"},
+		Refresh:  isShareRefreshFunc(vpcClient, shareid, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isShareRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isShareRefreshFunc(vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		shareOptions := &vpcv1.GetShareOptions{}
 
 		shareOptions.SetID(shareid)
 
-		share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
+		share, response, err := vpcClient.GetShareWithContext(ctx, shareOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
 		}
@@ -1968,17 +1972,18 @@ func isShareRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid
 	}
 }
 
-func isWaitForShareDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, shareid string) (interface{}, error) {
+func isWaitForShareDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, shareid string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"deleting", "stable", "waiting"},
-		Target:  []string{"done"},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s)", shareid),
+		Pending:  []string{"deleting", "stable", "waiting"},
+		Target:   []string{"done"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			shareOptions := &vpcv1.GetShareOptions{}
 
 			shareOptions.SetID(shareid)
 
-			share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
+			share, response, err := vpcClient.GetShareWithContext(ctx, shareOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return share, "done", nil
@@ -1990,12 +1995,11 @@ func isWaitForShareDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *sc
 			}
 			return share, "deleting", nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func suppressCronSpecDiff(k, old, new string, d *schema.ResourceData) bool {
@@ -2238,7 +2242,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 		}
 		updateShareOptions.SetSharePatch(sharePatch)
 		if hasSizeChanged || hasBandwidthChanged {
-			_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForShareAvailable(context, vpcClient, meta, d.Id(), d, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
@@ -2248,7 +2252,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 			log.Printf("[DEBUG] UpdateShareWithContext failed %s\n%s", err, response)
 			return err
 		}
-		_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForShareAvailable(context, vpcClient, meta, d.Id(), d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -2290,7 +2294,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 					log.Printf("[DEBUG] UpdateShareTargetWithContext failed %s\n%s", err, response)
 					return err
 				}
-				_, err = WaitForTargetAvailable(context, vpcClient, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForTargetAvailable(context, vpcClient, meta, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -2334,7 +2338,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 					log.Printf("[DEBUG] UpdateShareTargetWithContext failed %s\n%s", err, response)
 					return err
 				}
-				_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+				_, err = WaitForVNIAvailable(context, vpcClient, meta, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					return err
 				}
@@ -2358,12 +2362,12 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface of share mount target %s\n%s: %q", add[i], d.Id(), err, response)
 						}
-						_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+						_, err = WaitForVNIAvailable(context, vpcClient, meta, networkID, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							return err
 						}
 
-						_, err = WaitForTargetAvailable(context, vpcClient, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
+						_, err = WaitForTargetAvailable(context, vpcClient, meta, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							return err
 						}
@@ -2380,12 +2384,12 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface of share mount target %s\n%s: %q", remove[i], d.Id(), err, response)
 						}
-						_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+						_, err = WaitForVNIAvailable(context, vpcClient, meta, networkID, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							return err
 						}
 
-						_, err = WaitForTargetAvailable(context, vpcClient, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
+						_, err = WaitForTargetAvailable(context, vpcClient, meta, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							return err
 						}
@@ -2422,7 +2426,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
 				}
-				_, err = isWaitForReservedIpAvailable(sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForReservedIpAvailable(context, sess, meta, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error waiting for the reserved IP to be available: %s", err)
 				}
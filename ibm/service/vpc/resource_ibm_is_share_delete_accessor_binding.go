@@ -11,8 +11,8 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -63,7 +63,7 @@ func resourceIbmIsShareDeleteAccessorBindingCreate(context context.Context, d *s
 		return flex.TerraformErrorf(err, fmt.Sprintf("DeleteShareAccessorBindingWithContext failed: %s\n%s", err.Error(), response), "ibm_is_share_delete_accessor_binding", "delete").GetDiag()
 	}
 
-	_, err = isWaitForShareAccessorBindingDeleted(context, vpcClient, share_id, bindingId, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForShareAccessorBindingDeleted(context, vpcClient, meta, share_id, bindingId, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForShareAccessorBindingDeleted failed: %s", err.Error()), "ibm_is_share_delete_accessor_binding", "delete").GetDiag()
 	}
@@ -71,29 +71,29 @@ func resourceIbmIsShareDeleteAccessorBindingCreate(context context.Context, d *s
 	return nil
 }
 
-func isWaitForShareAccessorBindingDeleted(context context.Context, vpcClient *vpcv1.VpcV1, shareid, bindingId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForShareAccessorBindingDeleted(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid, bindingId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for share accessor binding (%s) to be deleted.", shareid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending", "deleting"},
-		Target:     []string{"done"},
-		Refresh:    isShareAccessorBindingRefreshFunc(context, vpcClient, shareid, bindingId, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) accessor binding (%s)", shareid, bindingId),
+		Pending:  []string{"pending", "deleting"},
+		Target:   []string{"done"},
+		Refresh:  isShareAccessorBindingRefreshFunc(vpcClient, shareid, bindingId, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isShareAccessorBindingRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, bindingId string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isShareAccessorBindingRefreshFunc(vpcClient *vpcv1.VpcV1, shareid string, bindingId string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		bindingOptions := &vpcv1.GetShareAccessorBindingOptions{
 			ShareID: &shareid,
 			ID:      &bindingId,
 		}
 
-		shareBinding, response, err := vpcClient.GetShareAccessorBindingWithContext(context, bindingOptions)
+		shareBinding, response, err := vpcClient.GetShareAccessorBindingWithContext(ctx, bindingOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return shareBinding, "done", nil
@@ -11,9 +11,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -321,7 +321,7 @@ func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.Reso
 		if mountTargets.VPC != nil && *mountTargets.VPC.ID == vpcId {
 			isWaitForOldTargetDelete(context, vpcClient, d, shareId, *mountTargets.ID)
 			if *mountTargets.LifecycleState == "deleting" {
-				_, err = isWaitForTargetDelete(context, vpcClient, d, shareId, *mountTargets.ID)
+				_, err = isWaitForTargetDelete(context, vpcClient, meta, d, shareId, *mountTargets.ID)
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForTargetDelete failed: %s", err.Error()), "ibm_is_share_mount_target", "create").GetDiag()
 				}
@@ -399,12 +399,12 @@ func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.Reso
 	}
 	d.SetId(fmt.Sprintf("%s/%s", *createShareMountTargetOptions.ShareID, *shareTarget.ID))
 	if shareTarget.VirtualNetworkInterface != nil {
-		_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+		_, err = WaitForVNIAvailable(context, vpcClient, meta, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "create").GetDiag()
 		}
 	}
-	_, err = WaitForMountTargetAvailable(context, vpcClient, *createShareMountTargetOptions.ShareID, *shareTarget.ID, d, d.Timeout(schema.TimeoutCreate))
+	_, err = WaitForMountTargetAvailable(context, vpcClient, meta, *createShareMountTargetOptions.ShareID, *shareTarget.ID, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("WaitForMountTargetAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "create").GetDiag()
 	}
@@ -562,7 +562,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+		_, err = WaitForVNIAvailable(context, vpcClient, meta, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 		}
@@ -588,12 +588,12 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForVNIAvailable(context, vpcClient, meta, networkID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 				}
 
-				_, err = WaitForTargetAvailable(context, vpcClient, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForTargetAvailable(context, vpcClient, meta, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("WaitForTargetAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 				}
@@ -612,12 +612,12 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForVNIAvailable(context, vpcClient, meta, networkID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 				}
 
-				_, err = WaitForTargetAvailable(context, vpcClient, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForTargetAvailable(context, vpcClient, meta, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("WaitForTargetAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 				}
@@ -658,7 +658,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForReservedIpAvailable(sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForReservedIpAvailable(context, sess, meta, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservedIpAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 		}
@@ -676,7 +676,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = WaitForMountTargetAvailable(context, vpcClient, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
+		_, err = WaitForMountTargetAvailable(context, vpcClient, meta, shareId, mountTargetId, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("WaitForMountTargetAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 		}
@@ -710,7 +710,7 @@ func resourceIBMIsShareMountTargetDelete(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG] DeleteShareMountTargetWithContext failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
-	_, err = isWaitForMountTargetDelete(context, vpcClient, d, parts[0], parts[1])
+	_, err = isWaitForMountTargetDelete(context, vpcClient, meta, d, parts[0], parts[1])
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -720,29 +720,29 @@ func resourceIBMIsShareMountTargetDelete(context context.Context, d *schema.Reso
 	return nil
 }
 
-func WaitForMountTargetAvailable(context context.Context, vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func WaitForMountTargetAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid, targetid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for target (%s) to be available.", targetid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"updating", "pending", "waiting"},
-		Target:     []string{"stable", "failed"},
-		Refresh:    mountTargetRefresh(context, vpcClient, shareid, targetid, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) mount target (%s)", shareid, targetid),
+		Pending:  []string{"updating", "pending", "waiting"},
+		Target:   []string{"stable", "failed"},
+		Refresh:  mountTargetRefresh(vpcClient, shareid, targetid, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func mountTargetRefresh(context context.Context, vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func mountTargetRefresh(vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		shareTargetOptions := &vpcv1.GetShareMountTargetOptions{}
 
 		shareTargetOptions.SetShareID(shareid)
 		shareTargetOptions.SetID(targetid)
 
-		target, response, err := vpcClient.GetShareMountTargetWithContext(context, shareTargetOptions)
+		target, response, err := vpcClient.GetShareMountTargetWithContext(ctx, shareTargetOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting target: %s\n%s", err, response)
 		}
@@ -756,18 +756,19 @@ func mountTargetRefresh(context context.Context, vpcClient *vpcv1.VpcV1, shareid
 	}
 }
 
-func isWaitForMountTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, shareid, targetid string) (interface{}, error) {
+func isWaitForMountTargetDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, shareid, targetid string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"deleting", "stable"},
-		Target:  []string{"done"},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) mount target (%s)", shareid, targetid),
+		Pending:  []string{"deleting", "stable"},
+		Target:   []string{"done"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			shareTargetOptions := &vpcv1.GetShareMountTargetOptions{}
 
 			shareTargetOptions.SetShareID(shareid)
 			shareTargetOptions.SetID(targetid)
 
-			target, response, err := vpcClient.GetShareMountTargetWithContext(context, shareTargetOptions)
+			target, response, err := vpcClient.GetShareMountTargetWithContext(ctx, shareTargetOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return target, "done", nil
@@ -779,12 +780,11 @@ func isWaitForMountTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1,
 			}
 			return target, "deleting", nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func ShareMountTargetVNIReservedIPInterfaceToMap(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, ripRef *vpcv1.ReservedIPReference, subnetId string) (map[string]interface{}, error) {
@@ -950,18 +950,19 @@ func ShareMountTargetMapToShareMountTargetPrototype(d *schema.ResourceData, vniM
 	return vniPrototype, nil
 }
 
-func isWaitForTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, shareid, targetid string) (interface{}, error) {
+func isWaitForTargetDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, shareid, targetid string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"deleting", "stable"},
-		Target:  []string{"done"},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) mount target (%s)", shareid, targetid),
+		Pending:  []string{"deleting", "stable"},
+		Target:   []string{"done"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			shareTargetOptions := &vpcv1.GetShareMountTargetOptions{}
 
 			shareTargetOptions.SetShareID(shareid)
 			shareTargetOptions.SetID(targetid)
 
-			target, response, err := vpcClient.GetShareMountTargetWithContext(context, shareTargetOptions)
+			target, response, err := vpcClient.GetShareMountTargetWithContext(ctx, shareTargetOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return target, "done", nil
@@ -973,35 +974,34 @@ func isWaitForTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *s
 			}
 			return target, "deleting", nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func WaitForVNIAvailable(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func WaitForVNIAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, vniId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VNI (%s) to be available.", vniId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"updating", "pending", "waiting"},
-		Target:     []string{"stable", "failed"},
-		Refresh:    VNIRefreshFunc(vpcClient, vniId, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("virtual network interface (%s)", vniId),
+		Pending:  []string{"updating", "pending", "waiting"},
+		Target:   []string{"stable", "failed"},
+		Refresh:  VNIRefreshFunc(vpcClient, vniId, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func VNIRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func VNIRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getVNIOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
 			ID: &vniId,
 		}
-		vni, response, err := vpcClient.GetVirtualNetworkInterface(getVNIOptions)
+		vni, response, err := vpcClient.GetVirtualNetworkInterfaceWithContext(ctx, getVNIOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting virtual network interface : %s\n%s", err, response)
 		}
@@ -1013,29 +1013,29 @@ func VNIRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData
 	}
 }
 
-func WaitForTargetAvailable(context context.Context, vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func WaitForTargetAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid, targetid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for target (%s) to be available.", targetid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"updating", "pending", "waiting"},
-		Target:     []string{"stable", "failed"},
-		Refresh:    mountTargetRefreshFunc(context, vpcClient, shareid, targetid, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) mount target (%s)", shareid, targetid),
+		Pending:  []string{"updating", "pending", "waiting"},
+		Target:   []string{"stable", "failed"},
+		Refresh:  mountTargetRefreshFunc(vpcClient, shareid, targetid, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func mountTargetRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func mountTargetRefreshFunc(vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		shareTargetOptions := &vpcv1.GetShareMountTargetOptions{}
 
 		shareTargetOptions.SetShareID(shareid)
 		shareTargetOptions.SetID(targetid)
 
-		target, response, err := vpcClient.GetShareMountTargetWithContext(context, shareTargetOptions)
+		target, response, err := vpcClient.GetShareMountTargetWithContext(ctx, shareTargetOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting target: %s\n%s", err, response)
 		}
@@ -11,9 +11,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -128,7 +128,7 @@ func resourceIbmIsShareReplicaOperationsCreate(context context.Context, d *schem
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForShareReplicationJobDone(context, vpcClient, share_id, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForShareReplicationJobDone(context, vpcClient, meta, share_id, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForShareReplicationJobDone failed: %s", err.Error()), "ibm_is_share_replica_operations", "create").GetDiag()
 	}
@@ -136,28 +136,28 @@ func resourceIbmIsShareReplicaOperationsCreate(context context.Context, d *schem
 	return nil
 }
 
-func isWaitForShareReplicationJobDone(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForShareReplicationJobDone(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for share (%s) to be available.", shareid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"active", "none"},
-		Refresh:    isShareReplicationJobRefreshFunc(context, vpcClient, shareid, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) replication job", shareid),
+		Pending:  []string{"pending"},
+		Target:   []string{"active", "none"},
+		Refresh:  isShareReplicationJobRefreshFunc(vpcClient, shareid, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isShareReplicationJobRefreshFunc(vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		shareOptions := &vpcv1.GetShareOptions{}
 
 		shareOptions.SetID(shareid)
 
-		share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
+		share, response, err := vpcClient.GetShareWithContext(ctx, shareOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
 		}
@@ -184,28 +184,28 @@ func resourceIbmIsShareReplicaOperationsDelete(context context.Context, d *schem
 	return nil
 }
 
-func isWaitForShareSplit(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForShareSplit(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for share (%s) to be available.", shareid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"split_pending"},
-		Target:     []string{"none"},
-		Refresh:    isShareSplitRefreshFunc(context, vpcClient, shareid, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s)", shareid),
+		Pending:  []string{"split_pending"},
+		Target:   []string{"none"},
+		Refresh:  isShareSplitRefreshFunc(vpcClient, shareid, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isShareSplitRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isShareSplitRefreshFunc(vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		shareOptions := &vpcv1.GetShareOptions{}
 
 		shareOptions.SetID(shareid)
 
-		share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
+		share, response, err := vpcClient.GetShareWithContext(ctx, shareOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
 		}
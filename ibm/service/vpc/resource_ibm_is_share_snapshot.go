@@ -17,11 +17,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -355,7 +355,7 @@ func resourceIBMIsShareSnapshotCreate(context context.Context, d *schema.Resourc
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createShareSnapshotOptions.ShareID, *shareSnapshot.ID))
-	_, err = isWaitForShareSnapshotAvailable(context, vpcClient, *createShareSnapshotOptions.ShareID, *shareSnapshot.ID, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForShareSnapshotAvailable(context, vpcClient, meta, *createShareSnapshotOptions.ShareID, *shareSnapshot.ID, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForShareSnapshotAvailable failed: %s", err.Error()), "ibm_is_share_snapshot", "create").GetDiag()
 	}
@@ -581,7 +581,7 @@ func resourceIBMIsShareSnapshotUpdate(context context.Context, d *schema.Resourc
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForShareSnapshotAvailable(context, vpcClient, parts[0], parts[1], d, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForShareSnapshotAvailable(context, vpcClient, meta, parts[0], parts[1], d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForShareSnapshotAvailable failed: %s", err.Error()), "ibm_is_share_snapshot", "update").GetDiag()
 		}
@@ -701,29 +701,29 @@ func ResourceIBMIsShareSnapshotZoneReferenceToMap(model *vpcv1.ZoneReference) (m
 	return modelMap, nil
 }
 
-func isWaitForShareSnapshotAvailable(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, shareSnapshotId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForShareSnapshotAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, shareid string, shareSnapshotId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for share snapshot (%s) to be available.", shareid)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"updating", "pending", "waiting"},
-		Target:     []string{"available", "failed"},
-		Refresh:    isShareSnapshotRefreshFunc(context, vpcClient, shareid, shareSnapshotId, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("share (%s) snapshot (%s)", shareid, shareSnapshotId),
+		Pending:  []string{"updating", "pending", "waiting"},
+		Target:   []string{"available", "failed"},
+		Refresh:  isShareSnapshotRefreshFunc(vpcClient, shareid, shareSnapshotId, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isShareSnapshotRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid, shareSnapshotId string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isShareSnapshotRefreshFunc(vpcClient *vpcv1.VpcV1, shareid, shareSnapshotId string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		shareSnapOptions := &vpcv1.GetShareSnapshotOptions{}
 
 		shareSnapOptions.SetShareID(shareid)
 		shareSnapOptions.SetID(shareSnapshotId)
 
-		shareSnapshot, response, err := vpcClient.GetShareSnapshotWithContext(context, shareSnapOptions)
+		shareSnapshot, response, err := vpcClient.GetShareSnapshotWithContext(ctx, shareSnapOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("Error Getting share snapshot: %s\n%s", err, response)
 		}
@@ -12,12 +12,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -57,8 +57,11 @@ const (
 	isSnapshotCatalogOfferingVersionCrn = "version_crn"
 )
 
+// ibmIsSnapshotIdentity is the identity of ibm_is_snapshot, which can be used to import it.
+var ibmIsSnapshotIdentity = vpcResourceIdentity("The unique identifier of the snapshot.")
+
 func ResourceIBMSnapshot() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSnapshotCreate,
 		ReadContext:   resourceIBMISSnapshotRead,
 		UpdateContext: resourceIBMISSnapshotUpdate,
@@ -508,7 +511,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsSnapshotIdentity)
 }
 
 func ResourceIBMISSnapshotValidator() *validate.ResourceValidator {
@@ -693,7 +696,7 @@ func resourceIBMISSnapshotCreate(context context.Context, d *schema.ResourceData
 	d.SetId(*snapshot.ID)
 	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
 
-	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot available failed: %s", err.Error()), "ibm_is_snapshot", "create")
@@ -712,27 +715,27 @@ func resourceIBMISSnapshotCreate(context context.Context, d *schema.ResourceData
 	return resourceIBMISSnapshotRead(context, d, meta)
 }
 
-func isWaitForSnapshotAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isSnapshotPending},
-		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot (%s)", id),
+		Pending:  []string{isSnapshotPending},
+		Target:   []string{isSnapshotAvailable, isSnapshotFailed},
+		Refresh:  isSnapshotRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -1080,7 +1083,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForSnapshotUpdate(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot update failed: %s", err.Error()), "ibm_is_snapshot", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1110,7 +1113,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotUpdate(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot update failed: %s", err.Error()), "ibm_is_snapshot", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1161,7 +1164,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotUpdate(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot update failed: %s", err.Error()), "ibm_is_snapshot", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1189,7 +1192,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForCloneAvailable(sess, d, id, add[i])
+				_, err = isWaitForCloneAvailable(context, sess, meta, d, id, add[i])
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot clone available failed: %s", err.Error()), "ibm_is_snapshot", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1210,7 +1213,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForCloneDeleted(sess, d, d.Id(), remove[i])
+				_, err = isWaitForCloneDeleted(context, sess, meta, d, d.Id(), remove[i])
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot clone deleted failed: %s", err.Error()), "ibm_is_snapshot", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1231,26 +1234,26 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func isWaitForSnapshotUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotUpdate(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isSnapshotUpdating},
-		Target:     []string{isSnapshotAvailable, isSnapshotFailed},
-		Refresh:    isSnapshotUpdateRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot (%s)", id),
+		Pending:  []string{isSnapshotUpdating},
+		Target:   []string{isSnapshotAvailable, isSnapshotFailed},
+		Refresh:  isSnapshotUpdateRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
 		}
@@ -1264,27 +1267,27 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		return snapshot, isSnapshotUpdating, nil
 	}
 }
-func isWaitForCloneAvailable(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
+func isWaitForCloneAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) clone (%s) to be available.", id, zoneName)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"false"},
-		Target:     []string{"true", "deleted"},
-		Refresh:    isSnapshotCloneRefreshFunc(sess, id, zoneName),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot (%s) clone (%s)", id, zoneName),
+		Pending:  []string{"false"},
+		Target:   []string{"true", "deleted"},
+		Refresh:  isSnapshotCloneRefreshFunc(sess, id, zoneName),
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotCloneRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotCloneRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSnapshotCloneOptions := &vpcv1.GetSnapshotCloneOptions{
 			ID:       &id,
 			ZoneName: &zoneName,
 		}
-		clone, response, err := sess.GetSnapshotClone(getSnapshotCloneOptions)
+		clone, response, err := sess.GetSnapshotCloneWithContext(ctx, getSnapshotCloneOptions)
 		if err != nil {
 			if response.StatusCode == 404 {
 				return nil, "deleted", nil
@@ -1300,13 +1303,13 @@ func isSnapshotCloneRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) resource
 	}
 }
 
-func isSnapshotCloneDeleteRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotCloneDeleteRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSnapshotCloneOptions := &vpcv1.GetSnapshotCloneOptions{
 			ID:       &id,
 			ZoneName: &zoneName,
 		}
-		clone, response, err := sess.GetSnapshotClone(getSnapshotCloneOptions)
+		clone, response, err := sess.GetSnapshotCloneWithContext(ctx, getSnapshotCloneOptions)
 		if err != nil {
 			if response.StatusCode == 404 {
 				return clone, "deleted", nil
@@ -1318,18 +1321,18 @@ func isSnapshotCloneDeleteRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) re
 	}
 }
 
-func isWaitForCloneDeleted(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
+func isWaitForCloneDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) clone (%s) to be deleted.", id, zoneName)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"true"},
-		Target:     []string{"false", "deleted"},
-		Refresh:    isSnapshotCloneDeleteRefreshFunc(sess, id, zoneName),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot (%s) clone (%s)", id, zoneName),
+		Pending:  []string{"true"},
+		Target:   []string{"false", "deleted"},
+		Refresh:  isSnapshotCloneDeleteRefreshFunc(sess, id, zoneName),
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceIBMISSnapshotDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1373,7 +1376,7 @@ func snapshotDelete(context context.Context, d *schema.ResourceData, meta interf
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot deleted failed: %s", err.Error()), "ibm_is_snapshot", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1383,28 +1386,28 @@ func snapshotDelete(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func isWaitForSnapshotDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isSnapshotDeleting},
-		Target:     []string{isSnapshotDeleted, isSnapshotFailed},
-		Refresh:    isSnapshotDeleteRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot (%s)", id),
+		Pending:  []string{isSnapshotDeleting},
+		Target:   []string{isSnapshotDeleted, isSnapshotFailed},
+		Refresh:  isSnapshotDeleteRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] Refresh function for Snapshot delete.")
 		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
 			ID: &id,
 		}
-		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		snapshot, response, err := sess.GetSnapshotWithContext(ctx, getSnapshotOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
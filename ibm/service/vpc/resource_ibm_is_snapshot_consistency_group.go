@@ -12,11 +12,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -362,7 +362,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 
 	d.SetId(*snapshotConsistencyGroup.ID)
 
-	_, err = isWaitForSnapshotConsistencyGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotConsistencyGroupAvailable(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for SnapshotConsistencyGroup available failed: %s", err.Error()), "ibm_is_snapshot_consistency_group", "create")
@@ -392,28 +392,28 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 	return resourceIBMIsSnapshotConsistencyGroupRead(context, d, meta)
 }
 
-func isWaitForSnapshotConsistencyGroupAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotConsistencyGroupAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot Consistency Group(%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"stable", "failed"},
-		Refresh:    isSnapshotConsistencyGroupRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot consistency group (%s)", id),
+		Pending:  []string{"pending"},
+		Target:   []string{"stable", "failed"},
+		Refresh:  isSnapshotConsistencyGroupRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		getSnapshotConsistencyGroupOptions := &vpcv1.GetSnapshotConsistencyGroupOptions{}
 		getSnapshotConsistencyGroupOptions.SetID(id)
 
-		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroup(getSnapshotConsistencyGroupOptions)
+		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroupWithContext(ctx, getSnapshotConsistencyGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
 			return nil, "failed", fmt.Errorf("[ERROR] Error GetSnapshotConsistencyGroupWithContext failed : %s\n%s", err, response)
@@ -430,26 +430,26 @@ func isSnapshotConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) re
 	}
 }
 
-func isWaitForSnapshotConsistencyGroupUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotConsistencyGroupUpdate(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot Consistency Group (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"updating"},
-		Target:     []string{"stable", "failed"},
-		Refresh:    isSnapshotUpdateConsistencyGroupRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot consistency group (%s)", id),
+		Pending:  []string{"updating"},
+		Target:   []string{"stable", "failed"},
+		Refresh:  isSnapshotUpdateConsistencyGroupRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotUpdateConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotUpdateConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSnapshotConsistencyGroupOptions := &vpcv1.GetSnapshotConsistencyGroupOptions{}
 		getSnapshotConsistencyGroupOptions.SetID(id)
 
-		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroup(getSnapshotConsistencyGroupOptions)
+		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroupWithContext(ctx, getSnapshotConsistencyGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
 			return nil, "failed", fmt.Errorf("[ERROR] Error GetSnapshotConsistencyGroupWithContext failed : %s\n%s", err, response)
@@ -465,29 +465,29 @@ func isSnapshotUpdateConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id stri
 	}
 }
 
-func isWaitForSnapshotConsistencyGroupDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotConsistencyGroupDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot Consistency Group (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{"deleted", "failed"},
-		Refresh:    isSnapshotDeleteConsistencyGroupRefreshFunc(sess, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("snapshot consistency group (%s)", id),
+		Pending:  []string{"deleting"},
+		Target:   []string{"deleted", "failed"},
+		Refresh:  isSnapshotDeleteConsistencyGroupRefreshFunc(sess, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSnapshotDeleteConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSnapshotDeleteConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] Refresh function for Snapshot Consistency Group delete.")
 
 		getSnapshotConsistencyGroupOptions := &vpcv1.GetSnapshotConsistencyGroupOptions{}
 		getSnapshotConsistencyGroupOptions.SetID(id)
 
-		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroup(getSnapshotConsistencyGroupOptions)
+		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroupWithContext(ctx, getSnapshotConsistencyGroupOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return snapshotConsistencyGroup, "deleted", nil
@@ -700,7 +700,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSnapshotConsistencyGroupUpdate(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotConsistencyGroupUpdate(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for SnapshotConsistencyGroup update failed: %s", err.Error()), "ibm_is_snapshot_consistency_group", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -730,7 +730,7 @@ func resourceIBMIsSnapshotConsistencyGroupDelete(context context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForSnapshotConsistencyGroupDeleted(vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotConsistencyGroupDeleted(context, vpcClient, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for SnapshotConsistencyGroup deleted failed: %s", err.Error()), "ibm_is_snapshot_consistency_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -37,8 +37,11 @@ const (
 	isKeyAccessTagType = "access"
 )
 
+// ibmIsSSHKeyIdentity is the identity of ibm_is_ssh_key, which can be used to import it.
+var ibmIsSSHKeyIdentity = vpcResourceIdentity("The unique identifier of the SSH key.")
+
 func ResourceIBMISSSHKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSSHKeyCreate,
 		ReadContext:   resourceIBMISSSHKeyRead,
 		UpdateContext: resourceIBMISSSHKeyUpdate,
@@ -160,7 +163,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Description: "List of access management tags for SSH key",
 			},
 		},
-	}
+	}, ibmIsSSHKeyIdentity)
 }
 
 func ResourceIBMISSHKeyValidator() *validate.ResourceValidator {
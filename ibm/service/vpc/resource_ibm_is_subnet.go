@@ -13,13 +13,13 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,8 +51,11 @@ const (
 	isAccessTagType          = "access"
 )
 
+// ibmIsSubnetIdentity is the identity of ibm_is_subnet, which can be used to import it.
+var ibmIsSubnetIdentity = vpcResourceIdentity("The unique identifier of the subnet.")
+
 func ResourceIBMISSubnet() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSubnetCreate,
 		ReadContext:   resourceIBMISSubnetRead,
 		UpdateContext: resourceIBMISSubnetUpdate,
@@ -230,7 +233,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, ibmIsSubnetIdentity)
 }
 
 func ResourceIBMISSubnetValidator() *validate.ResourceValidator {
@@ -400,7 +403,7 @@ func subnetCreate(context context.Context, d *schema.ResourceData, meta interfac
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -428,27 +431,27 @@ func subnetCreate(context context.Context, d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetProvisioning},
-		Target:     []string{isSubnetProvisioningDone, ""},
-		Refresh:    isSubnetRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s)", id),
+		Pending:  []string{"retry", isSubnetProvisioning},
+		Target:   []string{isSubnetProvisioningDone, ""},
+		Refresh:  isSubnetRefreshFunc(subnetC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting Subnet : %s\n%s", err, response)
 		}
@@ -687,7 +690,7 @@ func subnetUpdate(context context.Context, d *schema.ResourceData, meta interfac
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -706,7 +709,7 @@ func subnetUpdate(context context.Context, d *schema.ResourceData, meta interfac
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -802,7 +805,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -816,7 +819,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		if response != nil && response.StatusCode == 409 {
 			log.Printf("[DEBUG] Delete subnet response status code: 409 conflict, provider will try again. %s", err)
-			_, err = isWaitForSubnetDeleteRetry(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForSubnetDeleteRetry(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSubnetWithContext on retry failed: %s", err.Error()), "ibm_is_subnet", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -828,7 +831,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetDeleted failed: %s", err.Error()), "ibm_is_subnet", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -838,17 +841,18 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isSubnetInUse},
-		Target:  []string{isSubnetDeleting, isSubnetDeleted, ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s)", id),
+		Pending:  []string{isSubnetInUse},
+		Target:   []string{isSubnetDeleting, isSubnetDeleted, ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			deleteSubnetOptions := &vpcv1.DeleteSubnetOptions{
 				ID: &id,
 			}
 			log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
-			response, err := vpcClient.DeleteSubnet(deleteSubnetOptions)
+			response, err := vpcClient.DeleteSubnetWithContext(ctx, deleteSubnetOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 409 {
 					return response, isSubnetInUse, nil
@@ -859,35 +863,34 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 			}
 			return response, isSubnetDeleting, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(ctx context.Context, subnetC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetDeleting},
-		Target:     []string{isSubnetDeleted, ""},
-		Refresh:    isSubnetDeleteRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s)", id),
+		Pending:  []string{"retry", isSubnetDeleting},
+		Target:   []string{isSubnetDeleted, ""},
+		Refresh:  isSubnetDeleteRefreshFunc(subnetC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is subnet delete function here")
 		getSubnetOptions := &vpcv1.GetSubnetOptions{
 			ID: &id,
 		}
-		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
+		subnet, response, err := subnetC.GetSubnetWithContext(ctx, getSubnetOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return subnet, isSubnetDeleted, nil
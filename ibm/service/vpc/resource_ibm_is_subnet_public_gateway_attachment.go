@@ -10,9 +10,9 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -138,7 +138,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentCreate(context context.Context, d
 		return tfErr.GetDiag()
 	}
 	d.SetId(subnet)
-	_, err = isWaitForSubnetPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetPublicGatewayAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetPublicGatewayAvailable failed: %s", err.Error()), "ibm_is_subnet_public_gateway_attachment", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -306,7 +306,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForSubnetPublicGatewayDelete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetPublicGatewayDelete(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetPublicGatewayDelete failed: %s", err.Error()), "ibm_is_subnet_public_gateway_attachment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -316,27 +316,27 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 	return nil
 }
 
-func isWaitForSubnetPublicGatewayAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetPublicGatewayAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) public gateway attachment to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{IsPublicGatewayAttachmentPending, IsPublicGatewayAttachmentDeleting},
-		Target:     []string{IsPublicGatewayAttachmentAvailable, IsPublicGatewayAttachmentFailed, ""},
-		Refresh:    isSubnetPublicGatewayRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s) public gateway", id),
+		Pending:  []string{IsPublicGatewayAttachmentPending, IsPublicGatewayAttachmentDeleting},
+		Target:   []string{IsPublicGatewayAttachmentAvailable, IsPublicGatewayAttachmentFailed, ""},
+		Refresh:  isSubnetPublicGatewayRefreshFunc(subnetC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSubnetPublicGatewayRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSubnetPublicGatewayRefreshFunc(subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSubnetPublicGatewayOptionsModel := &vpcv1.GetSubnetPublicGatewayOptions{
 			ID: &id,
 		}
-		pg, response, err := subnetC.GetSubnetPublicGateway(getSubnetPublicGatewayOptionsModel)
+		pg, response, err := subnetC.GetSubnetPublicGatewayWithContext(ctx, getSubnetPublicGatewayOptionsModel)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -353,27 +353,27 @@ func isSubnetPublicGatewayRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.
 	}
 }
 
-func isWaitForSubnetPublicGatewayDelete(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetPublicGatewayDelete(ctx context.Context, subnetC *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) public gateway attachment to be detached.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{IsPublicGatewayAttachmentPending, IsPublicGatewayAttachmentDeleting},
-		Target:     []string{IsPublicGatewayAttachmentAvailable, IsPublicGatewayAttachmentFailed, ""},
-		Refresh:    isSubnetPublicGatewayDeleteRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s) public gateway", id),
+		Pending:  []string{IsPublicGatewayAttachmentPending, IsPublicGatewayAttachmentDeleting},
+		Target:   []string{IsPublicGatewayAttachmentAvailable, IsPublicGatewayAttachmentFailed, ""},
+		Refresh:  isSubnetPublicGatewayDeleteRefreshFunc(subnetC, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isSubnetPublicGatewayDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isSubnetPublicGatewayDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getSubnetPublicGatewayOptionsModel := &vpcv1.GetSubnetPublicGatewayOptions{
 			ID: &id,
 		}
-		pg, response, err := subnetC.GetSubnetPublicGateway(getSubnetPublicGatewayOptionsModel)
+		pg, response, err := subnetC.GetSubnetPublicGatewayWithContext(ctx, getSubnetPublicGatewayOptionsModel)
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
@@ -11,11 +11,11 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -187,7 +187,7 @@ func resourceIBMISReservedIPCreate(context context.Context, d *schema.ResourceDa
 
 	// Set id for the reserved IP as combination of subnet ID and reserved IP ID
 	d.SetId(fmt.Sprintf("%s/%s", subnetID, *rip.ID))
-	_, err = isWaitForReservedIpAvailable(sess, subnetID, *rip.ID, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForReservedIpAvailable(context, sess, meta, subnetID, *rip.ID, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservedIpAvailable failed: %s", err.Error()), "ibm_is_subnet_reserved_ip", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -385,7 +385,7 @@ func resourceIBMISReservedIPUpdate(context context.Context, d *schema.ResourceDa
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForReservedIpAvailable(sess, subnetID, reservedIPID, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForReservedIpAvailable(context, sess, meta, subnetID, reservedIPID, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservedIpAvailable failed: %s", err.Error()), "ibm_is_subnet_reserved_ip", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -494,26 +494,26 @@ func getReservedIpWithoutContext(d *schema.ResourceData, meta interface{}) (*vpc
 	return reservedIP, nil
 }
 
-func isWaitForReservedIpAvailable(sess *vpcv1.VpcV1, subnetid, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForReservedIpAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, subnetid, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for reseved ip (%s/%s) to be available.", subnetid, id)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"done", "failed", ""},
-		Refresh:    isReserveIpRefreshFunc(sess, subnetid, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("subnet (%s) reserved IP (%s)", subnetid, id),
+		Pending:  []string{"pending"},
+		Target:   []string{"done", "failed", ""},
+		Refresh:  isReserveIpRefreshFunc(sess, subnetid, id, d),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.ResourceData) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.ResourceData) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getreservedipOptions := &vpcv1.GetSubnetReservedIPOptions{
 			ID:       &id,
 			SubnetID: &subnetid,
 		}
-		rsip, response, err := sess.GetSubnetReservedIP(getreservedipOptions)
+		rsip, response, err := sess.GetSubnetReservedIPWithContext(ctx, getreservedipOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting reserved ip(%s/%s) : %s\n%s", subnetid, id, err, response)
 		}
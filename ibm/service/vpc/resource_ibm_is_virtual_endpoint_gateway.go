@@ -13,12 +13,12 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -49,10 +49,13 @@ const (
 	isVirtualEndpointGatewayAllowDnsResolutionBinding = "allow_dns_resolution_binding"
 )
 
+// ibmIsVirtualEndpointGatewayIdentity is the identity of ibm_is_virtual_endpoint_gateway, which can be used to import it.
+var ibmIsVirtualEndpointGatewayIdentity = vpcResourceIdentity("The unique identifier of the endpoint gateway.")
+
 func ResourceIBMISEndpointGateway() *schema.Resource {
 	targetNameFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetName)
 	targetCRNFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetCRN)
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMisVirtualEndpointGatewayCreate,
 		ReadContext:   resourceIBMisVirtualEndpointGatewayRead,
 		UpdateContext: resourceIBMisVirtualEndpointGatewayUpdate,
@@ -278,7 +281,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Description: "List of access management tags",
 			},
 		},
-	}
+	}, ibmIsVirtualEndpointGatewayIdentity)
 }
 
 func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
@@ -437,7 +440,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(context context.Context, d *schem
 
 	d.SetId(*endpointGateway.ID)
 
-	_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVirtualEndpointGatewayAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		if d.Get(targetResourceTypeFmt).(string) == "private_path_service_gateway" {
 			isAccessPending := false
@@ -555,7 +558,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schem
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualEndpointGatewayAvailable failed: %s", err.Error()), "ibm_is_virtual_endpoint_gateway", "update").GetDiag()
 				}
@@ -581,7 +584,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schem
 					return flex.TerraformErrorf(err, fmt.Sprintf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response), "ibm_is_virtual_endpoint_gateway", "update").GetDiag()
 
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualEndpointGatewayAvailable failed: %s", err.Error()), "ibm_is_virtual_endpoint_gateway", "update").GetDiag()
 				}
@@ -703,42 +706,42 @@ func flattenDataSourceSecurityGroups(securityGroupList []vpcv1.SecurityGroupRefe
 	return securitygroupList
 }
 
-func isWaitForVirtualEndpointGatewayAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualEndpointGatewayAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual endpoint gateway (%s) to be available.", endPointGatewayId)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"waiting", "pending", "updating"},
-		Target:     []string{"stable", "failed", ""},
-		Refresh:    isVirtualEndpointGatewayRefreshFunc(sess, endPointGatewayId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("virtual endpoint gateway (%s)", endPointGatewayId),
+		Pending:  []string{"waiting", "pending", "updating"},
+		Target:   []string{"stable", "failed", ""},
+		Refresh:  isVirtualEndpointGatewayRefreshFunc(sess, endPointGatewayId),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isWaitForVirtualEndpointGatewayForPPSGAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualEndpointGatewayForPPSGAvailable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual endpoint gateway (%s) to be available.", endPointGatewayId)
 	// When the target is PPSG, pending is a valid state when the endpoint gateway binding is not permitted within the terraform configuration.
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{"waiting", "updating"},
-		Target:                    []string{"stable", "failed", "pending", ""},
-		Refresh:                   isVirtualEndpointGatewayRefreshFunc(sess, endPointGatewayId),
-		Timeout:                   timeout,
-		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 6,
+	stateWaiter := &waiter.StateWaiter{
+		Resource:          fmt.Sprintf("virtual endpoint gateway (%s)", endPointGatewayId),
+		Pending:           []string{"waiting", "updating"},
+		Target:            []string{"stable", "failed", "pending", ""},
+		Refresh:           isVirtualEndpointGatewayRefreshFunc(sess, endPointGatewayId),
+		Timeout:           timeout,
+		TargetOccurrences: 6,
+		Policy:            waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 
 		opt := sess.NewGetEndpointGatewayOptions(endPointGatewayId)
-		result, response, err := sess.GetEndpointGateway(opt)
+		result, response, err := sess.GetEndpointGatewayWithContext(ctx, opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil, "", fmt.Errorf("Error Getting Virtual Endpoint Gateway : %s\n%s", err, response)
@@ -765,7 +768,7 @@ func resourceIBMisVirtualEndpointGatewayDelete(context context.Context, d *schem
 		log.Printf("Delete Endpoint Gateway failed: %v", response)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_virtual_endpoint_gateway", "delete", "sep-id-parts").GetDiag()
 	}
-	_, err = isWaitForEGWDelete(sess, d, d.Id())
+	_, err = isWaitForEGWDelete(context, sess, meta, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteEndpointGateway failed: %s", err.Error()), "ibm_is_virtual_endpoint_gateway", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -774,16 +777,17 @@ func resourceIBMisVirtualEndpointGatewayDelete(context context.Context, d *schem
 	return nil
 }
 
-func isWaitForEGWDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForEGWDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"deleting", "stable"},
-		Target:  []string{"done", ""},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("virtual endpoint gateway (%s)", id),
+		Pending:  []string{"deleting", "stable"},
+		Target:   []string{"done", ""},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getegwoptions := &vpcv1.GetEndpointGatewayOptions{
 				ID: &id,
 			}
-			egw, response, err := vpcClient.GetEndpointGateway(getegwoptions)
+			egw, response, err := vpcClient.GetEndpointGatewayWithContext(ctx, getegwoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return egw, "done", nil
@@ -795,12 +799,11 @@ func isWaitForEGWDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id strin
 			}
 			return egw, "deleting", nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
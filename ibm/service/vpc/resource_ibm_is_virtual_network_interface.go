@@ -13,12 +13,12 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -934,7 +934,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_virtual_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -955,7 +955,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, meta, vniId, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_virtual_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -996,7 +996,7 @@ func resourceIBMIsVirtualNetworkInterfaceDelete(context context.Context, d *sche
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVirtualNetworkInterfaceDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete), vni)
+	_, err = isWaitForVirtualNetworkInterfaceDeleted(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutDelete), vni)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceDeleted failed: %s", err.Error()), "ibm_is_virtual_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1151,57 +1151,48 @@ func resourceIBMIsVirtualNetworkInterfaceVirtualNetworkInterfaceTargetShareMount
 	return modelMap, nil
 }
 
-func isWaitForVirtualNetworkInterfaceAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualNetworkInterfaceAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VirtualNetworkInterface (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"", "pending"},
-		Target:     []string{"done", "failed", "stable"},
-		Refresh:    isVirtualNetworkInterfaceRefreshFunc(client, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("virtual network interface (%s)", id),
+		Pending:  []string{"", "pending"},
+		Target:   []string{"done", "stable"},
+		Failed:   []string{"failed", "suspended"},
+		Refresh:  isVirtualNetworkInterfaceRefreshFunc(client, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVirtualNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		vnigetoptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
-			ID: &id,
-		}
-		vni, response, err := client.GetVirtualNetworkInterface(vnigetoptions)
-		if err != nil {
-			return nil, "failed", fmt.Errorf("[ERROR] Error getting vni: %s\n%s", err, response)
-		}
-		if *vni.LifecycleState == "failed" || *vni.LifecycleState == "suspended" {
-			return vni, *vni.LifecycleState, fmt.Errorf("[ERROR] Error VirtualNetworkInterface in : %s state", *vni.LifecycleState)
-		}
-		return vni, *vni.LifecycleState, nil
-	}
+func isVirtualNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return waiter.LifecycleStateRefresh(func(ctx context.Context) (*vpcv1.VirtualNetworkInterface, *core.DetailedResponse, error) {
+		return client.GetVirtualNetworkInterfaceWithContext(ctx, &vpcv1.GetVirtualNetworkInterfaceOptions{ID: &id})
+	})
 }
-func isWaitForVirtualNetworkInterfaceDeleted(client *vpcv1.VpcV1, id string, timeout time.Duration, vni *vpcv1.VirtualNetworkInterface) (interface{}, error) {
+func isWaitForVirtualNetworkInterfaceDeleted(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration, vni *vpcv1.VirtualNetworkInterface) (interface{}, error) {
 	log.Printf("Waiting for VirtualNetworkInterface (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"", "pending", "deleting", "updating", "waiting"},
-		Target:     []string{"done", "failed", "stable", "suspended"},
-		Refresh:    isVirtualNetworkInterfaceDeleteRefreshFunc(client, vni, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("virtual network interface (%s)", id),
+		Pending:  []string{"", "pending", "deleting", "updating", "waiting"},
+		Target:   []string{"done", "failed", "stable", "suspended"},
+		Refresh:  isVirtualNetworkInterfaceDeleteRefreshFunc(client, vni, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVirtualNetworkInterfaceDeleteRefreshFunc(client *vpcv1.VpcV1, vnir *vpcv1.VirtualNetworkInterface, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVirtualNetworkInterfaceDeleteRefreshFunc(client *vpcv1.VpcV1, vnir *vpcv1.VirtualNetworkInterface, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		vnigetoptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
 			ID: &id,
 		}
-		vni, response, err := client.GetVirtualNetworkInterface(vnigetoptions)
+		vni, response, err := client.GetVirtualNetworkInterfaceWithContext(ctx, vnigetoptions)
 		if err != nil {
 			if response.StatusCode == 404 {
 				return vnir, "done", nil
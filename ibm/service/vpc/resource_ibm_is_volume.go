@@ -12,12 +12,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -59,8 +59,11 @@ const (
 	isVolumeCatalogOfferingVersionCrn = "version_crn"
 )
 
+// ibmIsVolumeIdentity is the identity of ibm_is_volume, which can be used to import it.
+var ibmIsVolumeIdentity = vpcResourceIdentity("The unique identifier of the volume.")
+
 func ResourceIBMISVolume() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVolumeCreate,
 		ReadContext:   resourceIBMISVolumeRead,
 		UpdateContext: resourceIBMISVolumeUpdate,
@@ -423,7 +426,7 @@ func ResourceIBMISVolume() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsVolumeIdentity)
 }
 
 func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
@@ -634,7 +637,7 @@ func volCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1002,7 +1005,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1028,7 +1031,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1074,7 +1077,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1100,7 +1103,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1148,7 +1151,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(context, sess, meta, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1184,7 +1187,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 		}
 		eTag = response.Headers.Get("ETag")
 		options.IfMatch = &eTag
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1238,7 +1241,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForInstanceAvailable(context, sess, meta, *insId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1264,7 +1267,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1305,7 +1308,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForVolumeAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1361,7 +1364,7 @@ func volDelete(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceVolumeDetached(sess, d, d.Id(), *volAtt.ID)
+			_, err = isWaitForInstanceVolumeDetached(context, sess, meta, d, d.Id(), *volAtt.ID)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_volume", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1380,7 +1383,7 @@ func volDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed: %s", err.Error()), "ibm_is_volume", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1390,27 +1393,27 @@ func volDelete(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(ctx context.Context, vol *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeDeleting},
-		Target:     []string{"done", ""},
-		Refresh:    isVolumeDeleteRefreshFunc(vol, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("volume (%s)", id),
+		Pending:  []string{"retry", isVolumeDeleting},
+		Target:   []string{"done", ""},
+		Refresh:  isVolumeDeleteRefreshFunc(vol, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := vol.GetVolume(volgetoptions)
+		vol, response, err := vol.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
@@ -1447,27 +1450,27 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeAvailable(ctx context.Context, client *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeProvisioning},
-		Target:     []string{isVolumeProvisioningDone, ""},
-		Refresh:    isVolumeRefreshFunc(client, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("volume (%s)", id),
+		Pending:  []string{"retry", isVolumeProvisioning},
+		Target:   []string{isVolumeProvisioningDone, ""},
+		Refresh:  isVolumeRefreshFunc(client, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := client.GetVolume(volgetoptions)
+		vol, response, err := client.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error getting volume: %s\n%s", err, response)
 		}
@@ -17,11 +17,11 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
@@ -92,8 +92,11 @@ const (
 	isVPCNoSgAclRules                         = "no_sg_acl_rules"
 )
 
+// ibmIsVPCIdentity is the identity of ibm_is_vpc, which can be used to import it.
+var ibmIsVPCIdentity = vpcResourceIdentity("The unique identifier of the VPC.")
+
 func ResourceIBMISVPC() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPCCreate,
 		ReadContext:   resourceIBMISVPCRead,
 		UpdateContext: resourceIBMISVPCUpdate,
@@ -637,7 +640,7 @@ func ResourceIBMISVPC() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsVPCIdentity)
 }
 
 func ResourceIBMISVPCValidator() *validate.ResourceValidator {
@@ -793,7 +796,7 @@ func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPCAvailable(context, sess, meta, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPCAvailable failed: %s", err.Error()), "ibm_is_vpc", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -887,19 +890,19 @@ func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCAvailable(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isVPCPending},
-		Target:     []string{isVPCAvailable, isVPCFailed},
-		Refresh:    isVPCRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPC (%s)", id),
+		Pending:  []string{isVPCPending},
+		Target:   []string{isVPCAvailable, isVPCFailed},
+		Refresh:  isVPCRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
@@ -974,12 +977,12 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 	return nil
 }
 
-func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
 		}
@@ -1876,7 +1879,7 @@ func vpcDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPCDeleted failed: %s", err.Error()), "ibm_is_vpc", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1886,28 +1889,28 @@ func vpcDelete(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCDeleted(ctx context.Context, vpc *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPCDeleting},
-		Target:     []string{isVPCDeleted, isVPCFailed},
-		Refresh:    isVPCDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPC (%s)", id),
+		Pending:  []string{"retry", isVPCDeleting},
+		Target:   []string{isVPCDeleted, isVPCFailed},
+		Refresh:  isVPCDeleteRefreshFunc(vpc, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		log.Printf("[DEBUG] is vpc delete function here")
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
-		vpc, response, err := vpc.GetVPC(getvpcOptions)
+		vpc, response, err := vpc.GetVPCWithContext(ctx, getvpcOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
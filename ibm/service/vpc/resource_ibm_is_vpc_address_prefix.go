@@ -27,8 +27,11 @@ const (
 	isAddressPrefix              = "address_prefix"
 )
 
+// ibmIsVPCAddressPrefixIdentity is the identity of ibm_is_vpc_address_prefix, which can be used to import it.
+var ibmIsVPCAddressPrefixIdentity = vpcChildResourceIdentity("vpc", "The unique identifier of the VPC.", "The unique identifier of the address prefix.")
+
 func ResourceIBMISVpcAddressPrefix() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVpcAddressPrefixCreate,
 		ReadContext:   resourceIBMISVpcAddressPrefixRead,
 		UpdateContext: resourceIBMISVpcAddressPrefixUpdate,
@@ -90,7 +93,7 @@ func ResourceIBMISVpcAddressPrefix() *schema.Resource {
 				Description: "The unique identifier of the address prefix",
 			},
 		},
-	}
+	}, ibmIsVPCAddressPrefixIdentity)
 }
 
 func ResourceIBMISAddressPrefixValidator() *validate.ResourceValidator {
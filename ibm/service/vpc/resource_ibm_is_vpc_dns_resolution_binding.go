@@ -11,10 +11,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -306,7 +306,7 @@ func resourceIBMIsVPCDnsResolutionBindingCreate(context context.Context, d *sche
 		return tfErr.GetDiag()
 	}
 	d.SetId(MakeTerraformVPCDNSID(spokeVPCID, *vpcdnsResolutionBinding.ID))
-	intf, err := isWaitForVpcDnsCreated(sess, spokeVPCID, *vpcdnsResolutionBinding.ID, d.Timeout(schema.TimeoutCreate))
+	intf, err := isWaitForVpcDnsCreated(context, sess, meta, spokeVPCID, *vpcdnsResolutionBinding.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -490,7 +490,7 @@ func resourceIBMIsVPCDnsResolutionBindingDelete(context context.Context, d *sche
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVpcDnsDeleted(sess, vpcId, id, d.Timeout(schema.TimeoutDelete), dns)
+	_, err = isWaitForVpcDnsDeleted(context, sess, meta, vpcId, id, d.Timeout(schema.TimeoutDelete), dns)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVpcDnsDeleted failed: %s", err.Error()), "ibm_is_vpc_dns_resolution_binding", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -516,29 +516,29 @@ func ParseVPCDNSTerraformID(s string) (string, string, error) {
 	return segments[0], segments[1], nil
 }
 
-func isWaitForVpcDnsDeleted(sess *vpcv1.VpcV1, vpcid, id string, timeout time.Duration, dns *vpcv1.VpcdnsResolutionBinding) (interface{}, error) {
+func isWaitForVpcDnsDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, vpcid, id string, timeout time.Duration, dns *vpcv1.VpcdnsResolutionBinding) (interface{}, error) {
 	log.Printf("Waiting for vpc dns (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting", "pending", "updating", "waiting"},
-		Target:     []string{"stable", "failed", "suspended", ""},
-		Refresh:    isVpcDnsDeleteRefreshFunc(sess, vpcid, id, dns),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPC (%s) DNS resolution binding (%s)", vpcid, id),
+		Pending:  []string{"deleting", "pending", "updating", "waiting"},
+		Target:   []string{"stable", "failed", "suspended", ""},
+		Refresh:  isVpcDnsDeleteRefreshFunc(sess, vpcid, id, dns),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVpcDnsDeleteRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string, dns *vpcv1.VpcdnsResolutionBinding) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVpcDnsDeleteRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string, dns *vpcv1.VpcdnsResolutionBinding) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getVPCDnsResolutionBindingOptions := &vpcv1.GetVPCDnsResolutionBindingOptions{}
 
 		getVPCDnsResolutionBindingOptions.SetVPCID(vpcid)
 		getVPCDnsResolutionBindingOptions.SetID(id)
 
-		vpcdnsResolutionBinding, response, err := sess.GetVPCDnsResolutionBinding(getVPCDnsResolutionBindingOptions)
+		vpcdnsResolutionBinding, response, err := sess.GetVPCDnsResolutionBindingWithContext(ctx, getVPCDnsResolutionBindingOptions)
 		if vpcdnsResolutionBinding == nil {
 			vpcdnsResolutionBinding = dns
 		}
@@ -552,29 +552,29 @@ func isVpcDnsDeleteRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string, dns *vpcv1.V
 	}
 }
 
-func isWaitForVpcDnsCreated(sess *vpcv1.VpcV1, vpcid, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpcDnsCreated(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, vpcid, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpc dns (%s) to be created.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting", "pending", "updating", "waiting"},
-		Target:     []string{"stable", "failed", "suspended", ""},
-		Refresh:    isVpcDnsCreateRefreshFunc(sess, vpcid, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPC (%s) DNS resolution binding (%s)", vpcid, id),
+		Pending:  []string{"deleting", "pending", "updating", "waiting"},
+		Target:   []string{"stable", "failed", "suspended", ""},
+		Refresh:  isVpcDnsCreateRefreshFunc(sess, vpcid, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVpcDnsCreateRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVpcDnsCreateRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getVPCDnsResolutionBindingOptions := &vpcv1.GetVPCDnsResolutionBindingOptions{}
 
 		getVPCDnsResolutionBindingOptions.SetVPCID(vpcid)
 		getVPCDnsResolutionBindingOptions.SetID(id)
 
-		vpcdnsResolutionBinding, response, err := sess.GetVPCDnsResolutionBinding(getVPCDnsResolutionBindingOptions)
+		vpcdnsResolutionBinding, response, err := sess.GetVPCDnsResolutionBindingWithContext(ctx, getVPCDnsResolutionBindingOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vpcdnsResolutionBinding, "", nil
@@ -49,8 +49,11 @@ const (
 	rtUserTagType                = "user"
 )
 
+// ibmIsVPCRoutingTableIdentity is the identity of ibm_is_vpc_routing_table, which can be used to import it.
+var ibmIsVPCRoutingTableIdentity = vpcChildResourceIdentity("vpc", "The unique identifier of the VPC.", "The unique identifier of the routing table.")
+
 func ResourceIBMISVPCRoutingTable() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPCRoutingTableCreate,
 		ReadContext:   resourceIBMISVPCRoutingTableRead,
 		UpdateContext: resourceIBMISVPCRoutingTableUpdate,
@@ -223,7 +226,7 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				Description: "List of access management tags",
 			},
 		},
-	}
+	}, ibmIsVPCRoutingTableIdentity)
 }
 
 func ResourceIBMISVPCRoutingTableValidator() *validate.ResourceValidator {
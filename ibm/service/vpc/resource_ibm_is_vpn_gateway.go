@@ -11,12 +11,12 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,8 +47,11 @@ const (
 	isVPNGatewayLifecycleReasons  = "lifecycle_reasons"
 )
 
+// ibmIsVPNGatewayIdentity is the identity of ibm_is_vpn_gateway, which can be used to import it.
+var ibmIsVPNGatewayIdentity = vpcResourceIdentity("The unique identifier of the VPN gateway.")
+
 func ResourceIBMISVPNGateway() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithResourceIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPNGatewayCreate,
 		ReadContext:   resourceIBMISVPNGatewayRead,
 		UpdateContext: resourceIBMISVPNGatewayUpdate,
@@ -350,7 +353,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, ibmIsVPNGatewayIdentity)
 }
 
 func ResourceIBMISVPNGatewayValidator() *validate.ResourceValidator {
@@ -453,7 +456,7 @@ func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface
 	d.SetId(*vpnGateway.ID)
 	log.Printf("[INFO] VPNGateway : %s", *vpnGateway.ID)
 
-	_, err = isWaitForVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVpnGatewayAvailable(context, sess, meta, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVpnGatewayAvailable failed: %s", err.Error()), "ibm_is_vpn_gateway", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -482,27 +485,27 @@ func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
-func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayAvailable(ctx context.Context, vpnGateway *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayProvisioning},
-		Target:     []string{isVPNGatewayProvisioningDone, ""},
-		Refresh:    isVpnGatewayRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN gateway (%s)", id),
+		Pending:  []string{"retry", isVPNGatewayProvisioning},
+		Target:   []string{isVPNGatewayProvisioningDone, ""},
+		Refresh:  isVpnGatewayRefreshFunc(vpnGateway, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
+		vpnGatewayIntf, response, err := vpnGateway.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
 		}
@@ -838,7 +841,7 @@ func vpngwDelete(context context.Context, d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVpnGatewayDeleted(context, sess, meta, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVpnGatewayDeleted failed: %s", err.Error()), "ibm_is_vpn_gateway", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -848,27 +851,27 @@ func vpngwDelete(context context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
-func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayDeleted(ctx context.Context, vpnGateway *vpcv1.VpcV1, meta interface{}, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayDeleting},
-		Target:     []string{isVPNGatewayDeleted, ""},
-		Refresh:    isVpnGatewayDeleteRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN gateway (%s)", id),
+		Pending:  []string{"retry", isVPNGatewayDeleting},
+		Target:   []string{isVPNGatewayDeleted, ""},
+		Refresh:  isVpnGatewayDeleteRefreshFunc(vpnGateway, id),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
-		vpngw, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
+		vpngw, response, err := vpnGateway.GetVPNGatewayWithContext(ctx, getVpnGatewayOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
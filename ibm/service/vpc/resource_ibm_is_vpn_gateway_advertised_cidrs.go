@@ -10,9 +10,9 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -128,7 +128,7 @@ func resourceIBMISVPNGatewayAdvertisedCidrDelete(context context.Context, d *sch
 	gID := parts[0]
 	cidr := parts[1] + "/" + parts[2]
 
-	deleteErr := vpngwAdvertisedCidrDelete(d, meta, gID, cidr)
+	deleteErr := vpngwAdvertisedCidrDelete(context, d, meta, gID, cidr)
 	if deleteErr != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error deleting advertised cidr err %s", err), "ibm_is_vpn_gateway_advertised_cidr", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -137,7 +137,7 @@ func resourceIBMISVPNGatewayAdvertisedCidrDelete(context context.Context, d *sch
 	return nil
 }
 
-func vpngwAdvertisedCidrDelete(d *schema.ResourceData, meta interface{}, gID, gCidr string) diag.Diagnostics {
+func vpngwAdvertisedCidrDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, gID, gCidr string) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("vpcClient creation failed: %s", err.Error()), "ibm_is_vpn_gateway_advertised_cidr", "delete")
@@ -171,7 +171,7 @@ func vpngwAdvertisedCidrDelete(d *schema.ResourceData, meta interface{}, gID, gC
 		return diag.FromErr(tfErr)
 	}
 
-	_, err = isWaitForVPNGatewayAdvertisedCIDRDeleted(sess, gID, gCidr, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayAdvertisedCIDRDeleted(ctx, sess, meta, gID, gCidr, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error checking for Vpn Gateway advertised cidr (%s) is deleted: %s", gCidr, err), "ibm_is_vpn_gateway_advertised_cidr", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -182,28 +182,28 @@ func vpngwAdvertisedCidrDelete(d *schema.ResourceData, meta interface{}, gID, gC
 	return nil
 }
 
-func isWaitForVPNGatewayAdvertisedCIDRDeleted(vpnGatewayAdverisedCidr *vpcv1.VpcV1, gID, gCidr string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayAdvertisedCIDRDeleted(ctx context.Context, vpnGatewayAdverisedCidr *vpcv1.VpcV1, meta interface{}, gID, gCidr string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayAdvertisedCIDR (%s) to be deleted.", gCidr)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayAdvertisedCidrDeleting},
-		Target:     []string{"", isVPNGatewayAdvertisedCidrDeleted},
-		Refresh:    isVPNGatewayAdvertisedCIDRDeleteRefreshFunc(vpnGatewayAdverisedCidr, gID, gCidr),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN gateway (%s) advertised CIDR (%s)", gID, gCidr),
+		Pending:  []string{"retry", isVPNGatewayAdvertisedCidrDeleting},
+		Target:   []string{"", isVPNGatewayAdvertisedCidrDeleted},
+		Refresh:  isVPNGatewayAdvertisedCIDRDeleteRefreshFunc(vpnGatewayAdverisedCidr, gID, gCidr),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVPNGatewayAdvertisedCIDRDeleteRefreshFunc(vpnGatewayAdverisedCidr *vpcv1.VpcV1, gID, gCidr string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVPNGatewayAdvertisedCIDRDeleteRefreshFunc(vpnGatewayAdverisedCidr *vpcv1.VpcV1, gID, gCidr string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		checkVPNGatewayAdvertisedCIDROptions := &vpcv1.CheckVPNGatewayAdvertisedCIDROptions{
 			VPNGatewayID: &gID,
 			CIDR:         &gCidr,
 		}
-		response, err := vpnGatewayAdverisedCidr.CheckVPNGatewayAdvertisedCIDR(checkVPNGatewayAdvertisedCIDROptions)
+		response, err := vpnGatewayAdverisedCidr.CheckVPNGatewayAdvertisedCIDRWithContext(ctx, checkVPNGatewayAdvertisedCIDROptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
@@ -11,11 +11,11 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -1240,7 +1240,7 @@ func vpngwconDelete(context context.Context, d *schema.ResourceData, meta interf
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayConnectionDeleted(context, sess, meta, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNGatewayConnectionDeleted failed: %s", err.Error()), "ibm_is_vpn_gateway_connection", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1251,28 +1251,28 @@ func vpngwconDelete(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayConnectionDeleted(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, meta interface{}, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayConnectionDeleting},
-		Target:     []string{"", isVPNGatewayConnectionDeleted},
-		Refresh:    isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN gateway (%s) connection (%s)", gID, gConnID),
+		Pending:  []string{"retry", isVPNGatewayConnectionDeleting},
+		Target:   []string{"", isVPNGatewayConnectionDeleted},
+		Refresh:  isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID),
+		Timeout:  timeout,
+		Policy:   waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
-func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) waiter.RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
 		getVpnGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{
 			VPNGatewayID: &gID,
 			ID:           &gConnID,
 		}
-		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnectionWithContext(ctx, getVpnGatewayConnectionOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
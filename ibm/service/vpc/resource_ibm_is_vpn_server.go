@@ -12,10 +12,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -581,7 +581,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 
 	d.SetId(*vpnServer.ID)
 
-	_, err = isWaitForVPNServerStable(context, vpcClient, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPNServerStable(context, vpcClient, meta, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "isWaitForVPNServerStable", "create", "wait-for-stable-vpnserver").GetDiag()
 	}
@@ -608,18 +608,19 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 	return resourceIBMIsVPNServerRead(context, d, meta)
 }
 
-func isWaitForVPNServerStable(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNServerStable(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPN Server(%s) to be stable.", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isVPNServerStatusPending, isVPNServerStatusUpdating},
-		Target:  []string{isVPNServerStatusStable, isVPNServerStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN server (%s)", d.Id()),
+		Pending:  []string{isVPNServerStatusPending, isVPNServerStatusUpdating},
+		Target:   []string{isVPNServerStatusStable, isVPNServerStatusFailed},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
 
 			getVPNServerOptions.SetID(d.Id())
 
-			vpnServer, _, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
+			vpnServer, _, err := sess.GetVPNServerWithContext(ctx, getVPNServerOptions)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVPNServerWithContext failed: %s", err.Error()), "ibm_is_vpn_server", "wait-read")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -631,12 +632,11 @@ func isWaitForVPNServerStable(context context.Context, sess *vpcv1.VpcV1, d *sch
 			}
 			return vpnServer, *vpnServer.LifecycleState, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1025,7 +1025,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVPNServerStable(context, vpcClient, d, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVPNServerStable(context, vpcClient, meta, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNServerStable failed: %s", err.Error()), "ibm_is_vpn_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1067,7 +1067,7 @@ func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceDat
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForVPNServerDeleted(context, vpcClient, d)
+	_, err = isWaitForVPNServerDeleted(context, vpcClient, meta, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNServerDeleted failed: %s", err.Error()), "ibm_is_vpn_server", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1078,17 +1078,18 @@ func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func isWaitForVPNServerDeleted(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData) (interface{}, error) {
+func isWaitForVPNServerDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 
 	log.Printf("Waiting for VPN Server (%s) to be deleted.", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"retry", isVPNServerStatusDeleting},
-		Target:  []string{isVPNServerStatusDeleted, isVPNServerStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN server (%s)", d.Id()),
+		Pending:  []string{"retry", isVPNServerStatusDeleting},
+		Target:   []string{isVPNServerStatusDeleted, isVPNServerStatusFailed},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getVPNServerOptions := &vpcv1.GetVPNServerOptions{}
 			getVPNServerOptions.SetID(d.Id())
 
-			vpnServer, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
+			vpnServer, response, err := sess.GetVPNServerWithContext(ctx, getVPNServerOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return vpnServer, isVPNServerStatusDeleted, nil
@@ -1098,12 +1099,11 @@ func isWaitForVPNServerDeleted(context context.Context, sess *vpcv1.VpcV1, d *sc
 			return vpnServer, *vpnServer.LifecycleState, nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceVPNServerFlattenLifecycleReasons(lifecycleReasons []vpcv1.VPNServerLifecycleReason) (lifecycleReasonsList []map[string]interface{}) {
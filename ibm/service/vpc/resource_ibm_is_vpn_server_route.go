@@ -11,10 +11,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -218,7 +218,7 @@ func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.Resour
 
 	d.SetId(fmt.Sprintf("%s/%s", *createVPNServerRouteOptions.VPNServerID, *vpnServerRoute.ID))
 
-	_, err = isWaitForVPNServerRouteStable(context, vpcClient, d, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPNServerRouteStable(context, vpcClient, meta, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNServerRouteStable failed: %s", err.Error()), "ibm_is_vpn_server_route", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -227,13 +227,14 @@ func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.Resour
 	return resourceIBMIsVPNServerRouteRead(context, d, meta)
 }
 
-func isWaitForVPNServerRouteStable(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNServerRouteStable(ctx context.Context, vpcClient *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPN Server  Route(%s) to be stable.", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isVPNServerStatusPending, isVPNServerRouteStatusUpdating},
-		Target:  []string{isVPNServerStatusStable, isVPNServerRouteStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN server route (%s)", d.Id()),
+		Pending:  []string{isVPNServerStatusPending, isVPNServerRouteStatusUpdating},
+		Target:   []string{isVPNServerStatusStable, isVPNServerRouteStatusFailed},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{}
 
 			parts, err := flex.SepIdParts(d.Id(), "/")
@@ -244,7 +245,7 @@ func isWaitForVPNServerRouteStable(context context.Context, vpcClient *vpcv1.Vpc
 			getVPNServerRouteOptions.SetVPNServerID(parts[0])
 			getVPNServerRouteOptions.SetID(parts[1])
 
-			vpnServerRoute, _, err := vpcClient.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
+			vpnServerRoute, _, err := vpcClient.GetVPNServerRouteWithContext(ctx, getVPNServerRouteOptions)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVPNServerRouteWithContext failed: %s", err.Error()), "ibm_is_vpn_server_route", "wait")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -256,12 +257,11 @@ func isWaitForVPNServerRouteStable(context context.Context, vpcClient *vpcv1.Vpc
 			}
 			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: timeout,
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 func resourceIBMIsVPNServerRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
@@ -368,7 +368,7 @@ func resourceIBMIsVPNServerRouteUpdate(context context.Context, d *schema.Resour
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForVPNServerRouteStable(context, vpcClient, d, d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitForVPNServerRouteStable(context, vpcClient, meta, d, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNServerRouteStable failed: %s", err.Error()), "ibm_is_vpn_server_route", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -403,7 +403,7 @@ func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.Resour
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVPNServerRouteDeleted(context, vpcClient, d)
+	_, err = isWaitForVPNServerRouteDeleted(context, vpcClient, meta, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNServerRouteStable failed: %s", err.Error()), "ibm_is_vpn_server_route", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -414,13 +414,14 @@ func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.Resour
 	return nil
 }
 
-func isWaitForVPNServerRouteDeleted(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData) (interface{}, error) {
+func isWaitForVPNServerRouteDeleted(ctx context.Context, sess *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for VPN Server Route(%s) to be deleted.", d.Id())
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"retry", isVPNServerRouteStatusDeleting},
-		Target:  []string{isVPNServerStatusDeleted, isVPNServerRouteStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	stateWaiter := &waiter.StateWaiter{
+		Resource: fmt.Sprintf("VPN server route (%s)", d.Id()),
+		Pending:  []string{"retry", isVPNServerRouteStatusDeleting},
+		Target:   []string{isVPNServerStatusDeleted, isVPNServerRouteStatusFailed},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
 			getVPNServerRouteOptions := &vpcv1.GetVPNServerRouteOptions{}
 
 			parts, err := flex.SepIdParts(d.Id(), "/")
@@ -431,7 +432,7 @@ func isWaitForVPNServerRouteDeleted(context context.Context, sess *vpcv1.VpcV1,
 			getVPNServerRouteOptions.SetVPNServerID(parts[0])
 			getVPNServerRouteOptions.SetID(parts[1])
 
-			vpnServerRoute, response, err := sess.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
+			vpnServerRoute, response, err := sess.GetVPNServerRouteWithContext(ctx, getVPNServerRouteOptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return vpnServerRoute, isVPNServerRouteStatusDeleted, nil
@@ -441,12 +442,11 @@ func isWaitForVPNServerRouteDeleted(context context.Context, sess *vpcv1.VpcV1,
 			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Policy:  waiter.PolicyFromMeta(meta),
 	}
 
-	return stateConf.WaitForState()
+	return stateWaiter.Wait(ctx)
 }
 
 func resourceVPNServerRouteFlattenLifecycleReasons(lifecycleReasons []vpcv1.VPNServerRouteLifecycleReason) (lifecycleReasonsList []map[string]interface{}) {
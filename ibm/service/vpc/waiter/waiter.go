@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package waiter waits for VPC resources to reach a lifecycle state or a status. The waiters poll
+// with an exponential interval, stop as soon as their context is cancelled, and report the last
+// observed state and reasons of the resource when the resource does not reach a target state.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// NotFound is the state of a resource that does not exist.
+const NotFound = "not_found"
+
+// RefreshFunc gets the resource that is waited for and returns it with its current state.
+type RefreshFunc func(ctx context.Context) (resource interface{}, state string, err error)
+
+// GetFunc gets a resource from the VPC API, for example a bound GetInstanceWithContext call.
+type GetFunc[T any] func(ctx context.Context) (*T, *core.DetailedResponse, error)
+
+// StateWaiter waits for a resource to reach one of the Target states.
+type StateWaiter struct {
+	// Resource names the resource in errors, for example "instance (0717_1e09281b)".
+	Resource string
+	// Pending are the states in which the waiter keeps polling. Any other state that is not a
+	// target state ends the wait with an error.
+	Pending []string
+	Target  []string
+	// TargetOccurrences is the number of consecutive polls that must observe a target state before
+	// the wait ends, for resources whose state settles only after flapping. Zero means one poll.
+	TargetOccurrences int
+	// Failed are the states that end the wait with an error, for example "failed".
+	Failed  []string
+	Refresh RefreshFunc
+	// Timeout bounds the wait. Zero means that the wait is only bounded by its context.
+	Timeout time.Duration
+	Policy  conns.WaiterPolicy
+}
+
+// PolicyFromMeta returns the waiter policy that is configured on the provider. The meta is the
+// provider meta of a resource, a conns.ClientSession.
+func PolicyFromMeta(meta interface{}) conns.WaiterPolicy {
+	return meta.(conns.ClientSession).WaiterPolicy()
+}
+
+// Wait polls the resource until it reaches a target state, and returns the last refreshed resource.
+// The first poll happens after the delay of the policy. The interval then starts at the minimum poll
+// interval and doubles after every poll, up to the maximum poll interval.
+func (w *StateWaiter) Wait(ctx context.Context) (interface{}, error) {
+	policy := w.Policy.WithDefaults()
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	var resource interface{}
+	state := ""
+	occurrences := 0
+	wait, interval := policy.Delay, policy.MinPollInterval
+	for {
+		if err := sleep(ctx, wait); err != nil {
+			return resource, w.stateError(resource, state, err)
+		}
+
+		current, currentState, err := w.Refresh(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return resource, w.stateError(resource, state, ctx.Err())
+			}
+			return resource, err
+		}
+		if current != nil {
+			resource = current
+		}
+		state = currentState
+
+		switch {
+		case slices.Contains(w.Target, state):
+			occurrences++
+			if occurrences >= w.TargetOccurrences {
+				return resource, nil
+			}
+		case slices.Contains(w.Failed, state):
+			stateErr := w.stateError(resource, state, nil)
+			stateErr.Failed = true
+			return resource, stateErr
+		case !slices.Contains(w.Pending, state):
+			return resource, w.stateError(resource, state, nil)
+		default:
+			occurrences = 0
+		}
+
+		wait = interval
+		interval = min(2*interval, policy.MaxPollInterval)
+	}
+}
+
+func (w *StateWaiter) stateError(resource interface{}, state string, err error) *StateError {
+	return &StateError{
+		Resource: w.Resource,
+		Target:   w.Target,
+		State:    state,
+		Reasons:  Reasons(resource),
+		Timeout:  w.Timeout,
+		Err:      err,
+	}
+}
+
+// sleep waits for d, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Reason is a lifecycle, status or health reason of a resource.
+type Reason struct {
+	Code     string
+	Message  string
+	MoreInfo string
+}
+
+func (r Reason) String() string {
+	s := r.Code
+	if r.Message != "" {
+		s += ": " + r.Message
+	}
+	if r.MoreInfo != "" {
+		s += " (" + r.MoreInfo + ")"
+	}
+	return s
+}
+
+// StateError is returned when a resource does not reach a target state.
+type StateError struct {
+	Resource string
+	Target   []string
+	// State is the last observed state of the resource. It is empty when the resource was never
+	// refreshed.
+	State string
+	// Reasons are the lifecycle, status and health reasons of the last refreshed resource.
+	Reasons []Reason
+	// Failed reports that the resource reached a failed state.
+	Failed  bool
+	Timeout time.Duration
+	// Err is the context error when the wait timed out or was cancelled.
+	Err error
+}
+
+func (e *StateError) Error() string {
+	var b strings.Builder
+	target := strings.Join(e.Target, " or ")
+	switch {
+	case errors.Is(e.Err, context.DeadlineExceeded) && e.Timeout > 0:
+		fmt.Fprintf(&b, "timeout after %s while waiting for %s to become %s", e.Timeout, e.Resource, target)
+	case errors.Is(e.Err, context.DeadlineExceeded):
+		fmt.Fprintf(&b, "deadline exceeded while waiting for %s to become %s", e.Resource, target)
+	case e.Err != nil:
+		fmt.Fprintf(&b, "cancelled waiting for %s to become %s", e.Resource, target)
+	case e.Failed:
+		fmt.Fprintf(&b, "%s failed while waiting for it to become %s", e.Resource, target)
+	default:
+		fmt.Fprintf(&b, "%s reached an unexpected state while waiting for it to become %s", e.Resource, target)
+	}
+	if e.State == "" {
+		b.WriteString(": no state was observed")
+	} else {
+		fmt.Fprintf(&b, ": last state %q", e.State)
+	}
+	if len(e.Reasons) > 0 {
+		reasons := make([]string, len(e.Reasons))
+		for i, reason := range e.Reasons {
+			reasons[i] = reason.String()
+		}
+		fmt.Fprintf(&b, ", reasons: %s", strings.Join(reasons, "; "))
+	}
+	return b.String()
+}
+
+func (e *StateError) Unwrap() error {
+	return e.Err
+}
+
+// LifecycleStateRefresh returns a refresh func that reads the lifecycle_state of the resource. A
+// resource that is not found has the state NotFound.
+func LifecycleStateRefresh[T any](get GetFunc[T]) RefreshFunc {
+	return fieldRefresh(get, "LifecycleState")
+}
+
+// StatusRefresh returns a refresh func that reads the status of the resource. A resource that is not
+// found has the state NotFound.
+func StatusRefresh[T any](get GetFunc[T]) RefreshFunc {
+	return fieldRefresh(get, "Status")
+}
+
+func fieldRefresh[T any](get GetFunc[T], field string) RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
+		resource, response, err := get(ctx)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil, NotFound, nil
+			}
+			return nil, "", fmt.Errorf("%s\n%s", err, response)
+		}
+		if resource == nil {
+			return nil, NotFound, nil
+		}
+		return resource, stringField(reflect.ValueOf(resource), field), nil
+	}
+}
+
+// Reasons returns the lifecycle, status and health reasons of a VPC resource.
+func Reasons(resource interface{}) []Reason {
+	v := indirect(reflect.ValueOf(resource))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var reasons []Reason
+	for _, name := range []string{"LifecycleReasons", "StatusReasons", "HealthReasons"} {
+		field := v.FieldByName(name)
+		if field.Kind() != reflect.Slice {
+			continue
+		}
+		for i := 0; i < field.Len(); i++ {
+			reason := field.Index(i)
+			reasons = append(reasons, Reason{
+				Code:     stringField(reason, "Code"),
+				Message:  stringField(reason, "Message"),
+				MoreInfo: stringField(reason, "MoreInfo"),
+			})
+		}
+	}
+	return reasons
+}
+
+// stringField returns the value of a string or *string field of a struct, or "" when there is none.
+func stringField(v reflect.Value, name string) string {
+	v = indirect(v)
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	field := indirect(v.FieldByName(name))
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
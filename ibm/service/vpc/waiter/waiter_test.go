@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package waiter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type testReason struct {
+	Code     *string
+	Message  *string
+	MoreInfo *string
+}
+
+type testResource struct {
+	LifecycleState   *string
+	LifecycleReasons []testReason
+	Status           *string
+}
+
+var testPolicy = conns.WaiterPolicy{
+	Delay:           time.Millisecond,
+	MinPollInterval: time.Millisecond,
+	MaxPollInterval: 4 * time.Millisecond,
+}
+
+// states returns a refresh func that returns the states in order, and then the last state forever.
+func states(polls *[]time.Time, values ...string) RefreshFunc {
+	return func(ctx context.Context) (interface{}, string, error) {
+		*polls = append(*polls, time.Now())
+		state := values[0]
+		if len(values) > 1 {
+			values = values[1:]
+		}
+		return &testResource{LifecycleState: &state}, state, nil
+	}
+}
+
+func TestStateWaiterTarget(t *testing.T) {
+	var polls []time.Time
+	w := &StateWaiter{
+		Resource: "instance (a)",
+		Pending:  []string{"pending", "updating"},
+		Target:   []string{"stable"},
+		Refresh:  states(&polls, "pending", "updating", "pending", "stable"),
+		Policy:   testPolicy,
+	}
+	resource, err := w.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if state := *resource.(*testResource).LifecycleState; state != "stable" {
+		t.Fatalf("expected the last refreshed resource, got state %q", state)
+	}
+	if len(polls) != 4 {
+		t.Fatalf("expected 4 polls, got %d", len(polls))
+	}
+}
+
+func TestStateWaiterTargetOccurrences(t *testing.T) {
+	var polls []time.Time
+	w := &StateWaiter{
+		Resource:          "endpoint gateway (a)",
+		Pending:           []string{"pending"},
+		Target:            []string{"stable"},
+		TargetOccurrences: 2,
+		Refresh:           states(&polls, "stable", "pending", "stable", "stable"),
+		Policy:            testPolicy,
+	}
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(polls) != 4 {
+		t.Fatalf("expected the pending state to reset the target occurrences and 4 polls, got %d", len(polls))
+	}
+}
+
+func TestStateWaiterBackoff(t *testing.T) {
+	var polls []time.Time
+	w := &StateWaiter{
+		Pending: []string{"pending"},
+		Target:  []string{"stable"},
+		Refresh: states(&polls, "pending", "pending", "pending", "pending", "pending", "stable"),
+		Policy: conns.WaiterPolicy{
+			Delay:           time.Millisecond,
+			MinPollInterval: 10 * time.Millisecond,
+			MaxPollInterval: 20 * time.Millisecond,
+		},
+	}
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The intervals are 10ms, 20ms, and then capped at 20ms
+	for i, expected := range []time.Duration{10, 20, 20, 20, 20} {
+		if interval := polls[i+1].Sub(polls[i]); interval < expected*time.Millisecond {
+			t.Fatalf("expected interval %d to be at least %dms, got %s", i, expected, interval)
+		}
+	}
+}
+
+func TestStateWaiterFailed(t *testing.T) {
+	code, message := "internal_error", "internal error"
+	w := &StateWaiter{
+		Resource: "instance (a)",
+		Pending:  []string{"pending"},
+		Target:   []string{"stable"},
+		Failed:   []string{"failed"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			state := "failed"
+			return &testResource{
+				LifecycleState:   &state,
+				LifecycleReasons: []testReason{{Code: &code, Message: &message}},
+			}, state, nil
+		},
+		Policy: testPolicy,
+	}
+	_, err := w.Wait(context.Background())
+	var stateErr *StateError
+	if !errors.As(err, &stateErr) || !stateErr.Failed || stateErr.State != "failed" {
+		t.Fatalf("expected a failed state error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `last state "failed"`) || !strings.Contains(err.Error(), "internal_error: internal error") {
+		t.Fatalf("expected the last state and reasons in the error, got %q", err)
+	}
+}
+
+func TestStateWaiterUnexpectedState(t *testing.T) {
+	var polls []time.Time
+	w := &StateWaiter{
+		Resource: "instance (a)",
+		Pending:  []string{"pending"},
+		Target:   []string{"stable"},
+		Refresh:  states(&polls, "pending", "deleting"),
+		Policy:   testPolicy,
+	}
+	_, err := w.Wait(context.Background())
+	var stateErr *StateError
+	if !errors.As(err, &stateErr) || stateErr.Failed || stateErr.State != "deleting" {
+		t.Fatalf("expected an unexpected state error, got %v", err)
+	}
+}
+
+func TestStateWaiterTimeout(t *testing.T) {
+	var polls []time.Time
+	w := &StateWaiter{
+		Resource: "instance (a)",
+		Pending:  []string{"pending"},
+		Target:   []string{"stable"},
+		Refresh:  states(&polls, "pending"),
+		Timeout:  20 * time.Millisecond,
+		Policy:   testPolicy,
+	}
+	_, err := w.Wait(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "timeout after 20ms") || !strings.Contains(err.Error(), `last state "pending"`) {
+		t.Fatalf("expected the timeout and the last state in the error, got %q", err)
+	}
+}
+
+func TestStateWaiterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	polled := false
+	w := &StateWaiter{
+		Resource: "instance (a)",
+		Pending:  []string{"pending"},
+		Target:   []string{"stable"},
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			polled = true
+			return nil, "pending", nil
+		},
+		Policy: conns.WaiterPolicy{Delay: time.Hour},
+	}
+	cancel()
+	_, err := w.Wait(ctx)
+	if !errors.Is(err, context.Canceled) || polled {
+		t.Fatalf("expected the wait to be cancelled before the first poll, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "no state was observed") {
+		t.Fatalf("expected no observed state in the error, got %q", err)
+	}
+}
+
+func TestLifecycleStateRefresh(t *testing.T) {
+	state := "stable"
+	refresh := LifecycleStateRefresh(func(ctx context.Context) (*testResource, *core.DetailedResponse, error) {
+		return &testResource{LifecycleState: &state}, &core.DetailedResponse{StatusCode: 200}, nil
+	})
+	if _, got, err := refresh(context.Background()); err != nil || got != "stable" {
+		t.Fatalf("expected state stable, got %q %v", got, err)
+	}
+
+	refresh = StatusRefresh(func(ctx context.Context) (*testResource, *core.DetailedResponse, error) {
+		return nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found")
+	})
+	if _, got, err := refresh(context.Background()); err != nil || got != NotFound {
+		t.Fatalf("expected state %s, got %q %v", NotFound, got, err)
+	}
+}